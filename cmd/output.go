@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func checkOutput(format string) error {
+	switch format {
+	case config.DefaultOutput, config.OutputJSON:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q (supported: %s)", format, config.OutputJSON)
+	}
+}
+
+// printNodes writes the cluster nodes and their metrics as JSON lines.
+func printNodes(w io.Writer, conn client.Connection) error {
+	if conn == nil || !conn.ConnectionOK() {
+		return errors.New("no cluster connection available")
+	}
+
+	f := watch.NewFactory(conn)
+	f.Start(client.BlankNamespace)
+	defer f.Terminate()
+	// Prime the node and pod informers so the listing below is complete.
+	for _, gvr := range []*client.GVR{client.NodeGVR, client.PodGVR} {
+		if _, err := f.List(gvr, client.BlankNamespace, true, labels.Everything()); err != nil {
+			return err
+		}
+	}
+
+	var no dao.Node
+	no.Init(f, client.NodeGVR)
+	ctx := context.WithValue(context.Background(), internal.KeyPodCounting, true)
+	oo, err := no.List(ctx, client.ClusterScope)
+	if err != nil {
+		return err
+	}
+
+	return writeJSONLines(w, oo)
+}
+
+func writeJSONLines(w io.Writer, oo []runtime.Object) error {
+	enc := json.NewEncoder(w)
+	for _, o := range oo {
+		if err := enc.Encode(o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
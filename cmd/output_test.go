@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func Test_checkOutput(t *testing.T) {
+	uu := map[string]struct {
+		format string
+		err    bool
+	}{
+		"ui":   {},
+		"json": {format: "json"},
+		"yaml": {format: "yaml", err: true},
+	}
+
+	for k, u := range uu {
+		t.Run(k, func(t *testing.T) {
+			err := checkOutput(u.format)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_writeJSONLines(t *testing.T) {
+	oo := []runtime.Object{
+		&render.NodeWithMetrics{
+			Raw:      makeNode("n1"),
+			PodCount: 2,
+			Net:      &client.NodeNetworkMetrics{RxBytes: 1, TxBytes: 2},
+		},
+		&render.NodeWithMetrics{
+			Raw:      makeNode("n2"),
+			PodCount: -1,
+		},
+	}
+
+	var buff bytes.Buffer
+	require.NoError(t, writeJSONLines(&buff, oo))
+
+	ll := strings.Split(strings.TrimSpace(buff.String()), "\n")
+	assert.Equal(t, []string{
+		`{"node":{"kind":"Node","metadata":{"name":"n1"}},"network":{"rxBytes":1,"txBytes":2},"podCount":2}`,
+		`{"node":{"kind":"Node","metadata":{"name":"n2"}}}`,
+	}, ll)
+}
+
+func makeNode(n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"kind":     "Node",
+		"metadata": map[string]any{"name": n},
+	}}
+}
@@ -102,16 +102,27 @@ func run(*cobra.Command, []string) error {
 		}
 	}()
 
-	slog.SetDefault(slog.New(tint.NewHandler(logFile, &tint.Options{
+	slog.SetDefault(slog.New(slogs.NewRedactHandler(tint.NewHandler(logFile, &tint.Options{
 		Level:      parseLevel(*k9sFlags.LogLevel),
 		TimeFormat: time.Kitchen,
-	})))
+	}))))
 
+	if err := checkOutput(*k9sFlags.Output); err != nil {
+		return err
+	}
+	ww, err := checkConfig(config.AppConfigFile, *k9sFlags.StrictConfig)
+	if err != nil {
+		return err
+	}
 	cfg, err := loadConfiguration()
 	if err != nil {
 		slog.Warn("Fail to load global/context configuration", slogs.Error, err)
 	}
+	if *k9sFlags.Output != config.DefaultOutput {
+		return printNodes(os.Stdout, cfg.GetConnection())
+	}
 	app := view.NewApp(cfg)
+	app.SetConfigWarnings(ww)
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		return err
 	}
@@ -125,6 +136,23 @@ func run(*cobra.Command, []string) error {
 	return nil
 }
 
+// checkConfig warns about unknown config keys or fails on them in strict mode.
+func checkConfig(path string, strict bool) ([]config.ConfigWarning, error) {
+	ww := config.ValidateConfig(path)
+	for _, w := range ww {
+		slog.Warn("Unknown config key", slogs.Path, w.Path, slogs.Key, w.Key)
+	}
+	if strict && len(ww) > 0 {
+		kk := make([]string, 0, len(ww))
+		for _, w := range ww {
+			kk = append(kk, w.Key)
+		}
+		return nil, fmt.Errorf("config file %q has %d unknown key(s): %s", path, len(ww), strings.Join(kk, ", "))
+	}
+
+	return ww, nil
+}
+
 func loadConfiguration() (*config.Config, error) {
 	slog.Info("🐶 K9s starting up...")
 
@@ -223,6 +251,12 @@ func initK9sFlags() {
 		false,
 		"Turn K9s splash screen off",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.A11y,
+		"a11y",
+		false,
+		"Render resources as high contrast plain text for screen readers",
+	)
 	rootCmd.Flags().BoolVarP(
 		k9sFlags.AllNamespaces,
 		"all-namespaces", "A",
@@ -253,6 +287,18 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVarP(
+		k9sFlags.Output,
+		"output", "o",
+		config.DefaultOutput,
+		"Print nodes in the given format (json) and exit instead of launching the UI",
+	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.StrictConfig,
+		"strict-config",
+		false,
+		"Fails on unknown configuration keys instead of warning about them",
+	)
 	rootCmd.Flags()
 }
 
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_checkConfig(t *testing.T) {
+	dir := t.TempDir()
+	cool, path := filepath.Join(dir, "cool.yaml"), filepath.Join(dir, "config.yaml")
+	require.NoError(t, os.WriteFile(cool, []byte("k9s:\n  refreshRate: 2\n"), 0o600))
+	require.NoError(t, os.WriteFile(path, []byte("k9s:\n  refreshRat: 2\n"), 0o600))
+
+	uu := map[string]struct {
+		path   string
+		strict bool
+		keys   []string
+		err    string
+	}{
+		"cool": {
+			path: cool,
+		},
+		"missing": {
+			path:   filepath.Join(dir, "blee.yaml"),
+			strict: true,
+		},
+		"warn": {
+			path: path,
+			keys: []string{"k9s.refreshRat"},
+		},
+		"strict": {
+			path:   path,
+			strict: true,
+			err:    `config file "` + path + `" has 1 unknown key(s): k9s.refreshRat`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ww, err := checkConfig(u.path, u.strict)
+			if u.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			var kk []string
+			for _, w := range ww {
+				kk = append(kk, w.Key)
+			}
+			assert.Equal(t, u.keys, kk)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit
+
+import (
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+var logger atomic.Pointer[AuditLogger]
+
+// Configure installs the audit logger for the given path. An empty path disables auditing.
+func Configure(path string) {
+	logger.Store(NewAuditLogger(path))
+}
+
+// Enabled checks if mutating actions are being audited.
+func Enabled() bool {
+	return logger.Load() != nil
+}
+
+// Log records an entry if auditing is enabled.
+func Log(e Entry) {
+	l := logger.Load()
+	if l == nil {
+		return
+	}
+	if err := l.Log(e); err != nil {
+		slog.Warn("Unable to write audit log entry",
+			slogs.Path, l.Path(),
+			slogs.Error, err,
+		)
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// OutcomeSuccess tracks a successful action.
+	OutcomeSuccess = "success"
+
+	// OutcomeFailure tracks a failed action.
+	OutcomeFailure = "failure"
+
+	logPerms = 0o600
+	dirPerms = 0o700
+)
+
+// Entry represents an audited mutating action.
+type Entry struct {
+	Timestamp time.Time      `json:"timestamp"`
+	User      string         `json:"user"`
+	Cluster   string         `json:"cluster"`
+	Namespace string         `json:"namespace,omitempty"`
+	Resource  string         `json:"resource"`
+	Name      string         `json:"name"`
+	Action    string         `json:"action"`
+	Params    map[string]any `json:"params,omitempty"`
+	Outcome   string         `json:"outcome"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// WithOutcome sets the entry outcome based on the action error.
+func (e Entry) WithOutcome(err error) Entry {
+	e.Outcome = OutcomeSuccess
+	if err != nil {
+		e.Outcome, e.Error = OutcomeFailure, err.Error()
+	}
+
+	return e
+}
+
+// AuditLogger appends audit entries as JSON lines to a file.
+type AuditLogger struct {
+	path string
+	mx   sync.Mutex
+}
+
+// NewAuditLogger returns a new logger or nil if no path is given.
+func NewAuditLogger(path string) *AuditLogger {
+	if path == "" {
+		return nil
+	}
+
+	return &AuditLogger{path: path}
+}
+
+// Path returns the audit log path.
+func (l *AuditLogger) Path() string {
+	return l.path
+}
+
+// Log appends an entry to the audit log.
+func (l *AuditLogger) Log(e Entry) error {
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now()
+	}
+	bb, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), dirPerms); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, logPerms)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(bb, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
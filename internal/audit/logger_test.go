@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package audit_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditLoggerDisabled(t *testing.T) {
+	assert.Nil(t, audit.NewAuditLogger(""))
+}
+
+func TestAuditLoggerLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "audit.log")
+	l := audit.NewAuditLogger(path)
+
+	e := audit.Entry{
+		User:     "fred",
+		Cluster:  "c1",
+		Resource: "v1/nodes",
+		Name:     "n1",
+		Action:   "cordon",
+	}
+	require.NoError(t, l.Log(e.WithOutcome(nil)))
+	e.Params = map[string]any{"replicas": 2}
+	require.NoError(t, l.Log(e.WithOutcome(errors.New("boom"))))
+
+	ee := readEntries(t, path)
+	require.Len(t, ee, 2)
+	assert.Equal(t, "fred", ee[0]["user"])
+	assert.Equal(t, "c1", ee[0]["cluster"])
+	assert.Equal(t, "v1/nodes", ee[0]["resource"])
+	assert.Equal(t, "n1", ee[0]["name"])
+	assert.Equal(t, "cordon", ee[0]["action"])
+	assert.Equal(t, audit.OutcomeSuccess, ee[0]["outcome"])
+	assert.NotEmpty(t, ee[0]["timestamp"])
+	assert.NotContains(t, ee[0], "params")
+	assert.NotContains(t, ee[0], "namespace")
+
+	assert.Equal(t, audit.OutcomeFailure, ee[1]["outcome"])
+	assert.Equal(t, "boom", ee[1]["error"])
+	assert.Equal(t, map[string]any{"replicas": float64(2)}, ee[1]["params"])
+}
+
+func TestLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	audit.Configure("")
+	assert.False(t, audit.Enabled())
+	audit.Log(audit.Entry{Action: "delete"})
+	assert.NoFileExists(t, path)
+
+	audit.Configure(path)
+	defer audit.Configure("")
+	assert.True(t, audit.Enabled())
+	audit.Log(audit.Entry{Action: "delete"})
+	assert.Len(t, readEntries(t, path), 1)
+}
+
+func readEntries(t *testing.T, path string) []map[string]any {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var ee []map[string]any
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var e map[string]any
+		require.NoError(t, json.Unmarshal(s.Bytes(), &e))
+		ee = append(ee, e)
+	}
+
+	return ee
+}
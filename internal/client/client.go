@@ -51,6 +51,7 @@ type APIClient struct {
 	mx                sync.RWMutex
 	cache             *cache.LRUExpireCache
 	connOK            bool
+	pool              *ConnectionPool
 	log               *slog.Logger
 }
 
@@ -317,6 +318,15 @@ func (a *APIClient) CheckConnectivity() bool {
 	if _, err := client.ServerVersion(); err == nil {
 		if !a.getConnOK() {
 			a.reset()
+		} else if p := a.getPool(); p != nil && p.Health().Degraded() {
+			// Watches keep the pooled connections busy, so the clients and transport
+			// are torn down. The fresh pool health starts clean hence the warning
+			// only fires once per degradation.
+			slog.Warn("Degraded api server connection. Reconnecting",
+				slogs.Host, p.Host(),
+				slogs.Health, p.Health().String(),
+			)
+			a.reset()
 		}
 	} else {
 		slog.Error("Unable to fetch server version", slogs.Error, err)
@@ -420,6 +430,52 @@ func (a *APIClient) getClient() kubernetes.Interface {
 	return a.client
 }
 
+// PoolHealth returns the api server connection pool health.
+func (a *APIClient) PoolHealth() PoolHealth {
+	if p := a.getPool(); p != nil {
+		return p.Health()
+	}
+
+	return PoolHealth{}
+}
+
+func (a *APIClient) getPool() *ConnectionPool {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	return a.pool
+}
+
+// connPool returns the connection pool for the given cluster endpoint.
+func (a *APIClient) connPool(cfg *restclient.Config) (*ConnectionPool, error) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if a.pool != nil && a.pool.Host() == cfg.Host {
+		return a.pool, nil
+	}
+	p, err := NewConnectionPool(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if a.pool != nil {
+		a.pool.Close()
+	}
+	a.pool = p
+
+	return a.pool, nil
+}
+
+func (a *APIClient) resetPool() {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	if a.pool != nil {
+		a.pool.Close()
+	}
+	a.pool = nil
+}
+
 // DialLogs returns a handle to api server for logs.
 func (a *APIClient) DialLogs() (kubernetes.Interface, error) {
 	if !a.getConnOK() {
@@ -434,7 +490,11 @@ func (a *APIClient) DialLogs() (kubernetes.Interface, error) {
 		return nil, err
 	}
 	cfg.Timeout = 0
-	c, err := kubernetes.NewForConfig(cfg)
+	p, err := a.connPool(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := kubernetes.NewForConfigAndClient(cfg, p.HTTPClient(cfg.Timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -456,7 +516,11 @@ func (a *APIClient) Dial() (kubernetes.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
-	c, err := kubernetes.NewForConfig(cfg)
+	p, err := a.connPool(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := kubernetes.NewForConfigAndClient(cfg, p.HTTPClient(cfg.Timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -512,7 +576,11 @@ func (a *APIClient) DynDial() (dynamic.Interface, error) {
 	if err != nil {
 		return nil, err
 	}
-	c, err := dynamic.NewForConfig(cfg)
+	p, err := a.connPool(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := dynamic.NewForConfigAndClient(cfg, p.HTTPClient(cfg.Timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -532,7 +600,11 @@ func (a *APIClient) MXDial() (*versioned.Clientset, error) {
 		return nil, err
 	}
 
-	c, err := versioned.NewForConfig(cfg)
+	p, err := a.connPool(cfg)
+	if err != nil {
+		return nil, err
+	}
+	c, err := versioned.NewForConfigAndClient(cfg, p.HTTPClient(cfg.Timeout))
 	if err != nil {
 		return nil, err
 	}
@@ -564,7 +636,7 @@ func (a *APIClient) SwitchContext(name string) error {
 		return err
 	}
 	a.reset()
-	ResetMetrics()
+	ResetMetrics(a)
 
 	// Need reload to pick up any kubeconfig changes.
 	a.config = NewConfig(a.config.flags)
@@ -572,6 +644,12 @@ func (a *APIClient) SwitchContext(name string) error {
 	return a.invalidateCache()
 }
 
+// Close releases the connection clients and pooled transport.
+func (a *APIClient) Close() {
+	a.reset()
+	ResetMetrics(a)
+}
+
 func (a *APIClient) reset() {
 	a.config.reset()
 	a.cache = cache.NewLRUExpireCache(cacheSize)
@@ -582,6 +660,7 @@ func (a *APIClient) reset() {
 	a.setCachedClient(nil)
 	a.setClient(nil)
 	a.setLogClient(nil)
+	a.resetPool()
 	a.setConnOK(true)
 }
 
@@ -27,9 +27,12 @@ const (
 
 // Config tracks a kubernetes configuration.
 type Config struct {
-	flags *genericclioptions.ConfigFlags
-	mx    sync.RWMutex
-	proxy func(*http.Request) (*url.URL, error)
+	flags      *genericclioptions.ConfigFlags
+	mx         sync.RWMutex
+	proxy      func(*http.Request) (*url.URL, error)
+	refresher  *TokenRefresher
+	refreshKey string
+	refreshFn  TokenRefreshFunc
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
@@ -60,10 +63,57 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 	if c.proxy != nil {
 		cfg.Proxy = c.proxy
 	}
+	r, err := c.tokenRefresher(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if r != nil {
+		cfg.Wrap(r.Wrap)
+	}
 
 	return cfg, nil
 }
 
+// SetTokenRefreshFn sets a callback notified on oidc token refreshes.
+func (c *Config) SetTokenRefreshFn(f TokenRefreshFunc) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.refreshFn = f
+}
+
+func (c *Config) notifyTokenRefresh(done bool, err error) {
+	c.mx.RLock()
+	f := c.refreshFn
+	c.mx.RUnlock()
+	if f != nil {
+		f(done, err)
+	}
+}
+
+// tokenRefresher returns the oidc token refresher for the given rest config if any.
+// Refreshers are kept across rest configs so refreshed tokens are shared by all clients.
+func (c *Config) tokenRefresher(cfg *restclient.Config) (*TokenRefresher, error) {
+	ap := cfg.AuthProvider
+	if ap == nil || ap.Name != oidcProvider {
+		return nil, nil
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	key := strings.Join([]string{ap.Config[oidcIssuerURL], ap.Config[oidcClientID], ap.Config[oidcIDToken]}, "|")
+	if c.refresher != nil && c.refreshKey == key {
+		return c.refresher, nil
+	}
+	r, err := NewTokenRefresher(ap, cfg.AuthConfigPersister, cfg.Proxy, c.notifyTokenRefresh)
+	if err != nil {
+		return nil, err
+	}
+	c.refresher, c.refreshKey = r, key
+
+	return r, nil
+}
+
 // Flags returns configuration flags.
 func (c *Config) Flags() *genericclioptions.ConfigFlags {
 	return c.flags
@@ -99,6 +149,16 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ForContext returns a new configuration targeting the given kubeconfig context.
+func (c *Config) ForContext(name string) (*Config, error) {
+	cfg := NewConfig(c.Flags())
+	if err := cfg.SwitchContext(name); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
 func (c *Config) Clone(ns string) (*genericclioptions.ConfigFlags, error) {
 	flags := genericclioptions.NewConfigFlags(false)
 	ct, err := c.CurrentContextName()
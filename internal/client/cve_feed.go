@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultCVEFeedURL tracks the NVD CVE API endpoint.
+	DefaultCVEFeedURL = "https://services.nvd.nist.gov/rest/json/cves/2.0"
+
+	cveDetailURL      = "https://nvd.nist.gov/vuln/detail/"
+	cveKernelCPE      = "cpe:2.3:o:linux:linux_kernel:"
+	cveDefaultTimeout = 10 * time.Second
+	cvePageSize       = 2000
+	cveMaxPages       = 10
+
+	// cveCacheTTL tracks how long a kernel CVE lookup is cached.
+	cveCacheTTL = 6 * time.Hour
+
+	// cveRetryTTL tracks how long a failed kernel CVE lookup is cached.
+	cveRetryTTL = 5 * time.Minute
+)
+
+// ErrNoCVEFeed indicates no CVE feed is configured.
+var ErrNoCVEFeed = errors.New("no cve feed configured")
+
+var (
+	cveFeed     atomic.Pointer[NVDFeed]
+	kernelRelRX = regexp.MustCompile(`^\d+\.\d+(\.\d+)?`)
+)
+
+// ConfigureCVEFeed sets up the shared kernel CVE feed. A nil config disables it.
+func ConfigureCVEFeed(cfg *CVEFeedConfig) error {
+	if cfg == nil {
+		cveFeed.Store(nil)
+		return nil
+	}
+	f, err := NewNVDFeed(*cfg)
+	if err != nil {
+		cveFeed.Store(nil)
+		return err
+	}
+	cveFeed.Store(f)
+
+	return nil
+}
+
+// KernelCVEFeed returns the shared kernel CVE feed or nil if not configured.
+func KernelCVEFeed() CVEFeed {
+	if f := cveFeed.Load(); f != nil {
+		return f
+	}
+
+	return nil
+}
+
+// CVEFeed represents a source of known kernel vulnerabilities.
+type CVEFeed interface {
+	// KernelCVEs returns the CVEs affecting the given kernel version.
+	KernelCVEs(kernelVersion string) ([]CVE, error)
+}
+
+// CVE represents a known vulnerability.
+type CVE struct {
+	ID       string
+	Score    float64
+	Severity string
+	URL      string
+}
+
+// CVEFeedConfig tracks a CVE feed connection settings.
+type CVEFeedConfig struct {
+	URL    string
+	APIKey string
+}
+
+type cveEntry struct {
+	cves    []CVE
+	err     error
+	expires time.Time
+}
+
+// NVDFeed queries the NVD CVE API for kernel vulnerabilities.
+// Lookups are cached per kernel release.
+type NVDFeed struct {
+	url     string
+	apiKey  string
+	client  *http.Client
+	timeout time.Duration
+	mx      sync.Mutex
+	cache   map[string]cveEntry
+}
+
+// NewNVDFeed returns a new feed for the given settings.
+func NewNVDFeed(cfg CVEFeedConfig) (*NVDFeed, error) {
+	u := cfg.URL
+	if u == "" {
+		u = DefaultCVEFeedURL
+	}
+	if _, err := url.ParseRequestURI(u); err != nil {
+		return nil, fmt.Errorf("invalid cve feed url %q: %w", u, err)
+	}
+
+	return &NVDFeed{
+		url:     u,
+		apiKey:  cfg.APIKey,
+		client:  &http.Client{},
+		timeout: cveDefaultTimeout,
+		cache:   make(map[string]cveEntry),
+	}, nil
+}
+
+// KernelRelease returns the upstream release of a node kernel version,
+// i.e. 5.15.0 for 5.15.0-1034-aws.
+func KernelRelease(v string) string {
+	return kernelRelRX.FindString(v)
+}
+
+// KernelCVEs returns the CVEs affecting the given kernel version, most severe first.
+// Matching is done against the upstream release so distro backports are not accounted for.
+func (f *NVDFeed) KernelCVEs(kernelVersion string) ([]CVE, error) {
+	rel := KernelRelease(kernelVersion)
+	if rel == "" {
+		return nil, fmt.Errorf("unable to parse kernel version %q", kernelVersion)
+	}
+
+	if e, ok := f.cached(rel); ok {
+		return e.cves, e.err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.timeout)
+	defer cancel()
+	cves, err := f.fetch(ctx, rel)
+	ttl := cveCacheTTL
+	if err != nil {
+		ttl = cveRetryTTL
+	}
+	f.mx.Lock()
+	f.cache[rel] = cveEntry{cves: cves, err: err, expires: time.Now().Add(ttl)}
+	f.mx.Unlock()
+
+	return cves, err
+}
+
+// cached returns an unexpired lookup for a kernel release.
+func (f *NVDFeed) cached(rel string) (cveEntry, bool) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	e, ok := f.cache[rel]
+
+	return e, ok && time.Now().Before(e.expires)
+}
+
+func (f *NVDFeed) fetch(ctx context.Context, rel string) ([]CVE, error) {
+	var cves []CVE
+	for page := range cveMaxPages {
+		cc, total, err := f.fetchPage(ctx, rel, page*cvePageSize)
+		if err != nil {
+			return nil, err
+		}
+		cves = append(cves, cc...)
+		if (page+1)*cvePageSize >= total {
+			break
+		}
+	}
+	sort.SliceStable(cves, func(i, j int) bool {
+		return cves[i].Score > cves[j].Score
+	})
+
+	return cves, nil
+}
+
+type nvdMetric struct {
+	BaseSeverity string `json:"baseSeverity"`
+	CvssData     struct {
+		BaseScore    float64 `json:"baseScore"`
+		BaseSeverity string  `json:"baseSeverity"`
+	} `json:"cvssData"`
+}
+
+func (f *NVDFeed) fetchPage(ctx context.Context, rel string, start int) ([]CVE, int, error) {
+	q := url.Values{
+		"virtualMatchString": {cveKernelCPE + rel},
+		"resultsPerPage":     {strconv.Itoa(cvePageSize)},
+		"startIndex":         {strconv.Itoa(start)},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url+"?"+q.Encode(), http.NoBody)
+	if err != nil {
+		return nil, 0, err
+	}
+	if f.apiKey != "" {
+		req.Header.Set("apiKey", f.apiKey)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("cve feed query failed with status %s", resp.Status)
+	}
+
+	var res struct {
+		TotalResults    int `json:"totalResults"`
+		Vulnerabilities []struct {
+			CVE struct {
+				ID      string `json:"id"`
+				Metrics struct {
+					V31 []nvdMetric `json:"cvssMetricV31"`
+					V30 []nvdMetric `json:"cvssMetricV30"`
+					V2  []nvdMetric `json:"cvssMetricV2"`
+				} `json:"metrics"`
+			} `json:"cve"`
+		} `json:"vulnerabilities"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, 0, fmt.Errorf("unable to decode cve feed response: %w", err)
+	}
+
+	cc := make([]CVE, 0, len(res.Vulnerabilities))
+	for _, v := range res.Vulnerabilities {
+		if v.CVE.ID == "" {
+			continue
+		}
+		c := CVE{ID: v.CVE.ID, URL: cveDetailURL + v.CVE.ID}
+		for _, mm := range [][]nvdMetric{v.CVE.Metrics.V31, v.CVE.Metrics.V30, v.CVE.Metrics.V2} {
+			if len(mm) == 0 {
+				continue
+			}
+			c.Score, c.Severity = mm[0].CvssData.BaseScore, mm[0].CvssData.BaseSeverity
+			if c.Severity == "" {
+				c.Severity = mm[0].BaseSeverity
+			}
+			break
+		}
+		cc = append(cc, c)
+	}
+
+	return cc, res.TotalResults, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nvdResponse = `{
+  "totalResults": 3,
+  "vulnerabilities": [
+    {"cve": {"id": "CVE-2023-0001", "metrics": {"cvssMetricV2": [{"baseSeverity": "MEDIUM", "cvssData": {"baseScore": 5.0}}]}}},
+    {"cve": {"id": "CVE-2023-0002", "metrics": {"cvssMetricV31": [{"cvssData": {"baseScore": 9.8, "baseSeverity": "CRITICAL"}}]}}},
+    {"cve": {"id": "CVE-2023-0003", "metrics": {}}}
+  ]
+}`
+
+func TestKernelRelease(t *testing.T) {
+	uu := map[string]struct {
+		v, e string
+	}{
+		"plain":  {v: "6.1.0", e: "6.1.0"},
+		"ubuntu": {v: "5.15.0-1034-aws", e: "5.15.0"},
+		"amzn":   {v: "4.14.326-245.539.amzn2.x86_64", e: "4.14.326"},
+		"short":  {v: "6.8-rc1", e: "6.8"},
+		"toast":  {v: "linux", e: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, KernelRelease(u.v))
+		})
+	}
+}
+
+func TestNVDFeedKernelCVEs(t *testing.T) {
+	var calls int
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "cpe:2.3:o:linux:linux_kernel:5.15.0", r.URL.Query().Get("virtualMatchString"))
+		assert.Equal(t, "fred", r.Header.Get("apiKey"))
+		_, _ = w.Write([]byte(nvdResponse))
+	}))
+	t.Cleanup(s.Close)
+
+	f, err := NewNVDFeed(CVEFeedConfig{URL: s.URL, APIKey: "fred"})
+	require.NoError(t, err)
+
+	cc, err := f.KernelCVEs("5.15.0-1034-aws")
+	require.NoError(t, err)
+	assert.Equal(t, []CVE{
+		{ID: "CVE-2023-0002", Score: 9.8, Severity: "CRITICAL", URL: cveDetailURL + "CVE-2023-0002"},
+		{ID: "CVE-2023-0001", Score: 5.0, Severity: "MEDIUM", URL: cveDetailURL + "CVE-2023-0001"},
+		{ID: "CVE-2023-0003", URL: cveDetailURL + "CVE-2023-0003"},
+	}, cc)
+
+	_, err = f.KernelCVEs("5.15.0-1040-aws")
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestNVDFeedKernelCVEsFailed(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(s.Close)
+
+	f, err := NewNVDFeed(CVEFeedConfig{URL: s.URL})
+	require.NoError(t, err)
+
+	_, err = f.KernelCVEs("6.1.0")
+	require.EqualError(t, err, "cve feed query failed with status 403 Forbidden")
+
+	_, err = f.KernelCVEs("fred")
+	require.EqualError(t, err, `unable to parse kernel version "fred"`)
+}
+
+func TestConfigureCVEFeed(t *testing.T) {
+	require.NoError(t, ConfigureCVEFeed(&CVEFeedConfig{}))
+	assert.NotNil(t, KernelCVEFeed())
+
+	require.Error(t, ConfigureCVEFeed(&CVEFeedConfig{URL: "nvd"}))
+	assert.Nil(t, KernelCVEFeed())
+
+	require.NoError(t, ConfigureCVEFeed(nil))
+	assert.Nil(t, KernelCVEFeed())
+}
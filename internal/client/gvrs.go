@@ -21,7 +21,9 @@ var (
 	SvcGVR  = NewGVR("v1/services")
 
 	// Autoscaling...
-	HpaGVR = NewGVR("autoscaling/v1/horizontalpodautoscalers")
+	HpaGVR   = NewGVR("autoscaling/v1/horizontalpodautoscalers")
+	HpaV2GVR = NewGVR("autoscaling/v2/horizontalpodautoscalers")
+	VpaGVR   = NewGVR("autoscaling.k8s.io/v1/verticalpodautoscalers")
 
 	// Batch...
 	CjGVR  = NewGVR("batch/v1/cronjobs")
@@ -31,6 +33,7 @@ var (
 	CrdGVR = NewGVR("apiextensions.k8s.io/v1/customresourcedefinitions")
 	PcGVR  = NewGVR("scheduling.k8s.io/v1/priorityclasses")
 	NpGVR  = NewGVR("networking.k8s.io/v1/networkpolicies")
+	IngGVR = NewGVR("networking.k8s.io/v1/ingresses")
 	ScGVR  = NewGVR("storage.k8s.io/v1/storageclasses")
 
 	// Policy...
@@ -53,6 +56,10 @@ var (
 	DirGVR = NewGVR("dirs")
 	PfGVR  = NewGVR("portforwards")
 	SdGVR  = NewGVR("screendumps")
+	MwGVR  = NewGVR("maintenance")
+	NplGVR = NewGVR("nodepools")
+	QuoGVR = NewGVR("quotausages")
+	SrcGVR = NewGVR("search")
 	BeGVR  = NewGVR("benchmarks")
 	AliGVR = NewGVR("aliases")
 	XGVR   = NewGVR("xrays")
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -22,21 +23,34 @@ const (
 	mxCacheExpiry = 1 * time.Minute
 )
 
-// MetricsDial tracks global metric server handle.
-var MetricsDial *MetricsServer
+// metricsDials tracks the metrics server handles per connection.
+var metricsDials = struct {
+	dials map[Connection]*MetricsServer
+	mx    sync.Mutex
+}{
+	dials: make(map[Connection]*MetricsServer),
+}
 
-// DialMetrics dials the metrics server.
+// DialMetrics dials the metrics server of the given connection.
 func DialMetrics(c Connection) *MetricsServer {
-	if MetricsDial == nil {
-		MetricsDial = NewMetricsServer(c)
+	metricsDials.mx.Lock()
+	defer metricsDials.mx.Unlock()
+
+	if m, ok := metricsDials.dials[c]; ok {
+		return m
 	}
+	m := NewMetricsServer(c)
+	metricsDials.dials[c] = m
 
-	return MetricsDial
+	return m
 }
 
-// ResetMetrics resets the metric server handle.
-func ResetMetrics() {
-	MetricsDial = nil
+// ResetMetrics resets the metric server handle of the given connection.
+func ResetMetrics(c Connection) {
+	metricsDials.mx.Lock()
+	defer metricsDials.mx.Unlock()
+
+	delete(metricsDials.dials, c)
 }
 
 // MetricsServer serves cluster metrics for nodes and pods.
@@ -146,6 +160,67 @@ func (m *MetricsServer) FetchNodesMetricsMap(ctx context.Context) (NodesMetricsM
 	return hh, nil
 }
 
+// FetchMergedNodesMetricsMap samples the nodes metrics count times and merges the results.
+// Metrics server replicas behind a load balancer may each report a partial node list.
+func (m *MetricsServer) FetchMergedNodesMetricsMap(ctx context.Context, count int) (NodesMetricsMap, error) {
+	const msg = "user is not authorized to list node metrics"
+	if err := m.checkAccess(ClusterScope, NmxGVR, msg); err != nil {
+		return nil, err
+	}
+
+	const key = "nodes-merged"
+	if entry, ok := m.cache.Get(key); ok && entry != nil {
+		mmx, ok := entry.(NodesMetricsMap)
+		if !ok {
+			return nil, fmt.Errorf("expected nodesmetricsmap but got %T", entry)
+		}
+		return mmx, nil
+	}
+
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+	mm := make([]NodesMetricsMap, 0, count)
+	for range count {
+		mxList, err := client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		hh := make(NodesMetricsMap, len(mxList.Items))
+		for i := range mxList.Items {
+			hh[mxList.Items[i].Name] = &mxList.Items[i]
+		}
+		mm = append(mm, hh)
+	}
+	mmx := MergeNodesMetricsMaps(mm...)
+	m.cache.Add(key, mmx, mxCacheExpiry)
+
+	return mmx, nil
+}
+
+// MergeNodesMetricsMaps union merges the given node metrics maps.
+// Conflicting entries resolve to the most recent sample.
+func MergeNodesMetricsMaps(maps ...NodesMetricsMap) NodesMetricsMap {
+	var size int
+	for _, m := range maps {
+		size = max(size, len(m))
+	}
+	res := make(NodesMetricsMap, size)
+	for _, m := range maps {
+		for name, mx := range m {
+			if mx == nil {
+				continue
+			}
+			if prev, ok := res[name]; !ok || mx.Timestamp.After(prev.Timestamp.Time) {
+				res[name] = mx
+			}
+		}
+	}
+
+	return res
+}
+
 // FetchNodesMetrics return all metrics for nodes.
 func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
 	const msg = "user is not authorized to list node metrics"
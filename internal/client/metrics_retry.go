@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// DefaultMetricsMaxRetries tracks the default number of metrics server retries.
+	DefaultMetricsMaxRetries = 2
+
+	// DefaultMetricsBackoff tracks the default initial metrics server retry back-off.
+	DefaultMetricsBackoff = 250 * time.Millisecond
+)
+
+var metricsRetry = struct {
+	maxRetries int
+	backoff    time.Duration
+	mx         sync.RWMutex
+}{
+	maxRetries: DefaultMetricsMaxRetries,
+	backoff:    DefaultMetricsBackoff,
+}
+
+// SetMetricsRetry configures the metrics server retry options.
+func SetMetricsRetry(maxRetries int, backoff time.Duration) {
+	metricsRetry.mx.Lock()
+	defer metricsRetry.mx.Unlock()
+
+	metricsRetry.maxRetries, metricsRetry.backoff = maxRetries, backoff
+}
+
+var multiMetricsEndpoints atomic.Int64
+
+// SetMultiMetricsEndpoints configures the number of load balanced metrics server
+// replicas to sample when fetching nodes metrics.
+func SetMultiMetricsEndpoints(n int) {
+	multiMetricsEndpoints.Store(int64(n))
+}
+
+// MultiMetricsEndpoints returns the number of metrics server replicas to sample.
+func MultiMetricsEndpoints() int {
+	return int(multiMetricsEndpoints.Load())
+}
+
+// DialRetryingMetrics dials the metrics server retrying transient failures.
+func DialRetryingMetrics(c Connection) *RetryingMetricsClient {
+	metricsRetry.mx.RLock()
+	defer metricsRetry.mx.RUnlock()
+
+	return NewRetryingMetricsClient(DialMetrics(c), metricsRetry.maxRetries, metricsRetry.backoff)
+}
+
+// RetryingMetricsClient retries transient metrics server failures
+// using an exponential back-off with jitter.
+type RetryingMetricsClient struct {
+	*MetricsServer
+
+	MaxRetries      int
+	BackoffDuration time.Duration
+
+	sleepFn func(context.Context, time.Duration) error
+}
+
+// NewRetryingMetricsClient returns a new instance.
+func NewRetryingMetricsClient(m *MetricsServer, maxRetries int, backoff time.Duration) *RetryingMetricsClient {
+	return &RetryingMetricsClient{
+		MetricsServer:   m,
+		MaxRetries:      maxRetries,
+		BackoffDuration: backoff,
+		sleepFn:         sleep,
+	}
+}
+
+// FetchNodesMetricsMap fetch node metrics as a map.
+func (r *RetryingMetricsClient) FetchNodesMetricsMap(ctx context.Context) (NodesMetricsMap, error) {
+	return withRetry(ctx, r, func() (NodesMetricsMap, error) {
+		return r.MetricsServer.FetchNodesMetricsMap(ctx)
+	})
+}
+
+// FetchMergedNodesMetricsMap samples and merges nodes metrics across metrics server replicas.
+func (r *RetryingMetricsClient) FetchMergedNodesMetricsMap(ctx context.Context, count int) (NodesMetricsMap, error) {
+	return withRetry(ctx, r, func() (NodesMetricsMap, error) {
+		return r.MetricsServer.FetchMergedNodesMetricsMap(ctx, count)
+	})
+}
+
+// FetchNodesMetrics return all metrics for nodes.
+func (r *RetryingMetricsClient) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	return withRetry(ctx, r, func() (*mv1beta1.NodeMetricsList, error) {
+		return r.MetricsServer.FetchNodesMetrics(ctx)
+	})
+}
+
+// FetchNodeMetrics return a given node metrics.
+func (r *RetryingMetricsClient) FetchNodeMetrics(ctx context.Context, n string) (*mv1beta1.NodeMetrics, error) {
+	return withRetry(ctx, r, func() (*mv1beta1.NodeMetrics, error) {
+		return r.MetricsServer.FetchNodeMetrics(ctx, n)
+	})
+}
+
+// FetchPodsMetricsMap fetch pods metrics as a map.
+func (r *RetryingMetricsClient) FetchPodsMetricsMap(ctx context.Context, ns string) (PodsMetricsMap, error) {
+	return withRetry(ctx, r, func() (PodsMetricsMap, error) {
+		return r.MetricsServer.FetchPodsMetricsMap(ctx, ns)
+	})
+}
+
+// backoff returns the delay before the given retry attempt, with up to 50% jitter.
+func (r *RetryingMetricsClient) backoff(attempt int) time.Duration {
+	d := r.BackoffDuration << attempt
+	if d <= 0 {
+		return 0
+	}
+
+	return d/2 + rand.N(d/2+1)
+}
+
+func withRetry[T any](ctx context.Context, r *RetryingMetricsClient, fn func() (T, error)) (T, error) {
+	var attempt int
+	for {
+		res, err := fn()
+		if err == nil || attempt >= r.MaxRetries || !IsTransientMetricsError(err) {
+			return res, err
+		}
+		d := r.backoff(attempt)
+		slog.Debug("Metrics server call failed. Retrying",
+			slogs.Error, err,
+			slogs.Elapsed, d,
+		)
+		if e := r.sleepFn(ctx, d); e != nil {
+			return res, err
+		}
+		attempt++
+	}
+}
+
+// IsTransientMetricsError returns true if a metrics server call failure is worth retrying.
+func IsTransientMetricsError(err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case apierrors.IsServiceUnavailable(err),
+		apierrors.IsTimeout(err),
+		apierrors.IsServerTimeout(err),
+		apierrors.IsTooManyRequests(err),
+		apierrors.IsUnexpectedServerError(err):
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
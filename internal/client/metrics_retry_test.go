@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsTransientMetricsError(t *testing.T) {
+	gr := schema.GroupResource{Group: "metrics.k8s.io", Resource: "nodes"}
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"nil":         {},
+		"unavailable": {err: apierrors.NewServiceUnavailable("blee"), e: true},
+		"timeout":     {err: apierrors.NewTimeoutError("blee", 1), e: true},
+		"throttled":   {err: apierrors.NewTooManyRequests("blee", 1), e: true},
+		"deadline":    {err: context.DeadlineExceeded, e: true},
+		"not-found":   {err: apierrors.NewNotFound(gr, "n1")},
+		"forbidden":   {err: apierrors.NewForbidden(gr, "n1", errors.New("blee"))},
+		"other":       {err: errors.New("blee")},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsTransientMetricsError(u.err))
+		})
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	unavailable := apierrors.NewServiceUnavailable("blee")
+	uu := map[string]struct {
+		errs     []error
+		calls    int
+		err      error
+		sleepErr error
+	}{
+		"ok": {
+			calls: 1,
+		},
+		"recovered": {
+			errs:  []error{unavailable, unavailable},
+			calls: 3,
+		},
+		"exhausted": {
+			errs:  []error{unavailable, unavailable, unavailable, unavailable},
+			calls: 4,
+			err:   unavailable,
+		},
+		"permanent": {
+			errs:  []error{apierrors.NewNotFound(schema.GroupResource{}, "n1")},
+			calls: 1,
+			err:   apierrors.NewNotFound(schema.GroupResource{}, "n1"),
+		},
+		"canceled": {
+			errs:     []error{unavailable, unavailable},
+			calls:    1,
+			err:      unavailable,
+			sleepErr: context.Canceled,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var delays []time.Duration
+			r := NewRetryingMetricsClient(nil, 3, 100*time.Millisecond)
+			r.sleepFn = func(_ context.Context, d time.Duration) error {
+				delays = append(delays, d)
+				return u.sleepErr
+			}
+			var calls int
+			_, err := withRetry(context.Background(), r, func() (int, error) {
+				calls++
+				if calls <= len(u.errs) {
+					return 0, u.errs[calls-1]
+				}
+				return 1, nil
+			})
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.calls, calls)
+			for i, d := range delays {
+				base := 100 * time.Millisecond << i
+				assert.GreaterOrEqual(t, d, base/2)
+				assert.LessOrEqual(t, d, base)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package client_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/stretchr/testify/assert"
@@ -342,3 +343,38 @@ func makeRes(c, m string) v1.ResourceList {
 		v1.ResourceMemory: mem,
 	}
 }
+
+func TestMergeNodesMetricsMaps(t *testing.T) {
+	t0 := time.Now()
+	mx := func(name string, ts time.Time) *v1beta1.NodeMetrics {
+		return &v1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Timestamp:  metav1.NewTime(ts),
+		}
+	}
+	n1Old, n1New, n2, n3 := mx("n1", t0), mx("n1", t0.Add(time.Minute)), mx("n2", t0), mx("n3", t0)
+
+	uu := map[string]struct {
+		mm []client.NodesMetricsMap
+		e  client.NodesMetricsMap
+	}{
+		"none": {
+			e: client.NodesMetricsMap{},
+		},
+		"partial": {
+			mm: []client.NodesMetricsMap{{"n1": n1Old}, {"n2": n2, "n3": n3}},
+			e:  client.NodesMetricsMap{"n1": n1Old, "n2": n2, "n3": n3},
+		},
+		"newest": {
+			mm: []client.NodesMetricsMap{{"n1": n1New, "n2": n2}, {"n1": n1Old, "n3": nil}},
+			e:  client.NodesMetricsMap{"n1": n1New, "n2": n2},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.MergeNodesMetricsMaps(u.mm...))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	// poolWindow tracks the number of recent requests used to compute the error rate.
+	poolWindow = 50
+
+	// poolMinSamples tracks the number of requests required before assessing health.
+	poolMinSamples = 10
+
+	// poolLatencyWeight tracks the weight of the latest request in the latency average.
+	poolLatencyWeight = 0.2
+
+	// DegradedErrorRate tracks the error rate past which a connection is deemed degraded.
+	DegradedErrorRate = 0.5
+
+	// DegradedLatency tracks the average latency past which a connection is deemed degraded.
+	// LIST and WATCH requests are excluded from the average as their latency scales
+	// with the cluster size.
+	DegradedLatency = 5 * time.Second
+)
+
+// PoolHealth tracks an api server connection pool health.
+type PoolHealth struct {
+	// Latency tracks the moving average of requests latency.
+	Latency time.Duration
+
+	// ErrorRate tracks the ratio of failed recent requests.
+	ErrorRate float64
+
+	// ActiveRequests tracks the number of in-flight requests.
+	ActiveRequests int
+
+	// Samples tracks the number of recent requests the health is based on.
+	Samples int
+}
+
+// Degraded returns true if the connection is failing or too slow.
+func (h PoolHealth) Degraded() bool {
+	if h.Samples < poolMinSamples {
+		return false
+	}
+
+	return h.ErrorRate >= DegradedErrorRate || h.Latency >= DegradedLatency
+}
+
+// String returns the health summary.
+func (h PoolHealth) String() string {
+	if h.Samples == 0 {
+		return NA
+	}
+
+	return fmt.Sprintf("%s %d%%err %dreq", h.Latency.Round(time.Millisecond), int(h.ErrorRate*100), h.ActiveRequests)
+}
+
+// ConnectionPool shares a single keep-alive transport across all clients dialing
+// a cluster endpoint and tracks the requests health.
+type ConnectionPool struct {
+	host      string
+	transport http.RoundTripper
+	mx        sync.RWMutex
+	latency   time.Duration
+	outcomes  [poolWindow]bool
+	count     int
+	active    int
+}
+
+// NewConnectionPool returns a new pool for the given cluster endpoint.
+func NewConnectionPool(cfg *restclient.Config) (*ConnectionPool, error) {
+	rt, err := restclient.TransportFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	p := ConnectionPool{host: cfg.Host}
+	p.transport = &poolTransport{pool: &p, rt: rt}
+
+	return &p, nil
+}
+
+// Host returns the pool cluster endpoint.
+func (p *ConnectionPool) Host() string {
+	return p.host
+}
+
+// HTTPClient returns an http client using the pooled connections.
+func (p *ConnectionPool) HTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: p.transport,
+		Timeout:   timeout,
+	}
+}
+
+// Health returns the pool current health.
+func (p *ConnectionPool) Health() PoolHealth {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	h := PoolHealth{
+		Latency:        p.latency,
+		ActiveRequests: p.active,
+		Samples:        min(p.count, poolWindow),
+	}
+	if h.Samples == 0 {
+		return h
+	}
+	var errs int
+	for _, failed := range p.outcomes[:h.Samples] {
+		if failed {
+			errs++
+		}
+	}
+	h.ErrorRate = float64(errs) / float64(h.Samples)
+
+	return h
+}
+
+// Close releases the pooled connections.
+func (p *ConnectionPool) Close() {
+	utilnet.CloseIdleConnectionsFor(p.transport)
+}
+
+func (p *ConnectionPool) begin() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.active++
+}
+
+func (p *ConnectionPool) abort() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.active--
+}
+
+func (p *ConnectionPool) end(d time.Duration, failed, timed bool) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.active--
+	p.outcomes[p.count%poolWindow] = failed
+	p.count++
+	if failed || !timed {
+		return
+	}
+	if p.latency == 0 {
+		p.latency = d
+		return
+	}
+	p.latency += time.Duration(poolLatencyWeight * float64(d-p.latency))
+}
+
+// poolTransport instruments the requests going through a connection pool.
+type poolTransport struct {
+	pool *ConnectionPool
+	rt   http.RoundTripper
+}
+
+var _ utilnet.RoundTripperWrapper = (*poolTransport)(nil)
+
+// RoundTrip executes a request tracking its latency and outcome.
+func (t *poolTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.pool.begin()
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	if errors.Is(err, context.Canceled) {
+		t.pool.abort()
+		return resp, err
+	}
+	t.pool.end(time.Since(start), err != nil || resp.StatusCode >= http.StatusInternalServerError, !isCollectionRequest(req))
+
+	return resp, err
+}
+
+// WrappedRoundTripper returns the pooled transport.
+func (t *poolTransport) WrappedRoundTripper() http.RoundTripper {
+	return t.rt
+}
+
+// isCollectionRequest returns true if the request lists or watches a resource collection.
+func isCollectionRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	if req.URL.Query().Get("watch") == "true" {
+		return true
+	}
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	switch {
+	case len(parts) > 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) > 3 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return false
+	}
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+
+	return len(parts) == 1
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestConnectionPoolHealth(t *testing.T) {
+	var fail atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewConnectionPool(&restclient.Config{Host: srv.URL})
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL, p.Host())
+	assert.Equal(t, client.PoolHealth{}, p.Health())
+	assert.Equal(t, client.NA, p.Health().String())
+
+	c := p.HTTPClient(time.Second)
+	get := func() {
+		resp, err := c.Get(srv.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	for range 10 {
+		get()
+	}
+	h := p.Health()
+	assert.Equal(t, 10, h.Samples)
+	assert.Zero(t, h.ErrorRate)
+	assert.Zero(t, h.ActiveRequests)
+	assert.Positive(t, h.Latency)
+	assert.False(t, h.Degraded())
+
+	fail.Store(true)
+	for range 10 {
+		get()
+	}
+	h = p.Health()
+	assert.Equal(t, 20, h.Samples)
+	assert.InDelta(t, 0.5, h.ErrorRate, 0.001)
+	assert.True(t, h.Degraded())
+}
+
+func TestConnectionPoolCollections(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewConnectionPool(&restclient.Config{Host: srv.URL})
+	require.NoError(t, err)
+
+	c := p.HTTPClient(time.Second)
+	for _, path := range []string{
+		"/api/v1/pods",
+		"/api/v1/namespaces",
+		"/api/v1/namespaces/ns1/pods",
+		"/apis/apps/v1/namespaces/ns1/deployments",
+		"/api/v1/namespaces/ns1/pods/p1?watch=true",
+	} {
+		resp, err := c.Get(srv.URL + path)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+	}
+	h := p.Health()
+	assert.Equal(t, 5, h.Samples)
+	assert.Zero(t, h.Latency)
+
+	resp, err := c.Get(srv.URL + "/api/v1/namespaces/ns1/pods/p1")
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Positive(t, p.Health().Latency)
+}
+
+func TestConnectionPoolCanceled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewConnectionPool(&restclient.Config{Host: srv.URL})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, http.NoBody)
+	require.NoError(t, err)
+	_, err = p.HTTPClient(0).Do(req)
+	require.Error(t, err)
+	assert.Equal(t, client.PoolHealth{}, p.Health())
+}
+
+func TestPoolHealthDegraded(t *testing.T) {
+	uu := map[string]struct {
+		h client.PoolHealth
+		e bool
+	}{
+		"empty": {},
+		"few-samples": {
+			h: client.PoolHealth{Samples: 2, ErrorRate: 1},
+		},
+		"healthy": {
+			h: client.PoolHealth{Samples: 20, ErrorRate: 0.1, Latency: 100 * time.Millisecond},
+		},
+		"errors": {
+			h: client.PoolHealth{Samples: 20, ErrorRate: 0.5},
+			e: true,
+		},
+		"slow": {
+			h: client.PoolHealth{Samples: 20, Latency: client.DegradedLatency},
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.h.Degraded())
+		})
+	}
+}
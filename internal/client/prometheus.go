@@ -0,0 +1,354 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	promQueryPath      = "/api/v1/query"
+	promDefaultTimeout = 5 * time.Second
+	bearerPrefix       = "Bearer "
+
+	// promCacheTTL tracks how long query results are reused before a refresh.
+	promCacheTTL = 30 * time.Second
+
+	// promThrottleQuery computes the ratio of throttled cpu periods per node.
+	promThrottleQuery = `sum by (node) (rate(container_cpu_cfs_throttled_periods_total%[1]s[5m])) / sum by (node) (rate(container_cpu_cfs_periods_total%[1]s[5m]))`
+
+	// promOOMQuery counts the containers OOM kills per pod over the last day.
+	promOOMQuery = `sum by (namespace, pod) (increase(container_oom_events_total%s[24h]))`
+)
+
+var prometheus = struct {
+	mx      sync.RWMutex
+	clients map[string]*PrometheusClient
+}{
+	clients: make(map[string]*PrometheusClient),
+}
+
+// ConfigurePrometheus sets up the Prometheus client for a given context. A nil config
+// disables it. An unchanged config keeps the current client and its cached results.
+func ConfigurePrometheus(context string, cfg *PrometheusConfig) error {
+	prometheus.mx.Lock()
+	defer prometheus.mx.Unlock()
+
+	if cfg == nil {
+		delete(prometheus.clients, context)
+		return nil
+	}
+	if p, ok := prometheus.clients[context]; ok && p.cfg == *cfg {
+		return nil
+	}
+	p, err := NewPrometheusClient(*cfg)
+	if err != nil {
+		delete(prometheus.clients, context)
+		return err
+	}
+	prometheus.clients[context] = p
+
+	return nil
+}
+
+// HasPrometheus checks if a Prometheus client is configured for any context.
+func HasPrometheus() bool {
+	prometheus.mx.RLock()
+	defer prometheus.mx.RUnlock()
+
+	return len(prometheus.clients) > 0
+}
+
+// Prometheus returns the given context Prometheus client or nil if not configured.
+func Prometheus(context string) *PrometheusClient {
+	prometheus.mx.RLock()
+	defer prometheus.mx.RUnlock()
+
+	return prometheus.clients[context]
+}
+
+// PrometheusConfig tracks a Prometheus server connection settings.
+type PrometheusConfig struct {
+	URL                string
+	BearerToken        string
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
+}
+
+// PromSample represents an instant vector sample.
+type PromSample struct {
+	Metric map[string]string
+	Value  float64
+}
+
+// PrometheusClient queries a Prometheus server using PromQL.
+type PrometheusClient struct {
+	cfg     PrometheusConfig
+	url     string
+	token   string
+	client  *http.Client
+	timeout time.Duration
+	mx      sync.Mutex
+	cache   map[string]*promEntry
+}
+
+// promEntry tracks a cached query result.
+type promEntry struct {
+	val     any
+	fetched time.Time
+	loading bool
+	failing bool
+}
+
+// NewPrometheusClient returns a new client for the given server.
+func NewPrometheusClient(cfg PrometheusConfig) (*PrometheusClient, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("no prometheus url configured")
+	}
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf("invalid prometheus url %q: %w", cfg.URL, err)
+	}
+	tlsCfg, err := promTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	tr.TLSClientConfig = tlsCfg
+
+	return &PrometheusClient{
+		cfg:     cfg,
+		url:     strings.TrimSuffix(cfg.URL, "/"),
+		token:   cfg.BearerToken,
+		client:  &http.Client{Transport: tr, Timeout: promDefaultTimeout},
+		timeout: promDefaultTimeout,
+		cache:   make(map[string]*promEntry),
+	}, nil
+}
+
+func promTLSConfig(cfg PrometheusConfig) (*tls.Config, error) {
+	//nolint:gosec
+	tlsCfg := tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+	if cfg.CAFile != "" {
+		bb, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read prometheus ca file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bb) {
+			return nil, fmt.Errorf("no valid certificates found in %q", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load prometheus client certificate: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return &tlsCfg, nil
+}
+
+// FetchNodeCPUThrottle returns the ratio of throttled cpu periods for a node containers.
+func (p *PrometheusClient) FetchNodeCPUThrottle(nodeName string) (float64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	ss, err := p.Query(ctx, fmt.Sprintf(promThrottleQuery, promSelector("node", nodeName)))
+	if err != nil || len(ss) == 0 {
+		return 0, err
+	}
+
+	return ss[0].Value, nil
+}
+
+// FetchNodesCPUThrottle returns the ratio of throttled cpu periods keyed by node name.
+func (p *PrometheusClient) FetchNodesCPUThrottle(ctx context.Context) (map[string]float64, error) {
+	ss, err := p.Query(ctx, fmt.Sprintf(promThrottleQuery, ""))
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]float64, len(ss))
+	for _, s := range ss {
+		mm[s.Metric["node"]] = s.Value
+	}
+
+	return mm, nil
+}
+
+// FetchPodOOMKills returns the number of OOM killed containers in a pod over the last day.
+func (p *PrometheusClient) FetchPodOOMKills(namespace, podName string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	ss, err := p.Query(ctx, fmt.Sprintf(promOOMQuery, promSelector("namespace", namespace, "pod", podName)))
+	if err != nil || len(ss) == 0 {
+		return 0, err
+	}
+
+	return int(math.Round(ss[0].Value)), nil
+}
+
+// FetchPodsOOMKills returns the number of OOM killed containers over the last day keyed
+// by pod fully qualified name.
+func (p *PrometheusClient) FetchPodsOOMKills(ctx context.Context, ns string) (map[string]int, error) {
+	var sel string
+	if IsNamespaced(ns) {
+		sel = promSelector("namespace", ns)
+	}
+	ss, err := p.Query(ctx, fmt.Sprintf(promOOMQuery, sel))
+	if err != nil {
+		return nil, err
+	}
+	mm := make(map[string]int, len(ss))
+	for _, s := range ss {
+		mm[FQN(s.Metric["namespace"], s.Metric["pod"])] = int(math.Round(s.Value))
+	}
+
+	return mm, nil
+}
+
+// CachedNodesCPUThrottle returns the last known nodes cpu throttling ratios and refreshes
+// them in the background once stale. It never blocks on the server.
+func (p *PrometheusClient) CachedNodesCPUThrottle() map[string]float64 {
+	mm, _ := p.cached("throttle", func(ctx context.Context) (any, error) {
+		return p.FetchNodesCPUThrottle(ctx)
+	}).(map[string]float64)
+
+	return mm
+}
+
+// CachedPodsOOMKills returns the last known pods OOM kills in a namespace and refreshes
+// them in the background once stale. It never blocks on the server.
+func (p *PrometheusClient) CachedPodsOOMKills(ns string) map[string]int {
+	mm, _ := p.cached("oom:"+ns, func(ctx context.Context) (any, error) {
+		return p.FetchPodsOOMKills(ctx, ns)
+	}).(map[string]int)
+
+	return mm
+}
+
+// cached returns the last result for a query, kicking off a refresh when stale.
+func (p *PrometheusClient) cached(key string, fetch func(context.Context) (any, error)) any {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	e, ok := p.cache[key]
+	if !ok {
+		e = new(promEntry)
+		p.cache[key] = e
+	}
+	if !e.loading && time.Since(e.fetched) >= promCacheTTL {
+		e.loading = true
+		go p.refresh(key, e, fetch)
+	}
+
+	return e.val
+}
+
+// refresh runs a query and records its result. Failures are only logged when a query
+// starts or stops failing.
+func (p *PrometheusClient) refresh(key string, e *promEntry, fetch func(context.Context) (any, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	v, err := fetch(ctx)
+
+	p.mx.Lock()
+	defer p.mx.Unlock()
+	e.loading, e.fetched = false, time.Now()
+	if err != nil {
+		if !e.failing {
+			slog.Warn("Prometheus query failed", slogs.URL, p.url, slogs.Key, key, slogs.Error, err)
+		}
+		e.val, e.failing = nil, true
+		return
+	}
+	if e.failing {
+		slog.Info("Prometheus query recovered", slogs.URL, p.url, slogs.Key, key)
+	}
+	e.val, e.failing = v, false
+}
+
+// Query runs an instant PromQL query and returns the resulting vector.
+func (p *PrometheusClient) Query(ctx context.Context, q string) ([]PromSample, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+promQueryPath+"?"+url.Values{"query": {q}}.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	if p.token != "" {
+		req.Header.Set("Authorization", bearerPrefix+p.token)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var res struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string `json:"resultType"`
+			Result     []struct {
+				Metric map[string]string `json:"metric"`
+				Value  [2]any            `json:"value"`
+			} `json:"result"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("prometheus query failed with status %s: %w", resp.Status, err)
+	}
+	if res.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", res.Error)
+	}
+	if res.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("unexpected prometheus result type %q", res.Data.ResultType)
+	}
+
+	ss := make([]PromSample, 0, len(res.Data.Result))
+	for _, r := range res.Data.Result {
+		raw, ok := r.Value[1].(string)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil || math.IsNaN(v) {
+			continue
+		}
+		ss = append(ss, PromSample{Metric: r.Metric, Value: v})
+	}
+
+	return ss, nil
+}
+
+// promSelector returns a PromQL label selector matching the given label/value pairs.
+func promSelector(kv ...string) string {
+	mm := make([]string, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		mm = append(mm, kv[i]+"="+strconv.Quote(kv[i+1]))
+	}
+
+	return "{" + strings.Join(mm, ",") + "}"
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPromServer(t *testing.T, body string, queries *[]string) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, promQueryPath, r.URL.Path)
+		assert.Equal(t, "Bearer fred", r.Header.Get("Authorization"))
+		*queries = append(*queries, r.URL.Query().Get("query"))
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func TestNewPrometheusClient(t *testing.T) {
+	uu := map[string]struct {
+		cfg PrometheusConfig
+		err string
+	}{
+		"ok": {
+			cfg: PrometheusConfig{URL: "https://prom:9090"},
+		},
+		"no-url": {
+			err: "no prometheus url configured",
+		},
+		"bad-url": {
+			cfg: PrometheusConfig{URL: "prom"},
+			err: `invalid prometheus url "prom": parse "prom": invalid URI for request`,
+		},
+		"bad-ca": {
+			cfg: PrometheusConfig{URL: "https://prom:9090", CAFile: "/blee/ca.crt"},
+			err: "unable to read prometheus ca file: open /blee/ca.crt: no such file or directory",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := NewPrometheusClient(u.cfg)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPrometheusFetchNodeCPUThrottle(t *testing.T) {
+	var qq []string
+	s := newPromServer(t, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"node":"n1"},"value":[1700000000,"0.25"]}]}}`, &qq)
+	p, err := NewPrometheusClient(PrometheusConfig{URL: s.URL + "/", BearerToken: "fred"})
+	require.NoError(t, err)
+
+	v, err := p.FetchNodeCPUThrottle("n1")
+	require.NoError(t, err)
+	assert.InDelta(t, 0.25, v, 0.001)
+	assert.Equal(t, []string{
+		`sum by (node) (rate(container_cpu_cfs_throttled_periods_total{node="n1"}[5m])) / sum by (node) (rate(container_cpu_cfs_periods_total{node="n1"}[5m]))`,
+	}, qq)
+
+	mm, err := p.FetchNodesCPUThrottle(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"n1": 0.25}, mm)
+}
+
+func TestPrometheusFetchPodOOMKills(t *testing.T) {
+	var qq []string
+	s := newPromServer(t, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"namespace":"ns1","pod":"p1"},"value":[1700000000,"1.9"]},{"metric":{"namespace":"ns1","pod":"p2"},"value":[1700000000,"NaN"]}]}}`, &qq)
+	p, err := NewPrometheusClient(PrometheusConfig{URL: s.URL, BearerToken: "fred"})
+	require.NoError(t, err)
+
+	c, err := p.FetchPodOOMKills("ns1", "p1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, c)
+
+	mm, err := p.FetchPodsOOMKills(context.Background(), "ns1")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"ns1/p1": 2}, mm)
+	assert.Equal(t, []string{
+		`sum by (namespace, pod) (increase(container_oom_events_total{namespace="ns1",pod="p1"}[24h]))`,
+		`sum by (namespace, pod) (increase(container_oom_events_total{namespace="ns1"}[24h]))`,
+	}, qq)
+}
+
+func TestPrometheusQueryFailed(t *testing.T) {
+	var qq []string
+	s := newPromServer(t, `{"status":"error","errorType":"bad_data","error":"parse error"}`, &qq)
+	p, err := NewPrometheusClient(PrometheusConfig{URL: s.URL, BearerToken: "fred"})
+	require.NoError(t, err)
+
+	_, err = p.Query(context.Background(), "up")
+	assert.EqualError(t, err, "prometheus query failed: parse error")
+}
+
+func TestPrometheusCached(t *testing.T) {
+	var qq []string
+	s := newPromServer(t, `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"node":"n1"},"value":[1700000000,"0.5"]}]}}`, &qq)
+	p, err := NewPrometheusClient(PrometheusConfig{URL: s.URL, BearerToken: "fred"})
+	require.NoError(t, err)
+
+	assert.Nil(t, p.CachedNodesCPUThrottle())
+	assert.Eventually(t, func() bool {
+		return p.CachedNodesCPUThrottle() != nil
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, map[string]float64{"n1": 0.5}, p.CachedNodesCPUThrottle())
+	assert.Len(t, qq, 1)
+}
+
+func TestConfigurePrometheus(t *testing.T) {
+	cfg := PrometheusConfig{URL: "https://prom:9090"}
+	require.NoError(t, ConfigurePrometheus("ct1", &cfg))
+	t.Cleanup(func() {
+		_ = ConfigurePrometheus("ct1", nil)
+	})
+
+	p := Prometheus("ct1")
+	require.NotNil(t, p)
+	assert.Nil(t, Prometheus("ct2"))
+
+	require.NoError(t, ConfigurePrometheus("ct1", &cfg))
+	assert.Same(t, p, Prometheus("ct1"))
+
+	require.Error(t, ConfigurePrometheus("ct1", &PrometheusConfig{URL: "prom"}))
+	assert.Nil(t, Prometheus("ct1"))
+}
@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	oidcProvider      = "oidc"
+	oidcIssuerURL     = "idp-issuer-url"
+	oidcClientID      = "client-id"
+	oidcClientSecret  = "client-secret"
+	oidcIDToken       = "id-token"
+	oidcRefreshToken  = "refresh-token"
+	oidcCAFile        = "idp-certificate-authority"
+	oidcCAData        = "idp-certificate-authority-data"
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+
+	// oidcRefreshTimeout tracks how long an identity provider call may take.
+	oidcRefreshTimeout = 30 * time.Second
+)
+
+// TokenRefreshFunc notifies an OIDC token refresh started or completed.
+type TokenRefreshFunc func(done bool, err error)
+
+// TokenRefresher refreshes expired OIDC tokens when the api server rejects
+// a request and retries the request with the new token.
+// Refreshed tokens are written back to the kubeconfig via the given persister.
+type TokenRefresher struct {
+	mx        sync.Mutex
+	cfg       map[string]string
+	token     string
+	replaced  string
+	client    *http.Client
+	persister restclient.AuthProviderConfigPersister
+	notifyFn  TokenRefreshFunc
+}
+
+// NewTokenRefresher returns a new refresher or nil if the auth provider is not oidc.
+// The identity provider is reached using the provider certificate authority and the given proxy.
+func NewTokenRefresher(ap *api.AuthProviderConfig, p restclient.AuthProviderConfigPersister, proxy func(*http.Request) (*url.URL, error), notify TokenRefreshFunc) (*TokenRefresher, error) {
+	if ap == nil || ap.Name != oidcProvider || ap.Config[oidcRefreshToken] == "" {
+		return nil, nil
+	}
+	c, err := idpClient(ap.Config, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenRefresher{
+		cfg:       maps.Clone(ap.Config),
+		client:    c,
+		persister: p,
+		notifyFn:  notify,
+	}, nil
+}
+
+// idpClient returns an http client trusting the identity provider certificate authority if any.
+func idpClient(cfg map[string]string, proxy func(*http.Request) (*url.URL, error)) (*http.Client, error) {
+	var (
+		ca  []byte
+		err error
+	)
+	switch {
+	case cfg[oidcCAData] != "":
+		if ca, err = base64.StdEncoding.DecodeString(cfg[oidcCAData]); err != nil {
+			return nil, fmt.Errorf("invalid oidc %s: %w", oidcCAData, err)
+		}
+	case cfg[oidcCAFile] != "":
+		if ca, err = os.ReadFile(cfg[oidcCAFile]); err != nil {
+			return nil, fmt.Errorf("unable to read oidc %s: %w", oidcCAFile, err)
+		}
+	}
+	tlsCfg := tls.Config{MinVersion: tls.VersionTLS12}
+	if len(ca) > 0 {
+		tlsCfg.RootCAs = x509.NewCertPool()
+		if !tlsCfg.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, errors.New("no valid oidc identity provider certificate authority found")
+		}
+	}
+	if proxy == nil {
+		proxy = http.ProxyFromEnvironment
+	}
+
+	return &http.Client{
+		Timeout: oidcRefreshTimeout,
+		Transport: &http.Transport{
+			Proxy:           proxy,
+			TLSClientConfig: &tlsCfg,
+		},
+	}, nil
+}
+
+// Wrap wraps a transport to refresh the token on unauthorized responses.
+func (t *TokenRefresher) Wrap(rt http.RoundTripper) http.RoundTripper {
+	return &refreshRoundTripper{refresher: t, rt: rt}
+}
+
+// current returns the refreshed token to use in lieu of the given one if any.
+func (t *TokenRefresher) current(token string) string {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.token != "" && token == t.replaced {
+		return t.token
+	}
+
+	return token
+}
+
+// Refresh exchanges the refresh token for a new id token unless the stale
+// token was already refreshed by a concurrent request.
+func (t *TokenRefresher) Refresh(ctx context.Context, stale string) (string, error) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.token != "" && t.token != stale {
+		return t.token, nil
+	}
+
+	t.notify(false, nil)
+	id, err := t.refresh(ctx)
+	t.notify(true, err)
+	if err != nil {
+		return "", err
+	}
+	if t.replaced == "" {
+		t.replaced = stale
+	}
+	t.token = id
+
+	return id, nil
+}
+
+func (t *TokenRefresher) notify(done bool, err error) {
+	if t.notifyFn != nil {
+		t.notifyFn(done, err)
+	}
+}
+
+func (t *TokenRefresher) refresh(ctx context.Context) (string, error) {
+	endpoint, err := t.tokenEndpoint(ctx)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {t.cfg[oidcRefreshToken]},
+		"client_id":     {t.cfg[oidcClientID]},
+	}
+	if secret := t.cfg[oidcClientSecret]; secret != "" {
+		form.Set("client_secret", secret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var tok struct {
+		IDToken      string `json:"id_token"`
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := t.getJSON(req, &tok); err != nil {
+		return "", fmt.Errorf("oidc token refresh failed: %w", err)
+	}
+	if tok.IDToken == "" {
+		return "", errors.New("oidc token refresh failed: no id token returned")
+	}
+	t.cfg[oidcIDToken] = tok.IDToken
+	if tok.RefreshToken != "" {
+		t.cfg[oidcRefreshToken] = tok.RefreshToken
+	}
+	t.persist()
+
+	return tok.IDToken, nil
+}
+
+// persist writes the refreshed tokens back to the kubeconfig so rotated
+// refresh tokens survive restarts.
+func (t *TokenRefresher) persist() {
+	if t.persister == nil {
+		return
+	}
+	if err := t.persister.Persist(maps.Clone(t.cfg)); err != nil {
+		slog.Warn("Unable to persist refreshed oidc token", slogs.Error, err)
+	}
+}
+
+func (t *TokenRefresher) tokenEndpoint(ctx context.Context) (string, error) {
+	issuer := strings.TrimSuffix(t.cfg[oidcIssuerURL], "/")
+	if issuer == "" {
+		return "", errors.New("no oidc issuer url configured")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issuer+oidcDiscoveryPath, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+
+	var disc struct {
+		TokenEndpoint string `json:"token_endpoint"`
+	}
+	if err := t.getJSON(req, &disc); err != nil {
+		return "", fmt.Errorf("oidc discovery failed: %w", err)
+	}
+	if disc.TokenEndpoint == "" {
+		return "", errors.New("oidc discovery failed: no token endpoint")
+	}
+
+	return disc.TokenEndpoint, nil
+}
+
+func (t *TokenRefresher) getJSON(req *http.Request, v any) error {
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// refreshRoundTripper retries unauthorized requests once with a refreshed token.
+type refreshRoundTripper struct {
+	refresher *TokenRefresher
+	rt        http.RoundTripper
+}
+
+// RoundTrip executes a request, refreshing the token on unauthorized responses.
+func (r *refreshRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	stale := strings.TrimPrefix(req.Header.Get("Authorization"), bearerPrefix)
+	if tok := r.refresher.current(stale); tok != stale {
+		req = withToken(req, tok)
+		stale = tok
+	}
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	tok, err := r.refresher.Refresh(req.Context(), stale)
+	if err != nil {
+		slog.Warn("Unable to refresh oidc token", slogs.Error, err)
+		return resp, nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	retry := withToken(req, tok)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.rt.RoundTrip(retry)
+}
+
+func withToken(req *http.Request, tok string) *http.Request {
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", bearerPrefix+tok)
+
+	return r
+}
@@ -0,0 +1,231 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestNewTokenRefresher(t *testing.T) {
+	uu := map[string]struct {
+		ap *api.AuthProviderConfig
+		ok bool
+	}{
+		"none": {},
+		"gcp": {
+			ap: &api.AuthProviderConfig{Name: "gcp", Config: map[string]string{oidcRefreshToken: "rt"}},
+		},
+		"no-refresh-token": {
+			ap: &api.AuthProviderConfig{Name: oidcProvider},
+		},
+		"oidc": {
+			ap: &api.AuthProviderConfig{Name: oidcProvider, Config: map[string]string{oidcRefreshToken: "rt"}},
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, err := NewTokenRefresher(u.ap, nil, nil, nil)
+			require.NoError(t, err)
+			assert.Equal(t, u.ok, r != nil)
+		})
+	}
+}
+
+func TestTokenRefresherRoundTrip(t *testing.T) {
+	var refreshes atomic.Int32
+	idp := newTestIDP(t, &refreshes, false)
+	defer idp.Close()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		bb, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(append([]byte("ok:"), bb...))
+	}))
+	defer srv.Close()
+
+	var (
+		events []bool
+		p      testPersister
+	)
+	r, err := NewTokenRefresher(&api.AuthProviderConfig{
+		Name: oidcProvider,
+		Config: map[string]string{
+			oidcIssuerURL:    idp.URL,
+			oidcClientID:     "k9s",
+			oidcIDToken:      "stale",
+			oidcRefreshToken: "rt",
+		},
+	}, &p, nil, func(done bool, _ error) {
+		events = append(events, done)
+	})
+	require.NoError(t, err)
+	c := http.Client{Transport: r.Wrap(http.DefaultTransport)}
+
+	for range 2 {
+		req, err := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader("fred"))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer stale")
+		resp, err := c.Do(req)
+		require.NoError(t, err)
+		bb, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "ok:fred", string(bb))
+	}
+	assert.Equal(t, int32(1), refreshes.Load())
+	assert.Equal(t, []bool{false, true}, events)
+	assert.Equal(t, "fresh", r.cfg[oidcIDToken])
+	assert.Equal(t, "rt2", r.cfg[oidcRefreshToken])
+	require.Len(t, p.cc, 1)
+	assert.Equal(t, "fresh", p.cc[0][oidcIDToken])
+	assert.Equal(t, "rt2", p.cc[0][oidcRefreshToken])
+}
+
+func TestTokenRefresherRefreshFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	var (
+		failed bool
+		p      testPersister
+	)
+	r, err := NewTokenRefresher(&api.AuthProviderConfig{
+		Name:   oidcProvider,
+		Config: map[string]string{oidcRefreshToken: "rt"},
+	}, &p, nil, func(done bool, err error) {
+		failed = done && err != nil
+	})
+	require.NoError(t, err)
+	c := http.Client{Transport: r.Wrap(http.DefaultTransport)}
+
+	resp, err := c.Get(srv.URL)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.True(t, failed)
+	assert.Empty(t, p.cc)
+}
+
+func TestTokenRefresherIDPCertificateAuthority(t *testing.T) {
+	var refreshes atomic.Int32
+	idp := newTestIDP(t, &refreshes, true)
+	defer idp.Close()
+
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: idp.Certificate().Raw})
+	uu := map[string]struct {
+		cfg map[string]string
+		err string
+	}{
+		"untrusted": {
+			err: "certificate",
+		},
+		"ca-data": {
+			cfg: map[string]string{oidcCAData: base64.StdEncoding.EncodeToString(ca)},
+		},
+		"ca-file": {
+			cfg: map[string]string{oidcCAFile: writeTestCA(t, ca)},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := map[string]string{
+				oidcIssuerURL:    idp.URL,
+				oidcClientID:     "k9s",
+				oidcRefreshToken: "rt",
+			}
+			maps.Copy(cfg, u.cfg)
+			r, err := NewTokenRefresher(&api.AuthProviderConfig{Name: oidcProvider, Config: cfg}, nil, nil, nil)
+			require.NoError(t, err)
+
+			tok, err := r.Refresh(context.Background(), "stale")
+			if u.err != "" {
+				require.ErrorContains(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "fresh", tok)
+		})
+	}
+}
+
+func TestNewTokenRefresherInvalidCA(t *testing.T) {
+	_, err := NewTokenRefresher(&api.AuthProviderConfig{
+		Name:   oidcProvider,
+		Config: map[string]string{oidcRefreshToken: "rt", oidcCAData: base64.StdEncoding.EncodeToString([]byte("blee"))},
+	}, nil, nil, nil)
+	require.Error(t, err)
+}
+
+// Helpers...
+
+type testPersister struct {
+	cc []map[string]string
+}
+
+func (p *testPersister) Persist(cfg map[string]string) error {
+	p.cc = append(p.cc, cfg)
+
+	return nil
+}
+
+func writeTestCA(t *testing.T, ca []byte) string {
+	path := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(path, ca, 0600))
+
+	return path
+}
+
+func newTestIDP(t *testing.T, refreshes *atomic.Int32, secure bool) *httptest.Server {
+	srv := httptest.NewUnstartedServer(nil)
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case oidcDiscoveryPath:
+			_ = json.NewEncoder(w).Encode(map[string]string{"token_endpoint": srv.URL + "/token"})
+		case "/token":
+			require.NoError(t, r.ParseForm())
+			assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+			assert.Equal(t, "rt", r.Form.Get("refresh_token"))
+			assert.Equal(t, "k9s", r.Form.Get("client_id"))
+			refreshes.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]string{"id_token": "fresh", "refresh_token": "rt2"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	if secure {
+		srv.StartTLS()
+	} else {
+		srv.Start()
+	}
+
+	return srv
+}
@@ -77,6 +77,14 @@ type ContainersMetrics map[string]*mv1beta1.ContainerMetrics
 // NodesMetricsMap tracks node metrics.
 type NodesMetricsMap map[string]*mv1beta1.NodeMetrics
 
+// NodeNetworkMetrics tracks a node network I/O counters.
+type NodeNetworkMetrics struct {
+	RxBytes, TxBytes uint64
+}
+
+// NodesNetworkMetricsMap tracks nodes network metrics.
+type NodesNetworkMetricsMap map[string]*NodeNetworkMetrics
+
 // PodsMetricsMap tracks pod metrics.
 type PodsMetricsMap map[string]*mv1beta1.PodMetrics
 
@@ -132,6 +140,9 @@ type Connection interface {
 	// CheckConnectivity checks if api server connection is happy or not.
 	CheckConnectivity() bool
 
+	// PoolHealth returns the api server connection pool health.
+	PoolHealth() PoolHealth
+
 	// ActiveContext returns the current context name.
 	ActiveContext() string
 
@@ -190,6 +190,9 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare(client.PfGVR, "portforward", "pf")
 	a.declare(client.BeGVR, "benchmark", "bench")
 	a.declare(client.SdGVR, "screendump", "sd")
+	a.declare(client.MwGVR, "maintenance", "mw")
+	a.declare(client.NplGVR, "nodepool", "pool")
+	a.declare(client.QuoGVR, "quotausage", "qu")
 	a.declare(client.PuGVR, "pulse", "pu", "hz")
 	a.declare(client.XGVR, "xray", "x")
 	a.declare(client.WkGVR, "workload", "wk")
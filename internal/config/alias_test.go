@@ -112,7 +112,7 @@ func TestAliasesLoad(t *testing.T) {
 	a := config.NewAliases()
 	require.NoError(t, a.Load(path.Join(config.AppConfigDir, "plain.yaml")))
 
-	assert.Len(t, a.Alias, 55)
+	assert.Len(t, a.Alias, 63)
 }
 
 func TestAliasesSave(t *testing.T) {
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Audit tracks the mutating actions audit log options.
+type Audit struct {
+	// LogPath tracks the audit log file path. An empty path disables auditing.
+	LogPath string `json:"logPath" yaml:"logPath"`
+
+	// ExecRecordDir tracks where pod shell sessions are recorded. An empty dir disables recording.
+	// Only the pod and container shells are recorded. Attach, plugins and node shells are not.
+	ExecRecordDir string `json:"execRecordDir,omitempty" yaml:"execRecordDir,omitempty"`
+
+	// ExecRecordInput records the shell sessions input along with the output.
+	// The input includes any typed secrets hence it is off by default.
+	ExecRecordInput bool `json:"execRecordInput,omitempty" yaml:"execRecordInput,omitempty"`
+}
+
+// NewAudit returns a new instance.
+func NewAudit() *Audit {
+	return &Audit{}
+}
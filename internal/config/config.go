@@ -219,6 +219,23 @@ func (c *Config) SetActiveView(view string) {
 	}
 }
 
+// NodeLabelFilter returns the persisted nodes label selector for the active context.
+func (c *Config) NodeLabelFilter() string {
+	ct, err := c.K9s.ActiveContext()
+	if err != nil {
+		return ""
+	}
+
+	return ct.View.NodeLabelFilter
+}
+
+// SetNodeLabelFilter persists the nodes label selector for the active context.
+func (c *Config) SetNodeLabelFilter(sel string) {
+	if ct, err := c.K9s.ActiveContext(); err == nil {
+		ct.View.NodeLabelFilter = sel
+	}
+}
+
 // GetConnection return an api server connection.
 func (c *Config) GetConnection() client.Connection {
 	return c.conn
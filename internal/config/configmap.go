@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// DefaultConfigMapHistoryDepth tracks the default number of configmap revisions to keep.
+const DefaultConfigMapHistoryDepth = 5
+
+// ConfigMap tracks the configmap version history options.
+type ConfigMap struct {
+	// HistoryDepth tracks the number of revisions kept in a configmap history annotation.
+	HistoryDepth int `json:"historyDepth" yaml:"historyDepth"`
+}
+
+// NewConfigMap returns a new instance.
+func NewConfigMap() *ConfigMap {
+	return &ConfigMap{
+		HistoryDepth: DefaultConfigMapHistoryDepth,
+	}
+}
+
+// Validate checks configmap options and reverts invalid settings to defaults.
+func (c *ConfigMap) Validate() {
+	if c.HistoryDepth <= 0 {
+		if c.HistoryDepth < 0 {
+			slog.Warn("Invalid configmap options. Using default history depth",
+				slogs.Error, fmt.Errorf("configmap.historyDepth must be greater than 0 but got %d", c.HistoryDepth),
+			)
+		}
+		c.HistoryDepth = DefaultConfigMapHistoryDepth
+	}
+}
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// DefaultDashboardRefreshInterval tracks the default dashboard refresh interval in seconds.
+const DefaultDashboardRefreshInterval = 10
+
+// Dashboard tracks the cluster dashboard options.
+type Dashboard struct {
+	RefreshInterval int `json:"refreshInterval" yaml:"refreshInterval"`
+}
+
+// NewDashboard returns a new instance.
+func NewDashboard() *Dashboard {
+	return &Dashboard{
+		RefreshInterval: DefaultDashboardRefreshInterval,
+	}
+}
+
+// Validate checks dashboard options and reverts invalid settings to defaults.
+func (d *Dashboard) Validate() {
+	if d.RefreshInterval <= 0 {
+		if d.RefreshInterval < 0 {
+			slog.Warn("Invalid dashboard options. Using default refresh interval",
+				slogs.Error, fmt.Errorf("dashboard.refreshInterval must be greater than 0 but got %d", d.RefreshInterval),
+			)
+		}
+		d.RefreshInterval = DefaultDashboardRefreshInterval
+	}
+}
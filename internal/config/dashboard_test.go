@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDashboardValidate(t *testing.T) {
+	uu := map[string]struct {
+		d, e config.Dashboard
+	}{
+		"empty": {
+			e: config.Dashboard{RefreshInterval: 10},
+		},
+		"valid": {
+			d: config.Dashboard{RefreshInterval: 30},
+			e: config.Dashboard{RefreshInterval: 30},
+		},
+		"negative": {
+			d: config.Dashboard{RefreshInterval: -1},
+			e: config.Dashboard{RefreshInterval: 10},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.d.Validate()
+			assert.Equal(t, u.e, u.d)
+		})
+	}
+}
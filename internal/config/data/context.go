@@ -20,6 +20,8 @@ type Context struct {
 	View         *View        `yaml:"view"`
 	FeatureGates FeatureGates `yaml:"featureGates"`
 	Proxy        *Proxy       `yaml:"proxy"`
+	SSH          *SSH         `yaml:"ssh,omitempty"`
+	Prometheus   *Prometheus  `yaml:"prometheus,omitempty"`
 	mx           sync.RWMutex
 }
 
@@ -86,4 +88,8 @@ func (c *Context) Validate(conn client.Connection, _, clusterName string) {
 		c.View = NewView()
 	}
 	c.View.Validate()
+
+	if c.SSH != nil {
+		c.SSH.Validate()
+	}
 }
@@ -5,7 +5,9 @@ package data
 
 // FeatureGates represents K9s opt-in features.
 type FeatureGates struct {
-	NodeShell bool `yaml:"nodeShell"`
+	NodeShell          bool `yaml:"nodeShell"`
+	NodeNetworkMetrics bool `yaml:"nodeNetworkMetrics"`
+	NodeCertExpiry     bool `yaml:"nodeCertExpiry"`
 }
 
 // NewFeatureGates returns a new feature gate.
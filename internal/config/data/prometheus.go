@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import "github.com/derailed/k9s/internal/client"
+
+// Prometheus tracks a context's Prometheus server used to enrich node and pod metrics.
+type Prometheus struct {
+	// URL tracks the Prometheus server url.
+	URL string `yaml:"url"`
+
+	// BearerToken tracks an optional token used to authenticate against the server.
+	BearerToken string `yaml:"bearerToken,omitempty"`
+
+	// TLS tracks the server TLS settings.
+	TLS *PrometheusTLS `yaml:"tls,omitempty"`
+}
+
+// PrometheusTLS tracks the Prometheus server TLS settings.
+type PrometheusTLS struct {
+	CAFile             string `yaml:"caFile,omitempty"`
+	CertFile           string `yaml:"certFile,omitempty"`
+	KeyFile            string `yaml:"keyFile,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecureSkipVerify,omitempty"`
+}
+
+// ClientConfig returns the Prometheus client settings or nil if not configured.
+func (p *Prometheus) ClientConfig() *client.PrometheusConfig {
+	if p == nil || p.URL == "" {
+		return nil
+	}
+	cfg := client.PrometheusConfig{
+		URL:         p.URL,
+		BearerToken: p.BearerToken,
+	}
+	if p.TLS != nil {
+		cfg.CAFile, cfg.CertFile, cfg.KeyFile = p.TLS.CAFile, p.TLS.CertFile, p.TLS.KeyFile
+		cfg.InsecureSkipVerify = p.TLS.InsecureSkipVerify
+	}
+
+	return &cfg
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data
+
+import (
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// DefaultSSHPort tracks the default node ssh port.
+	DefaultSSHPort = 22
+
+	// DefaultSSHAddressType tracks the default node address used to ssh in.
+	DefaultSSHAddressType = string(v1.NodeInternalIP)
+)
+
+// SSH tracks a context's node ssh configuration.
+type SSH struct {
+	User        string `yaml:"user,omitempty"`
+	Port        int    `yaml:"port"`
+	KeyPath     string `yaml:"keyPath,omitempty"`
+	AddressType string `yaml:"addressType"`
+}
+
+// NewSSH returns a new instance.
+func NewSSH() *SSH {
+	return &SSH{
+		Port:        DefaultSSHPort,
+		AddressType: DefaultSSHAddressType,
+	}
+}
+
+// Validate ensures the ssh configuration is sound.
+func (s *SSH) Validate() {
+	if s.Port <= 0 || s.Port > 65535 {
+		if s.Port != 0 {
+			slog.Warn("Invalid ssh port. Using default", slogs.Port, s.Port)
+		}
+		s.Port = DefaultSSHPort
+	}
+	switch v1.NodeAddressType(s.AddressType) {
+	case v1.NodeInternalIP, v1.NodeExternalIP:
+	default:
+		if s.AddressType != "" {
+			slog.Warn("Invalid ssh address type. Using default",
+				slogs.Attr, s.AddressType,
+			)
+		}
+		s.AddressType = DefaultSSHAddressType
+	}
+}
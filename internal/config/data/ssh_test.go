@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package data_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHValidate(t *testing.T) {
+	uu := map[string]struct {
+		s, e data.SSH
+	}{
+		"empty": {
+			e: data.SSH{Port: 22, AddressType: "InternalIP"},
+		},
+		"valid": {
+			s: data.SSH{User: "fred", Port: 2222, KeyPath: "/tmp/id", AddressType: "ExternalIP"},
+			e: data.SSH{User: "fred", Port: 2222, KeyPath: "/tmp/id", AddressType: "ExternalIP"},
+		},
+		"bad-port": {
+			s: data.SSH{Port: 100_000, AddressType: "ExternalIP"},
+			e: data.SSH{Port: 22, AddressType: "ExternalIP"},
+		},
+		"bad-address": {
+			s: data.SSH{Port: 22, AddressType: "Hostname"},
+			e: data.SSH{Port: 22, AddressType: "InternalIP"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.s.Validate()
+			assert.Equal(t, u.e, u.s)
+		})
+	}
+}
@@ -3,11 +3,19 @@
 
 package data
 
+import (
+	"log/slog"
+
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 const DefaultView = "po"
 
 // View tracks view configuration options.
 type View struct {
-	Active string `yaml:"active"`
+	Active          string `yaml:"active"`
+	NodeLabelFilter string `yaml:"nodeLabelFilter,omitempty"`
 }
 
 // NewView creates a new view configuration.
@@ -20,4 +28,11 @@ func (v *View) Validate() {
 	if v.Active == "" {
 		v.Active = DefaultView
 	}
+	if v.NodeLabelFilter == "" {
+		return
+	}
+	if _, err := labels.Parse(v.NodeLabelFilter); err != nil {
+		slog.Warn("Invalid node label filter. Clearing it", slogs.Error, err)
+		v.NodeLabelFilter = ""
+	}
 }
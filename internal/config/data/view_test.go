@@ -26,3 +26,13 @@ func TestViewValidateBlank(t *testing.T) {
 	v.Validate()
 	assert.Equal(t, "po", v.Active)
 }
+
+func TestViewValidateNodeLabelFilter(t *testing.T) {
+	v := data.View{Active: "no", NodeLabelFilter: "zone in (a,b)"}
+	v.Validate()
+	assert.Equal(t, "zone in (a,b)", v.NodeLabelFilter)
+
+	v.NodeLabelFilter = "zone in (a"
+	v.Validate()
+	assert.Empty(t, v.NodeLabelFilter)
+}
@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// DefaultDebugImages tracks the default debug sidecar images.
+var DefaultDebugImages = []string{"busybox:1.36", "nicolaka/netshoot:latest"}
+
+// Debug tracks the pod debug sidecar options.
+type Debug struct {
+	// Images tracks the images available to launch a debug sidecar.
+	Images []string `json:"images" yaml:"images"`
+}
+
+// NewDebug returns a new instance.
+func NewDebug() *Debug {
+	return &Debug{
+		Images: append([]string(nil), DefaultDebugImages...),
+	}
+}
+
+// Validate ensures debug options are valid.
+func (d *Debug) Validate() {
+	if len(d.Images) == 0 {
+		d.Images = append([]string(nil), DefaultDebugImages...)
+	}
+}
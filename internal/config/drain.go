@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	// DefaultDrainGracePeriod tracks the default drain grace period. -1 uses the pod's own grace period.
+	DefaultDrainGracePeriod = -1
+
+	// DefaultDrainTimeout tracks the default drain timeout.
+	DefaultDrainTimeout = 5 * time.Second
+
+	// DefaultEvictionOrder tracks the default drain pods eviction order.
+	DefaultEvictionOrder = "default"
+)
+
+var evictionOrders = []string{DefaultEvictionOrder, "priority", "namespace"}
+
+// DrainOptions tracks node drain defaults.
+type DrainOptions struct {
+	GracePeriodSeconds  int           `json:"gracePeriodSeconds" yaml:"gracePeriodSeconds"`
+	Timeout             time.Duration `json:"timeout" yaml:"timeout"`
+	DeleteEmptyDirData  bool          `json:"deleteEmptyDirData" yaml:"deleteEmptyDirData"`
+	IgnoreAllDaemonSets bool          `json:"ignoreAllDaemonSets" yaml:"ignoreAllDaemonSets"`
+	EvictionOrder       string        `json:"evictionOrder" yaml:"evictionOrder"`
+	ChunkSize           int           `json:"chunkSize,omitempty" yaml:"chunkSize,omitempty"`
+	InterChunkDelay     time.Duration `json:"interChunkDelay,omitempty" yaml:"interChunkDelay,omitempty"`
+	PreHooks            []string      `json:"preHooks,omitempty" yaml:"preHooks,omitempty"`
+	PostHooks           []string      `json:"postHooks,omitempty" yaml:"postHooks,omitempty"`
+	HookTimeout         time.Duration `json:"hookTimeout,omitempty" yaml:"hookTimeout,omitempty"`
+}
+
+// NewDrainOptions returns a new instance.
+func NewDrainOptions() *DrainOptions {
+	return &DrainOptions{
+		GracePeriodSeconds: DefaultDrainGracePeriod,
+		Timeout:            DefaultDrainTimeout,
+		EvictionOrder:      DefaultEvictionOrder,
+	}
+}
+
+// Validate checks drain options and reverts invalid settings to defaults.
+func (d *DrainOptions) Validate() {
+	if d.GracePeriodSeconds < DefaultDrainGracePeriod {
+		slog.Warn("Invalid drain options. Using default grace period",
+			slogs.Error, fmt.Errorf("drainOptions.gracePeriodSeconds must be non-negative (or -1 to use the pod grace period) but got %d", d.GracePeriodSeconds),
+		)
+		d.GracePeriodSeconds = DefaultDrainGracePeriod
+	}
+	if d.Timeout < 0 {
+		slog.Warn("Invalid drain options. Using default timeout",
+			slogs.Error, fmt.Errorf("drainOptions.timeout must be greater than 0 but got %s", d.Timeout),
+		)
+	}
+	if d.Timeout <= 0 {
+		d.Timeout = DefaultDrainTimeout
+	}
+	if !slices.Contains(evictionOrders, d.EvictionOrder) {
+		if d.EvictionOrder != "" {
+			slog.Warn("Invalid drain options. Using default eviction order",
+				slogs.Error, fmt.Errorf("drainOptions.evictionOrder must be one of %v but got %q", evictionOrders, d.EvictionOrder),
+			)
+		}
+		d.EvictionOrder = DefaultEvictionOrder
+	}
+	if d.ChunkSize < 0 {
+		slog.Warn("Invalid drain options. Evicting all pods at once",
+			slogs.Error, fmt.Errorf("drainOptions.chunkSize must be non-negative but got %d", d.ChunkSize),
+		)
+		d.ChunkSize = 0
+	}
+	if d.InterChunkDelay < 0 {
+		slog.Warn("Invalid drain options. Disabling inter chunk delay",
+			slogs.Error, fmt.Errorf("drainOptions.interChunkDelay must be non-negative but got %s", d.InterChunkDelay),
+		)
+		d.InterChunkDelay = 0
+	}
+	if d.HookTimeout < 0 {
+		slog.Warn("Invalid drain options. Using default hook timeout",
+			slogs.Error, fmt.Errorf("drainOptions.hookTimeout must be non-negative but got %s", d.HookTimeout),
+		)
+		d.HookTimeout = 0
+	}
+	d.PreHooks = validHooks("preHooks", d.PreHooks)
+	d.PostHooks = validHooks("postHooks", d.PostHooks)
+}
+
+// validHooks drops hooks that are not valid command templates.
+func validHooks(kind string, hh []string) []string {
+	vv := make([]string, 0, len(hh))
+	for i, h := range hh {
+		if _, err := template.New(kind).Parse(h); err != nil {
+			slog.Warn("Invalid drain options. Skipping hook",
+				slogs.Error, fmt.Errorf("drainOptions.%s[%d] is not a valid template: %w", kind, i, err),
+			)
+			continue
+		}
+		vv = append(vv, h)
+	}
+	if len(vv) == 0 {
+		return nil
+	}
+
+	return vv
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDrainOptions(t *testing.T) {
+	d := config.NewDrainOptions()
+	d.Validate()
+
+	assert.Equal(t, -1, d.GracePeriodSeconds)
+	assert.Equal(t, 5*time.Second, d.Timeout)
+	assert.False(t, d.DeleteEmptyDirData)
+	assert.False(t, d.IgnoreAllDaemonSets)
+	assert.Equal(t, "default", d.EvictionOrder)
+}
+
+func TestDrainOptionsValidate(t *testing.T) {
+	uu := map[string]struct {
+		d, e config.DrainOptions
+	}{
+		"empty": {
+			e: config.DrainOptions{Timeout: 5 * time.Second, EvictionOrder: "default"},
+		},
+		"valid": {
+			d: config.DrainOptions{GracePeriodSeconds: 30, Timeout: time.Minute, DeleteEmptyDirData: true, EvictionOrder: "priority"},
+			e: config.DrainOptions{GracePeriodSeconds: 30, Timeout: time.Minute, DeleteEmptyDirData: true, EvictionOrder: "priority"},
+		},
+		"bad-grace": {
+			d: config.DrainOptions{GracePeriodSeconds: -10, Timeout: time.Minute},
+			e: config.DrainOptions{GracePeriodSeconds: -1, Timeout: time.Minute, EvictionOrder: "default"},
+		},
+		"bad-timeout": {
+			d: config.DrainOptions{GracePeriodSeconds: 10, Timeout: -time.Second},
+			e: config.DrainOptions{GracePeriodSeconds: 10, Timeout: 5 * time.Second, EvictionOrder: "default"},
+		},
+		"bad-order": {
+			d: config.DrainOptions{Timeout: time.Minute, EvictionOrder: "fred"},
+			e: config.DrainOptions{Timeout: time.Minute, EvictionOrder: "default"},
+		},
+		"chunks": {
+			d: config.DrainOptions{Timeout: time.Minute, ChunkSize: 20, InterChunkDelay: time.Second},
+			e: config.DrainOptions{Timeout: time.Minute, EvictionOrder: "default", ChunkSize: 20, InterChunkDelay: time.Second},
+		},
+		"bad-chunks": {
+			d: config.DrainOptions{Timeout: time.Minute, ChunkSize: -1, InterChunkDelay: -time.Second},
+			e: config.DrainOptions{Timeout: time.Minute, EvictionOrder: "default"},
+		},
+		"bad-hook-timeout": {
+			d: config.DrainOptions{Timeout: time.Minute, HookTimeout: -time.Second},
+			e: config.DrainOptions{Timeout: time.Minute, EvictionOrder: "default"},
+		},
+		"hooks": {
+			d: config.DrainOptions{
+				Timeout:   time.Minute,
+				PreHooks:  []string{"echo {{.NodeName}}", "echo {{.NodeName"},
+				PostHooks: []string{"{{"},
+			},
+			e: config.DrainOptions{
+				Timeout:       time.Minute,
+				EvictionOrder: "default",
+				PreHooks:      []string{"echo {{.NodeName}}"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.d.Validate()
+			assert.Equal(t, u.e, u.d)
+		})
+	}
+}
@@ -12,6 +12,12 @@ const (
 
 	// DefaultCommand represents the default command to run.
 	DefaultCommand = ""
+
+	// DefaultOutput represents the default output format, ie the interactive UI.
+	DefaultOutput = ""
+
+	// OutputJSON emits resources as JSON lines instead of launching the UI.
+	OutputJSON = "json"
 )
 
 // Flags represents K9s configuration flags.
@@ -27,7 +33,10 @@ type Flags struct {
 	Write         *bool
 	Crumbsless    *bool
 	Splashless    *bool
+	A11y          *bool
 	ScreenDumpDir *string
+	Output        *string
+	StrictConfig  *bool
 }
 
 // NewFlags returns new configuration flags.
@@ -44,7 +53,10 @@ func NewFlags() *Flags {
 		Write:         boolPtr(false),
 		Crumbsless:    boolPtr(false),
 		Splashless:    boolPtr(false),
+		A11y:          boolPtr(false),
 		ScreenDumpDir: strPtr(AppDumpsDir),
+		Output:        strPtr(DefaultOutput),
+		StrictConfig:  boolPtr(false),
 	}
 }
 
@@ -20,6 +20,7 @@ func TestNewFlags(t *testing.T) {
 	assert.Equal(t, "/tmp/k9s-test/k9s.log", *f.LogFile)
 	assert.Equal(t, config.AppDumpsDir, *f.ScreenDumpDir)
 	assert.Empty(t, *f.Command)
+	assert.Empty(t, *f.Output)
 	assert.False(t, *f.Headless)
 	assert.False(t, *f.Logoless)
 	assert.False(t, *f.AllNamespaces)
@@ -27,4 +28,6 @@ func TestNewFlags(t *testing.T) {
 	assert.False(t, *f.Write)
 	assert.False(t, *f.Crumbsless)
 	assert.False(t, *f.Splashless)
+	assert.False(t, *f.A11y)
+	assert.False(t, *f.StrictConfig)
 }
@@ -43,6 +43,9 @@ const (
 
 	// SkinSchema describes skin config schema.
 	SkinSchema = "skin.json"
+
+	// additionalPropertyErr tracks unknown properties validation errors.
+	additionalPropertyErr = "additional_property_not_allowed"
 )
 
 var (
@@ -162,6 +165,38 @@ func (v *Validator) Validate(k string, bb []byte) error {
 	return errs
 }
 
+// UnknownKeys returns the sorted dotted paths of the document keys not described
+// by the given schema.
+func (v *Validator) UnknownKeys(k string, bb []byte) ([]string, error) {
+	var m any
+	if err := yaml.Unmarshal(bb, &m); err != nil {
+		return nil, err
+	}
+	s, ok := v.schemas[k]
+	if !ok {
+		return nil, fmt.Errorf("no schema found for: %q", k)
+	}
+	result, err := gojsonschema.Validate(s, gojsonschema.NewGoLoader(m))
+	if err != nil {
+		return nil, err
+	}
+
+	var kk []string
+	for _, re := range result.Errors() {
+		if re.Type() != additionalPropertyErr {
+			continue
+		}
+		prop, _ := re.Details()["property"].(string)
+		if f := re.Field(); f != gojsonschema.STRING_ROOT_SCHEMA_PROPERTY {
+			prop = f + "." + prop
+		}
+		kk = append(kk, prop)
+	}
+	slices.Sort(kk)
+
+	return slices.Compact(kk), nil
+}
+
 func (v *Validator) ValidateObj(k string, o any) error {
 	s, ok := v.schemas[k]
 	if !ok {
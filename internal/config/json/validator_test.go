@@ -238,3 +238,38 @@ columns is required`,
 		})
 	}
 }
+
+func TestUnknownKeys(t *testing.T) {
+	uu := map[string]struct {
+		f, bb string
+		e     []string
+	}{
+		"cool": {
+			f: "testdata/k9s/cool.yaml",
+		},
+		"toast": {
+			f: "testdata/k9s/toast.yaml",
+			e: []string{"k9s.shellPods"},
+		},
+		"nested": {
+			bb: "k9s:\n  refreshRat: 2\n  ui:\n    enableMous: true\n    logoless: true\nk8s: {}\n",
+			e:  []string{"k8s", "k9s.refreshRat", "k9s.ui.enableMous"},
+		},
+	}
+
+	v := json.NewValidator()
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb := []byte(u.bb)
+			if u.f != "" {
+				var err error
+				bb, err = os.ReadFile(u.f)
+				require.NoError(t, err)
+			}
+			kk, err := v.UnknownKeys(json.K9sSchema, bb)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, kk)
+		})
+	}
+}
@@ -21,31 +21,42 @@ import (
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         int        `json:"refreshRate" yaml:"refreshRate"`
-	MaxConnRetry        int32      `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	PortForwardAddress  string     `yaml:"portForwardAddress"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            *ShellPod  `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
-	manualRefreshRate   int
-	manualReadOnly      *bool
-	manualCommand       *string
-	manualScreenDumpDir *string
-	dir                 *data.Dir
-	activeContextName   string
-	activeConfig        *data.Config
-	conn                client.Connection
-	ks                  data.KubeSettings
-	mx                  sync.RWMutex
-	contextSwitch       bool
+	LiveViewAutoRefresh   bool          `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	ScreenDumpDir         string        `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate           int           `json:"refreshRate" yaml:"refreshRate"`
+	MaxConnRetry          int32         `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly              bool          `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC         bool          `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	PortForwardAddress    string        `yaml:"portForwardAddress"`
+	UI                    UI            `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck    bool          `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting    bool          `json:"disablePodCounting" yaml:"disablePodCounting"`
+	NodePoolLabel         string        `json:"nodePoolLabel" yaml:"nodePoolLabel"`
+	NodeColumns           []string      `json:"nodeColumns,omitempty" yaml:"nodeColumns,omitempty"`
+	ShellPod              *ShellPod     `json:"shellPod" yaml:"shellPod"`
+	ImageScans            ImageScans    `json:"imageScans" yaml:"imageScans"`
+	Logger                Logger        `json:"logger" yaml:"logger"`
+	Thresholds            Threshold     `json:"thresholds" yaml:"thresholds"`
+	DrainOptions          *DrainOptions `json:"drainOptions" yaml:"drainOptions"`
+	MetricsRetry          *MetricsRetry `json:"metricsRetry" yaml:"metricsRetry"`
+	MultiMetricsEndpoints int           `json:"multiMetricsEndpoints,omitempty" yaml:"multiMetricsEndpoints,omitempty"`
+	Dashboard             *Dashboard    `json:"dashboard" yaml:"dashboard"`
+	Security              *Security     `json:"security" yaml:"security"`
+	Audit                 *Audit        `json:"audit" yaml:"audit"`
+	KernelCVEs            *KernelCVEs   `json:"kernelCVEs" yaml:"kernelCVEs"`
+	Debug                 *Debug        `json:"debug" yaml:"debug"`
+	ConfigMap             *ConfigMap    `json:"configmap" yaml:"configmap"`
+	manualRefreshRate     int
+	manualReadOnly        *bool
+	manualCommand         *string
+	manualScreenDumpDir   *string
+	dir                   *data.Dir
+	activeContextName     string
+	activeConfig          *data.Config
+	conn                  client.Connection
+	ks                    data.KubeSettings
+	mx                    sync.RWMutex
+	contextSwitch         bool
 }
 
 // NewK9s create a new K9s configuration.
@@ -56,6 +67,15 @@ func NewK9s(conn client.Connection, ks data.KubeSettings) *K9s {
 		ScreenDumpDir:      AppDumpsDir,
 		Logger:             NewLogger(),
 		Thresholds:         NewThreshold(),
+		DrainOptions:       NewDrainOptions(),
+		MetricsRetry:       NewMetricsRetry(),
+		Dashboard:          NewDashboard(),
+		Security:           NewSecurity(),
+		Audit:              NewAudit(),
+		KernelCVEs:         NewKernelCVEs(),
+		Debug:              NewDebug(),
+		ConfigMap:          NewConfigMap(),
+		NodePoolLabel:      DefaultNodePoolLabel,
 		PortForwardAddress: defaultPFAddress(),
 		ShellPod:           NewShellPod(),
 		ImageScans:         NewImageScans(),
@@ -121,12 +141,43 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.UI = k1.UI
 	k.SkipLatestRevCheck = k1.SkipLatestRevCheck
 	k.DisablePodCounting = k1.DisablePodCounting
+	if k1.NodePoolLabel != "" {
+		k.NodePoolLabel = k1.NodePoolLabel
+	}
+	if len(k1.NodeColumns) > 0 {
+		k.NodeColumns = k1.NodeColumns
+	}
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
 	k.ImageScans = k1.ImageScans
 	if k1.Thresholds != nil {
 		k.Thresholds = k1.Thresholds
 	}
+	if k1.DrainOptions != nil {
+		k.DrainOptions = k1.DrainOptions
+	}
+	if k1.MetricsRetry != nil {
+		k.MetricsRetry = k1.MetricsRetry
+	}
+	k.MultiMetricsEndpoints = k1.MultiMetricsEndpoints
+	if k1.Dashboard != nil {
+		k.Dashboard = k1.Dashboard
+	}
+	if k1.Security != nil {
+		k.Security = k1.Security
+	}
+	if k1.Audit != nil {
+		k.Audit = k1.Audit
+	}
+	if k1.KernelCVEs != nil {
+		k.KernelCVEs = k1.KernelCVEs
+	}
+	if k1.Debug != nil {
+		k.Debug = k1.Debug
+	}
+	if k1.ConfigMap != nil {
+		k.ConfigMap = k1.ConfigMap
+	}
 }
 
 // AppScreenDumpDir fetch screen dumps dir.
@@ -218,6 +269,16 @@ func (k *K9s) getActiveContextName() string {
 	return k.activeContextName
 }
 
+// ContextConfig loads a context configuration without activating it.
+func (k *K9s) ContextConfig(contextName string) (*data.Config, error) {
+	ct, err := k.ks.GetContext(contextName)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.dir.Load(contextName, ct)
+}
+
 // ActivateContext initializes the active context if not present.
 func (k *K9s) ActivateContext(contextName string) (*data.Context, error) {
 	k.setActiveContextName(contextName)
@@ -298,6 +359,7 @@ func (k *K9s) Override(k9sFlags *Flags) {
 	k.UI.manualLogoless = k9sFlags.Logoless
 	k.UI.manualCrumbsless = k9sFlags.Crumbsless
 	k.UI.manualSplashless = k9sFlags.Splashless
+	k.UI.manualA11y = k9sFlags.A11y
 	if k9sFlags.ReadOnly != nil && *k9sFlags.ReadOnly {
 		k.manualReadOnly = k9sFlags.ReadOnly
 	}
@@ -345,6 +407,15 @@ func (k *K9s) IsSplashless() bool {
 	return k.UI.Splashless
 }
 
+// IsA11y returns the accessibility setting.
+func (k *K9s) IsA11y() bool {
+	if IsBoolSet(k.UI.manualA11y) {
+		return true
+	}
+
+	return k.UI.A11y
+}
+
 // GetRefreshRate returns the current refresh rate.
 func (k *K9s) GetRefreshRate() int {
 	if k.manualRefreshRate != 0 {
@@ -354,6 +425,11 @@ func (k *K9s) GetRefreshRate() int {
 	return k.RefreshRate
 }
 
+// CanDecodeSecrets returns true if secret data values may be decoded.
+func (k *K9s) CanDecodeSecrets() bool {
+	return k.Security != nil && k.Security.DecodeSecrets
+}
+
 // IsReadOnly returns the readonly setting.
 func (k *K9s) IsReadOnly() bool {
 	ro := k.ReadOnly
@@ -382,6 +458,9 @@ func (k *K9s) Validate(c client.Connection, contextName, clusterName string) {
 	if k.PortForwardAddress == "" {
 		k.PortForwardAddress = defaultPFAddress()
 	}
+	if k.NodePoolLabel == "" {
+		k.NodePoolLabel = DefaultNodePoolLabel
+	}
 
 	if k.getActiveConfig() == nil {
 		_, _ = k.ActivateContext(contextName)
@@ -391,6 +470,35 @@ func (k *K9s) Validate(c client.Connection, contextName, clusterName string) {
 	}
 	k.Logger = k.Logger.Validate()
 	k.Thresholds = k.Thresholds.Validate()
+	if k.DrainOptions == nil {
+		k.DrainOptions = NewDrainOptions()
+	}
+	k.DrainOptions.Validate()
+	if k.MetricsRetry == nil {
+		k.MetricsRetry = NewMetricsRetry()
+	}
+	k.MetricsRetry.Validate()
+	if k.Dashboard == nil {
+		k.Dashboard = NewDashboard()
+	}
+	k.Dashboard.Validate()
+	if k.Security == nil {
+		k.Security = NewSecurity()
+	}
+	if k.Audit == nil {
+		k.Audit = NewAudit()
+	}
+	if k.KernelCVEs == nil {
+		k.KernelCVEs = NewKernelCVEs()
+	}
+	if k.Debug == nil {
+		k.Debug = NewDebug()
+	}
+	k.Debug.Validate()
+	if k.ConfigMap == nil {
+		k.ConfigMap = NewConfigMap()
+	}
+	k.ConfigMap.Validate()
 
 	if cfg := k.getActiveConfig(); cfg != nil {
 		cfg.Validate(c, contextName, clusterName)
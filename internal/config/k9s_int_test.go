@@ -18,9 +18,9 @@ func Test_k9sOverrides(t *testing.T) {
 	)
 
 	uu := map[string]struct {
-		k                  *K9s
-		rate               int
-		ro, hl, cl, sl, ll bool
+		k                        *K9s
+		rate                     int
+		ro, hl, cl, sl, ll, a11y bool
 	}{
 		"plain": {
 			k: &K9s{
@@ -49,6 +49,7 @@ func Test_k9sOverrides(t *testing.T) {
 					Logoless:   true,
 					Crumbsless: true,
 					Splashless: true,
+					A11y:       true,
 				},
 				SkipLatestRevCheck: false,
 				DisablePodCounting: false,
@@ -59,6 +60,7 @@ func Test_k9sOverrides(t *testing.T) {
 			ll:   true,
 			cl:   true,
 			sl:   true,
+			a11y: true,
 		},
 		"overrides": {
 			k: &K9s{
@@ -76,6 +78,7 @@ func Test_k9sOverrides(t *testing.T) {
 					manualLogoless:   &trueVal,
 					manualCrumbsless: &trueVal,
 					manualSplashless: &trueVal,
+					manualA11y:       &trueVal,
 				},
 				SkipLatestRevCheck:  false,
 				DisablePodCounting:  false,
@@ -90,6 +93,7 @@ func Test_k9sOverrides(t *testing.T) {
 			ll:   true,
 			cl:   true,
 			sl:   true,
+			a11y: true,
 		},
 	}
 
@@ -102,6 +106,7 @@ func Test_k9sOverrides(t *testing.T) {
 			assert.Equal(t, u.sl, u.k.IsSplashless())
 			assert.Equal(t, u.hl, u.k.IsHeadless())
 			assert.Equal(t, u.ll, u.k.IsLogoless())
+			assert.Equal(t, u.a11y, u.k.IsA11y())
 		})
 	}
 }
@@ -6,7 +6,9 @@ package config_test
 import (
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/mock"
 	"github.com/stretchr/testify/assert"
@@ -147,3 +149,74 @@ func TestAppScreenDumpDir(t *testing.T) {
 	require.NoError(t, cfg.Load("testdata/configs/k9s.yaml", true))
 	assert.Equal(t, "/tmp/k9s-test/screen-dumps", cfg.K9s.AppScreenDumpDir())
 }
+
+func TestUINodeAgeLimits(t *testing.T) {
+	const day = 24 * time.Hour
+	uu := map[string]struct {
+		th         *config.NodeAgeThresholds
+		warn, crit time.Duration
+	}{
+		"none": {
+			warn: config.DefaultNodeAgeWarn,
+			crit: config.DefaultNodeAgeCrit,
+		},
+		"days": {
+			th:   &config.NodeAgeThresholds{Warn: "7d", Crit: "14d"},
+			warn: 7 * day,
+			crit: 14 * day,
+		},
+		"durations": {
+			th:   &config.NodeAgeThresholds{Warn: "12h", Crit: "48h"},
+			warn: 12 * time.Hour,
+			crit: 48 * time.Hour,
+		},
+		"invalid": {
+			th:   &config.NodeAgeThresholds{Warn: "fred", Crit: "-1d"},
+			warn: config.DefaultNodeAgeWarn,
+			crit: config.DefaultNodeAgeCrit,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			warn, crit := config.UI{NodeAgeThresholds: u.th}.NodeAgeLimits()
+			assert.Equal(t, u.warn, warn)
+			assert.Equal(t, u.crit, crit)
+		})
+	}
+}
+
+func TestUIColumnWidthsFor(t *testing.T) {
+	ui := config.UI{
+		ColumnWidths: map[string]map[string]int{
+			"v1/pods":     {"name": 30, "Namespace": 10, "IP": 0},
+			"v1/services": {"NAME": 20},
+			"pods":        {"NAME": 5},
+		},
+	}
+
+	uu := map[string]struct {
+		gvr *client.GVR
+		e   map[string]int
+	}{
+		"none": {
+			gvr: client.DpGVR,
+		},
+		"exact": {
+			gvr: client.SvcGVR,
+			e:   map[string]int{"NAME": 20},
+		},
+		"case": {
+			gvr: client.PodGVR,
+			e:   map[string]int{"NAME": 30, "NAMESPACE": 10},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.ColumnWidthsFor(u.gvr))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import "github.com/derailed/k9s/internal/client"
+
+// KernelCVEs tracks the CVE feed used to check node kernels for known vulnerabilities.
+type KernelCVEs struct {
+	// Enabled toggles the node kernel CVE checks.
+	Enabled bool `json:"enabled" yaml:"enabled"`
+
+	// URL tracks the CVE feed url. Defaults to the NVD CVE API.
+	URL string `json:"url,omitempty" yaml:"url,omitempty"`
+
+	// APIKey tracks an optional feed api key used to lift rate limits.
+	APIKey string `json:"apiKey,omitempty" yaml:"apiKey,omitempty"`
+}
+
+// NewKernelCVEs returns a new instance.
+func NewKernelCVEs() *KernelCVEs {
+	return &KernelCVEs{}
+}
+
+// FeedConfig returns the CVE feed settings or nil if disabled.
+func (k *KernelCVEs) FeedConfig() *client.CVEFeedConfig {
+	if k == nil || !k.Enabled {
+		return nil
+	}
+
+	return &client.CVEFeedConfig{
+		URL:    k.URL,
+		APIKey: k.APIKey,
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// MetricsRetry tracks metrics server retry options.
+type MetricsRetry struct {
+	MaxRetries      int           `json:"maxRetries" yaml:"maxRetries"`
+	BackoffDuration time.Duration `json:"backoffDuration" yaml:"backoffDuration"`
+}
+
+// NewMetricsRetry returns a new instance.
+func NewMetricsRetry() *MetricsRetry {
+	return &MetricsRetry{
+		MaxRetries:      client.DefaultMetricsMaxRetries,
+		BackoffDuration: client.DefaultMetricsBackoff,
+	}
+}
+
+// Validate checks retry options and reverts invalid settings to defaults.
+func (r *MetricsRetry) Validate() {
+	if r.MaxRetries < 0 {
+		slog.Warn("Invalid metrics retry options. Using default max retries",
+			slogs.Error, fmt.Errorf("metricsRetry.maxRetries must be non-negative but got %d", r.MaxRetries),
+		)
+		r.MaxRetries = client.DefaultMetricsMaxRetries
+	}
+	if r.BackoffDuration <= 0 {
+		if r.BackoffDuration < 0 {
+			slog.Warn("Invalid metrics retry options. Using default back-off",
+				slogs.Error, fmt.Errorf("metricsRetry.backoffDuration must be greater than 0 but got %s", r.BackoffDuration),
+			)
+		}
+		r.BackoffDuration = client.DefaultMetricsBackoff
+	}
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsRetryValidate(t *testing.T) {
+	uu := map[string]struct {
+		r, e config.MetricsRetry
+	}{
+		"empty": {
+			e: config.MetricsRetry{BackoffDuration: 250 * time.Millisecond},
+		},
+		"valid": {
+			r: config.MetricsRetry{MaxRetries: 5, BackoffDuration: time.Second},
+			e: config.MetricsRetry{MaxRetries: 5, BackoffDuration: time.Second},
+		},
+		"bad-retries": {
+			r: config.MetricsRetry{MaxRetries: -1, BackoffDuration: time.Second},
+			e: config.MetricsRetry{MaxRetries: 2, BackoffDuration: time.Second},
+		},
+		"bad-backoff": {
+			r: config.MetricsRetry{MaxRetries: 1, BackoffDuration: -time.Second},
+			e: config.MetricsRetry{MaxRetries: 1, BackoffDuration: 250 * time.Millisecond},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.r.Validate()
+			assert.Equal(t, u.e, u.r)
+		})
+	}
+}
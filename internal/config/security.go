@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// Security tracks sensitive data handling options.
+type Security struct {
+	// DecodeSecrets allows secret data values to be decoded for display.
+	DecodeSecrets bool `json:"decodeSecrets" yaml:"decodeSecrets"`
+}
+
+// NewSecurity returns a new instance.
+func NewSecurity() *Security {
+	return &Security{}
+}
@@ -3,6 +3,17 @@
 
 package config
 
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
 const (
 	defaultRefreshRate  = 2
 	defaultMaxConnRetry = 5
@@ -12,6 +23,18 @@ const (
 
 	// MEM tracks memory usage.
 	MEM = "memory"
+
+	// DefaultSparklinesHistoryDepth tracks the default number of metrics samples kept per node.
+	DefaultSparklinesHistoryDepth = 60
+
+	// DefaultNodeAgeWarn tracks the default age past which a node is flagged as aging.
+	DefaultNodeAgeWarn = 30 * 24 * time.Hour
+
+	// DefaultNodeAgeCrit tracks the default age past which a node is flagged as stale.
+	DefaultNodeAgeCrit = 90 * 24 * time.Hour
+
+	// DefaultNodePoolLabel tracks the default node label used to group nodes into pools.
+	DefaultNodePoolLabel = "cloud.google.com/gke-nodepool"
 )
 
 // UI tracks ui specific configs.
@@ -37,6 +60,9 @@ type UI struct {
 	// NoIcons toggles icons display.
 	NoIcons bool `json:"noIcons" yaml:"noIcons"`
 
+	// A11y renders resources as high contrast plain text for screen readers.
+	A11y bool `json:"a11y" yaml:"a11y"`
+
 	// Skin reference the general k9s skin name.
 	// Can be overridden per context.
 	Skin string `json:"skin" yaml:"skin,omitempty"`
@@ -47,8 +73,114 @@ type UI struct {
 	// UseFullGVRTitle toggles the display of full GVR (group/version/resource) vs R in views title.
 	UseFullGVRTitle bool `json:"useFullGVRTitle" yaml:"useFullGVRTitle"`
 
+	// HighlightChanges toggles highlighting node cells changed since the last refresh.
+	HighlightChanges bool `json:"highlightChanges" yaml:"highlightChanges"`
+
+	// Sparklines tracks node metrics history settings.
+	Sparklines *Sparklines `json:"sparklines" yaml:"sparklines,omitempty"`
+
+	// NodeAgeThresholds tracks the node age coloring thresholds.
+	NodeAgeThresholds *NodeAgeThresholds `json:"nodeAgeThresholds" yaml:"nodeAgeThresholds,omitempty"`
+
+	// ColumnWidths tracks max column widths per resource GVR ie v1/pods: {NAME: 30}.
+	ColumnWidths map[string]map[string]int `json:"columnWidths" yaml:"columnWidths,omitempty"`
+
+	// Units tracks the resource units convention ie metric, binary or raw.
+	Units string `json:"units" yaml:"units,omitempty"`
+
 	manualHeadless   *bool
 	manualLogoless   *bool
 	manualCrumbsless *bool
 	manualSplashless *bool
+	manualA11y       *bool
+}
+
+// Sparklines tracks metrics history settings.
+type Sparklines struct {
+	// HistoryDepth tracks the number of metrics samples to retain.
+	HistoryDepth int `json:"historyDepth" yaml:"historyDepth"`
+}
+
+// SparklinesHistoryDepth returns the number of metrics samples to retain.
+func (u UI) SparklinesHistoryDepth() int {
+	if u.Sparklines == nil || u.Sparklines.HistoryDepth <= 0 {
+		return DefaultSparklinesHistoryDepth
+	}
+
+	return u.Sparklines.HistoryDepth
+}
+
+// ColumnWidthsFor returns a resource max column widths keyed by upper cased column names.
+// Resources are matched on their GVR and non positive widths are ignored.
+func (u UI) ColumnWidthsFor(gvr *client.GVR) map[string]int {
+	var ww map[string]int
+	for k, cc := range u.ColumnWidths {
+		if !strings.EqualFold(k, gvr.String()) {
+			continue
+		}
+		for col, w := range cc {
+			if w <= 0 {
+				continue
+			}
+			if ww == nil {
+				ww = make(map[string]int, len(cc))
+			}
+			ww[strings.ToUpper(col)] = w
+		}
+	}
+
+	return ww
+}
+
+// NodeAgeThresholds tracks node age staleness thresholds as durations ie 720h or 30d.
+type NodeAgeThresholds struct {
+	// Warn tracks the age past which a node is flagged as aging.
+	Warn string `json:"warn" yaml:"warn"`
+
+	// Crit tracks the age past which a node is flagged as stale.
+	Crit string `json:"crit" yaml:"crit"`
+}
+
+// NodeAgeLimits returns the node age warn and critical thresholds.
+// Missing or invalid thresholds fall back to the defaults.
+func (u UI) NodeAgeLimits() (warn, crit time.Duration) {
+	warn, crit = DefaultNodeAgeWarn, DefaultNodeAgeCrit
+	if u.NodeAgeThresholds == nil {
+		return
+	}
+	if d, err := parseAge(u.NodeAgeThresholds.Warn); err == nil {
+		warn = d
+	} else if u.NodeAgeThresholds.Warn != "" {
+		slog.Warn("Invalid node age warn threshold", slogs.Error, err)
+	}
+	if d, err := parseAge(u.NodeAgeThresholds.Crit); err == nil {
+		crit = d
+	} else if u.NodeAgeThresholds.Crit != "" {
+		slog.Warn("Invalid node age crit threshold", slogs.Error, err)
+	}
+
+	return
+}
+
+// parseAge parses a positive duration, additionally accepting a days suffix ie 30d.
+func parseAge(s string) (time.Duration, error) {
+	var (
+		d   time.Duration
+		err error
+	)
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		var n int
+		n, err = strconv.Atoi(days)
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		d, err = time.ParseDuration(s)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("age threshold must be positive: %q", s)
+	}
+
+	return d, nil
 }
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+// ConfigWarning tracks an unknown key found in a config file.
+type ConfigWarning struct {
+	Path string
+	Key  string
+}
+
+// String returns the warning message.
+func (w ConfigWarning) String() string {
+	return fmt.Sprintf("unknown key %q in %s", w.Key, w.Path)
+}
+
+// ValidateConfig reports the keys of a k9s config file that are not recognized.
+// Unreadable or malformed files yield no warnings as loading reports those.
+func ValidateConfig(path string) []ConfigWarning {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	kk, err := data.JSONValidator.UnknownKeys(json.K9sSchema, bb)
+	if err != nil {
+		slog.Debug("Unable to check config keys", slogs.Path, path, slogs.Error, err)
+		return nil
+	}
+	ww := make([]ConfigWarning, 0, len(kk))
+	for _, k := range kk {
+		ww = append(ww, ConfigWarning{Path: path, Key: k})
+	}
+
+	return ww
+}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfig(t *testing.T) {
+	uu := map[string]struct {
+		f string
+		e []config.ConfigWarning
+	}{
+		"happy": {
+			f: "testdata/configs/k9s.yaml",
+			e: []config.ConfigWarning{},
+		},
+		"toast": {
+			f: "testdata/configs/k9s_toast.yaml",
+			e: []config.ConfigWarning{
+				{Path: "testdata/configs/k9s_toast.yaml", Key: "k9s.disablePodCounts"},
+				{Path: "testdata/configs/k9s_toast.yaml", Key: "k9s.shellPods"},
+			},
+		},
+		"missing": {
+			f: "testdata/configs/blee.yaml",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.ValidateConfig(u.f))
+		})
+	}
+}
+
+func TestConfigWarningString(t *testing.T) {
+	w := config.ConfigWarning{Path: "config.yaml", Key: "k9s.ui.enableMous"}
+
+	assert.Equal(t, `unknown key "k9s.ui.enableMous" in config.yaml`, w.String())
+}
@@ -13,6 +13,10 @@ var accessors = Accessors{
 	*client.CoGVR:  new(Container),
 	*client.ScnGVR: new(ImageScan),
 	*client.SdGVR:  new(ScreenDump),
+	*client.MwGVR:  new(Maintenance),
+	*client.NplGVR: new(NodePool),
+	*client.QuoGVR: new(ResourceQuota),
+	*client.SrcGVR: new(Search),
 	*client.BeGVR:  new(Benchmark),
 	*client.PfGVR:  new(PortForward),
 	*client.DirGVR: new(Dir),
@@ -32,10 +36,17 @@ var accessors = Accessors{
 	*client.CjGVR:  new(CronJob),
 	*client.JobGVR: new(Job),
 
+	*client.HpaGVR:   new(HorizontalPodAutoscaler),
+	*client.HpaV2GVR: new(HorizontalPodAutoscaler),
+
 	*client.HmGVR:  new(HelmChart),
 	*client.HmhGVR: new(HelmHistory),
 
 	*client.CrdGVR: new(CustomResourceDefinition),
+
+	*client.NpGVR:  new(NetworkPolicy),
+	*client.PvcGVR: new(PersistentVolumeClaim),
+	*client.IngGVR: new(Ingress),
 }
 
 // Accessors represents a collection of dao accessors.
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"github.com/derailed/k9s/internal/audit"
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// auditAction records a mutating action on a resource in the audit log.
+func auditAction(f Factory, gvr *client.GVR, path, action string, params map[string]any, err error) {
+	if !audit.Enabled() {
+		return
+	}
+
+	ns, n := client.Namespaced(path)
+	e := audit.Entry{
+		Namespace: ns,
+		Name:      n,
+		Action:    action,
+		Params:    params,
+	}
+	if gvr != nil {
+		e.Resource = gvr.String()
+	}
+	if f != nil && f.Client() != nil {
+		if cfg := f.Client().Config(); cfg != nil {
+			e.User, _ = cfg.CurrentUserName()
+			e.Cluster, _ = cfg.CurrentClusterName()
+		}
+	}
+	audit.Log(e.WithOutcome(err))
+}
+
+// auditFn records a mutating action outcome.
+type auditFn func(gvr *client.GVR, path, action string, params map[string]any, err error)
+
+// auditorFor returns an audit function recording actions against the given factory.
+func auditorFor(f Factory) auditFn {
+	return func(gvr *client.GVR, path, action string, params map[string]any, err error) {
+		auditAction(f, gvr, path, action, params, err)
+	}
+}
+
+func cordonAction(cordon bool) string {
+	if cordon {
+		return "cordon"
+	}
+
+	return "uncordon"
+}
+
+func deleteParams(p *metav1.DeletionPropagation, grace Grace) map[string]any {
+	mm := make(map[string]any, 2)
+	if p != nil {
+		mm["propagation"] = string(*p)
+	}
+	if grace != DefaultGrace {
+		mm["gracePeriod"] = int64(grace)
+	}
+
+	return mm
+}
+
+func (o DrainOptions) auditParams() map[string]any {
+	return map[string]any{
+		"gracePeriod":        o.GracePeriodSeconds,
+		"timeout":            o.Timeout.String(),
+		"ignoreDaemonSets":   o.IgnoreAllDaemonSets,
+		"deleteEmptyDirData": o.DeleteEmptyDirData,
+		"force":              o.Force,
+		"disableEviction":    o.DisableEviction,
+		"evictionOrder":      string(o.EvictionOrder),
+		"markSafeToEvict":    o.MarkSafeToEvict,
+	}
+}
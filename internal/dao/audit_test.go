@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteParams(t *testing.T) {
+	fg := metav1.DeletePropagationForeground
+
+	uu := map[string]struct {
+		p     *metav1.DeletionPropagation
+		grace Grace
+		e     map[string]any
+	}{
+		"default": {
+			grace: DefaultGrace,
+			e:     map[string]any{},
+		},
+		"full": {
+			p:     &fg,
+			grace: 0,
+			e:     map[string]any{"propagation": "Foreground", "gracePeriod": int64(0)},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, deleteParams(u.p, u.grace))
+		})
+	}
+}
+
+func TestCordonAction(t *testing.T) {
+	assert.Equal(t, "cordon", cordonAction(true))
+	assert.Equal(t, "uncordon", cordonAction(false))
+}
@@ -4,15 +4,32 @@
 package dao
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
 	"sync"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// SummaryTopNodes tracks the number of top nodes reported in a cluster summary.
+	SummaryTopNodes = 5
+
+	// SummaryWarnings tracks the number of recent warnings reported in a cluster summary.
+	SummaryWarnings = 10
 )
 
 // RefScanner represents a resource reference scanner.
@@ -151,3 +168,184 @@ func ScanForSARefs(ctx context.Context, f Factory) (Refs, error) {
 
 	return res, nil
 }
+
+// NodeHealth tracks the cluster nodes readiness.
+type NodeHealth struct {
+	Ready, NotReady, Cordoned int
+}
+
+// Total returns the total number of nodes.
+func (h NodeHealth) Total() int {
+	return h.Ready + h.NotReady
+}
+
+// NodeUsage tracks a node resource usage.
+type NodeUsage struct {
+	Name    string
+	Usage   int64
+	Percent int
+}
+
+// ClusterSummary represents an aggregate cluster health snapshot.
+type ClusterSummary struct {
+	Nodes       NodeHealth
+	TopCPUNodes []NodeUsage
+	TopMEMNodes []NodeUsage
+	PodPhases   map[v1.PodPhase]int
+	PendingPVCs []string
+	Warnings    []*v1.Event
+}
+
+// FetchClusterSummary concurrently gathers nodes, pods, volume claims and events
+// to summarize the cluster health. Partial summaries are returned along with
+// any collection errors.
+func FetchClusterSummary(ctx context.Context, f Factory) (*ClusterSummary, error) {
+	var (
+		s    ClusterSummary
+		errs error
+		wg   sync.WaitGroup
+		mx   sync.Mutex
+	)
+	collect := func(fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mx.Lock()
+				errs = errors.Join(errs, err)
+				mx.Unlock()
+			}
+		}()
+	}
+
+	collect(func() error {
+		nl, err := FetchNodes(ctx, f, "")
+		if err != nil {
+			return err
+		}
+		s.Nodes = nodeHealth(nl.Items)
+		mmx, err := client.DialRetryingMetrics(f.Client()).FetchNodesMetricsMap(ctx)
+		if err != nil {
+			return err
+		}
+		s.TopCPUNodes = topNodes(nl.Items, mmx, v1.ResourceCPU, SummaryTopNodes)
+		s.TopMEMNodes = topNodes(nl.Items, mmx, v1.ResourceMemory, SummaryTopNodes)
+		return nil
+	})
+	collect(func() error {
+		oo, err := f.List(client.PodGVR, client.BlankNamespace, true, labels.Everything())
+		if err != nil {
+			return err
+		}
+		s.PodPhases = podPhaseCounts(oo)
+		return nil
+	})
+	collect(func() error {
+		oo, err := f.List(client.PvcGVR, client.BlankNamespace, true, labels.Everything())
+		if err != nil {
+			return err
+		}
+		s.PendingPVCs = pendingPVCs(oo)
+		return nil
+	})
+	collect(func() error {
+		dial, err := f.Client().Dial()
+		if err != nil {
+			return err
+		}
+		sel := fields.OneTermEqualSelector("type", v1.EventTypeWarning)
+		ll, err := dial.CoreV1().Events(client.BlankNamespace).List(ctx, metav1.ListOptions{FieldSelector: sel.String()})
+		if err != nil {
+			return err
+		}
+		s.Warnings = recentEvents(ll.Items, SummaryWarnings)
+		return nil
+	})
+	wg.Wait()
+
+	return &s, errs
+}
+
+func nodeHealth(nn []v1.Node) NodeHealth {
+	var h NodeHealth
+	for i := range nn {
+		if isNodeReady(&nn[i]) {
+			h.Ready++
+		} else {
+			h.NotReady++
+		}
+		if nn[i].Spec.Unschedulable {
+			h.Cordoned++
+		}
+	}
+
+	return h
+}
+
+// topNodes returns the n nodes using the most of the given resource.
+func topNodes(nn []v1.Node, mmx client.NodesMetricsMap, res v1.ResourceName, n int) []NodeUsage {
+	uu := make([]NodeUsage, 0, len(nn))
+	for i := range nn {
+		mx, ok := mmx[nn[i].Name]
+		if !ok {
+			continue
+		}
+		used, alloc := mx.Usage[res], nn[i].Status.Allocatable[res]
+		u := NodeUsage{Name: nn[i].Name, Usage: used.Value()}
+		if res == v1.ResourceCPU {
+			u.Usage = used.MilliValue()
+			u.Percent = client.ToPercentage(used.MilliValue(), alloc.MilliValue())
+		} else {
+			u.Percent = client.ToPercentage(used.Value(), alloc.Value())
+		}
+		uu = append(uu, u)
+	}
+	slices.SortFunc(uu, func(a, b NodeUsage) int {
+		return cmp.Or(cmp.Compare(b.Usage, a.Usage), strings.Compare(a.Name, b.Name))
+	})
+
+	return uu[:min(n, len(uu))]
+}
+
+func podPhaseCounts(oo []runtime.Object) map[v1.PodPhase]int {
+	mm := make(map[v1.PodPhase]int)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		mm[v1.PodPhase(phase)]++
+	}
+
+	return mm
+}
+
+func pendingPVCs(oo []runtime.Object) []string {
+	var pp []string
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if phase, _, _ := unstructured.NestedString(u.Object, "status", "phase"); phase == string(v1.ClaimPending) {
+			pp = append(pp, client.FQN(u.GetNamespace(), u.GetName()))
+		}
+	}
+	slices.Sort(pp)
+
+	return pp
+}
+
+// recentEvents returns the n most recent events.
+func recentEvents(ee []v1.Event, n int) []*v1.Event {
+	rr := make([]*v1.Event, 0, len(ee))
+	for i := range ee {
+		rr = append(rr, &ee[i])
+	}
+	slices.SortStableFunc(rr, func(a, b *v1.Event) int {
+		return eventTime(b).Compare(eventTime(a))
+	})
+
+	return rr[:min(n, len(rr))]
+}
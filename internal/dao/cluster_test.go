@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNodeHealth(t *testing.T) {
+	nn := []v1.Node{
+		makeSummaryNode("n1", v1.ConditionTrue, false),
+		makeSummaryNode("n2", v1.ConditionFalse, false),
+		makeSummaryNode("n3", v1.ConditionTrue, true),
+		{ObjectMeta: metav1.ObjectMeta{Name: "n4"}},
+	}
+
+	h := nodeHealth(nn)
+	assert.Equal(t, NodeHealth{Ready: 2, NotReady: 2, Cordoned: 1}, h)
+	assert.Equal(t, 4, h.Total())
+}
+
+func TestTopNodes(t *testing.T) {
+	nn := []v1.Node{
+		makeSummaryNode("n1", v1.ConditionTrue, false),
+		makeSummaryNode("n2", v1.ConditionTrue, false),
+		makeSummaryNode("n3", v1.ConditionTrue, false),
+		makeSummaryNode("n4", v1.ConditionTrue, false),
+	}
+	mmx := client.NodesMetricsMap{
+		"n1": makeSummaryMetrics("n1", "500m", "1Gi"),
+		"n2": makeSummaryMetrics("n2", "1", "512Mi"),
+		"n3": makeSummaryMetrics("n3", "250m", "2Gi"),
+	}
+
+	uu := map[string]struct {
+		res v1.ResourceName
+		n   int
+		e   []NodeUsage
+	}{
+		"cpu": {
+			res: v1.ResourceCPU,
+			n:   2,
+			e: []NodeUsage{
+				{Name: "n2", Usage: 1000, Percent: 50},
+				{Name: "n1", Usage: 500, Percent: 25},
+			},
+		},
+		"mem": {
+			res: v1.ResourceMemory,
+			n:   5,
+			e: []NodeUsage{
+				{Name: "n3", Usage: 2 * 1024 * 1024 * 1024, Percent: 50},
+				{Name: "n1", Usage: 1024 * 1024 * 1024, Percent: 25},
+				{Name: "n2", Usage: 512 * 1024 * 1024, Percent: 12},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, topNodes(nn, mmx, u.res, u.n))
+		})
+	}
+}
+
+func TestPodPhaseCounts(t *testing.T) {
+	oo := []runtime.Object{
+		makeSummaryObj("default", "p1", string(v1.PodRunning)),
+		makeSummaryObj("default", "p2", string(v1.PodRunning)),
+		makeSummaryObj("fred", "p3", string(v1.PodPending)),
+		makeSummaryObj("fred", "p4", string(v1.PodFailed)),
+	}
+
+	assert.Equal(t, map[v1.PodPhase]int{
+		v1.PodRunning: 2,
+		v1.PodPending: 1,
+		v1.PodFailed:  1,
+	}, podPhaseCounts(oo))
+}
+
+func TestPendingPVCs(t *testing.T) {
+	oo := []runtime.Object{
+		makeSummaryObj("fred", "c1", string(v1.ClaimPending)),
+		makeSummaryObj("default", "c2", string(v1.ClaimBound)),
+		makeSummaryObj("default", "c3", string(v1.ClaimPending)),
+	}
+
+	assert.Equal(t, []string{"default/c3", "fred/c1"}, pendingPVCs(oo))
+}
+
+func TestRecentEvents(t *testing.T) {
+	t0 := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	ee := []v1.Event{
+		{ObjectMeta: metav1.ObjectMeta{Name: "e1"}, LastTimestamp: metav1.NewTime(t0)},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e2"}, LastTimestamp: metav1.NewTime(t0.Add(2 * time.Minute))},
+		{ObjectMeta: metav1.ObjectMeta{Name: "e3"}, LastTimestamp: metav1.NewTime(t0.Add(time.Minute))},
+	}
+
+	rr := recentEvents(ee, 2)
+	assert.Len(t, rr, 2)
+	assert.Equal(t, "e2", rr[0].Name)
+	assert.Equal(t, "e3", rr[1].Name)
+	assert.Empty(t, recentEvents(nil, 2))
+}
+
+// Helpers...
+
+func makeSummaryNode(n string, ready v1.ConditionStatus, cordoned bool) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Spec:       v1.NodeSpec{Unschedulable: cordoned},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: ready},
+			},
+		},
+	}
+}
+
+func makeSummaryMetrics(n, cpu, mem string) *mv1beta1.NodeMetrics {
+	return &mv1beta1.NodeMetrics{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Usage: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(mem),
+		},
+	}
+}
+
+func makeSummaryObj(ns, n, phase string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"metadata": map[string]any{
+				"namespace": ns,
+				"name":      n,
+			},
+			"status": map[string]any{
+				"phase": phase,
+			},
+		},
+	}
+}
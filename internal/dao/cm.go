@@ -3,9 +3,80 @@
 
 package dao
 
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 var _ Accessor = (*ConfigMap)(nil)
 
+// imageTypes tracks binary entries content types that can be viewed as images.
+var imageTypes = []string{"image/png", "image/jpeg"}
+
 // ConfigMap represents a configmap resource.
 type ConfigMap struct {
 	Resource
 }
+
+// BinaryKeys returns a configmap binary data keys sorted by name.
+func (c *ConfigMap) BinaryKeys(namespace, name string) ([]string, error) {
+	cm, err := c.load(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	kk := make([]string, 0, len(cm.BinaryData))
+	for k := range cm.BinaryData {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+
+	return kk, nil
+}
+
+// GetBinaryEntry returns a configmap binary data entry raw bytes.
+func (c *ConfigMap) GetBinaryEntry(namespace, name, key string) ([]byte, error) {
+	cm, err := c.load(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	bb, ok := cm.BinaryData[key]
+	if !ok {
+		return nil, fmt.Errorf("no binary data entry %q found in configmap %s", key, client.FQN(namespace, name))
+	}
+
+	return bb, nil
+}
+
+func (c *ConfigMap) load(ns, n string) (*v1.ConfigMap, error) {
+	auth, err := c.Client().CanI(ns, c.gvr, n, client.GetAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to view configmap %s", client.FQN(ns, n))
+	}
+	o, err := c.getFactory().Get(c.gvr, client.FQN(ns, n), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var cm v1.ConfigMap
+	if err := fromUnstructured(o, &cm); err != nil {
+		return nil, err
+	}
+
+	return &cm, nil
+}
+
+// ImageType returns the content type of binary data if it is a viewable image.
+func ImageType(bb []byte) (string, bool) {
+	ct := http.DetectContentType(bb)
+
+	return ct, slices.Contains(imageTypes, ct)
+}
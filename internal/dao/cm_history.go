@@ -0,0 +1,247 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// HistoryAnnotation tracks the configmap data revisions pushed by k9s.
+	HistoryAnnotation = "k9s.io/history"
+
+	// maxAnnotationsSize tracks the api server limit on a resource total annotations size.
+	maxAnnotationsSize = 256 * 1024
+
+	cmEditRetries = 3
+)
+
+var cmHistoryDepth atomic.Int64
+
+// SetConfigMapHistoryDepth configures the number of configmap revisions to keep.
+func SetConfigMapHistoryDepth(n int) {
+	cmHistoryDepth.Store(int64(n))
+}
+
+// ConfigMapHistoryDepth returns the number of configmap revisions to keep.
+func ConfigMapHistoryDepth() int {
+	if n := int(cmHistoryDepth.Load()); n > 0 {
+		return n
+	}
+
+	return config.DefaultConfigMapHistoryDepth
+}
+
+// ConfigMapRevision represents a configmap data snapshot.
+type ConfigMapRevision struct {
+	Timestamp  time.Time         `json:"timestamp"`
+	Data       map[string]string `json:"data,omitempty"`
+	BinaryData map[string][]byte `json:"binaryData,omitempty"`
+}
+
+// History returns a configmap pushed revisions, most recent first.
+func (c *ConfigMap) History(namespace, name string) ([]ConfigMapRevision, error) {
+	cm, err := c.load(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeHistory(cm.Annotations[HistoryAnnotation])
+}
+
+// PushVersion snapshots a configmap current data into its history annotation.
+func (c *ConfigMap) PushVersion(ctx context.Context, namespace, name string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	path := client.FQN(namespace, name)
+	defer func() {
+		auditAction(c.getFactory(), c.gvr, path, "push version", nil, err)
+	}()
+
+	dial, err := c.dialUpdate(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return pushVersion(ctx, dial, namespace, name, ConfigMapHistoryDepth(), time.Now())
+}
+
+// RestoreVersion replaces a configmap data with the revision at the given history index.
+// The current data is pushed to the history first.
+func (c *ConfigMap) RestoreVersion(ctx context.Context, namespace, name string, index int) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	path := client.FQN(namespace, name)
+	defer func() {
+		auditAction(c.getFactory(), c.gvr, path, "restore version", map[string]any{"index": index}, err)
+	}()
+
+	dial, err := c.dialUpdate(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return restoreVersion(ctx, dial, namespace, name, index, ConfigMapHistoryDepth(), time.Now())
+}
+
+func (c *ConfigMap) dialUpdate(ns, n string) (kubernetes.Interface, error) {
+	auth, err := c.Client().CanI(ns, c.gvr, n, []string{client.UpdateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to update configmap %s", client.FQN(ns, n))
+	}
+
+	return c.Client().Dial()
+}
+
+func pushVersion(ctx context.Context, dial kubernetes.Interface, ns, n string, depth int, now time.Time) error {
+	return updateConfigMap(ctx, dial, ns, n, func(cm *v1.ConfigMap) error {
+		rr, err := decodeHistory(cm.Annotations[HistoryAnnotation])
+		if err != nil {
+			return err
+		}
+
+		return setHistory(cm, pushRevision(rr, cm, depth, now))
+	})
+}
+
+func restoreVersion(ctx context.Context, dial kubernetes.Interface, ns, n string, index, depth int, now time.Time) error {
+	return updateConfigMap(ctx, dial, ns, n, func(cm *v1.ConfigMap) error {
+		rr, err := decodeHistory(cm.Annotations[HistoryAnnotation])
+		if err != nil {
+			return err
+		}
+		if index < 0 || index >= len(rr) {
+			return fmt.Errorf("no revision #%d found in configmap %s history", index, client.FQN(ns, n))
+		}
+		r := rr[index]
+		if err := setHistory(cm, pushRevision(rr, cm, depth, now)); err != nil {
+			return err
+		}
+		cm.Data, cm.BinaryData = r.Data, r.BinaryData
+
+		return nil
+	})
+}
+
+// pushRevision prepends a configmap current data to its revisions, keeping at most depth ones.
+func pushRevision(rr []ConfigMapRevision, cm *v1.ConfigMap, depth int, now time.Time) []ConfigMapRevision {
+	rr = append([]ConfigMapRevision{{
+		Timestamp:  now.UTC(),
+		Data:       maps.Clone(cm.Data),
+		BinaryData: maps.Clone(cm.BinaryData),
+	}}, rr...)
+	if len(rr) > depth {
+		rr = rr[:depth]
+	}
+
+	return rr
+}
+
+// setHistory records revisions in a configmap history annotation. The oldest revisions
+// are dropped until the annotations fit the api server size limit.
+func setHistory(cm *v1.ConfigMap, rr []ConfigMapRevision) error {
+	var size int
+	for k, v := range cm.Annotations {
+		if k != HistoryAnnotation {
+			size += len(k) + len(v)
+		}
+	}
+	for ; len(rr) > 0; rr = rr[:len(rr)-1] {
+		raw, err := encodeHistory(rr)
+		if err != nil {
+			return err
+		}
+		if size+len(HistoryAnnotation)+len(raw) > maxAnnotationsSize {
+			continue
+		}
+		if cm.Annotations == nil {
+			cm.Annotations = make(map[string]string, 1)
+		}
+		cm.Annotations[HistoryAnnotation] = raw
+
+		return nil
+	}
+
+	return fmt.Errorf("configmap %s data exceeds the %dKiB annotations size limit", client.FQN(cm.Namespace, cm.Name), maxAnnotationsSize/1024)
+}
+
+// updateConfigMap applies an edit to a configmap retrying on conflicts.
+func updateConfigMap(ctx context.Context, dial kubernetes.Interface, ns, n string, edit func(*v1.ConfigMap) error) error {
+	cc := dial.CoreV1().ConfigMaps(ns)
+	for i := 0; ; i++ {
+		cm, err := cc.Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := edit(cm); err != nil {
+			return err
+		}
+		_, err = cc.Update(ctx, cm, metav1.UpdateOptions{})
+		if !kerrors.IsConflict(err) || i == cmEditRetries {
+			return err
+		}
+		slog.Debug("Configmap update conflict. Retrying", slogs.ResName, client.FQN(ns, n), slogs.Error, err)
+	}
+}
+
+// encodeHistory serializes revisions as base64 encoded gzipped json.
+func encodeHistory(rr []ConfigMapRevision) (string, error) {
+	var buff bytes.Buffer
+	w := gzip.NewWriter(&buff)
+	if err := json.NewEncoder(w).Encode(rr); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buff.Bytes()), nil
+}
+
+func decodeHistory(s string) ([]ConfigMapRevision, error) {
+	if s == "" {
+		return nil, nil
+	}
+	bb, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", HistoryAnnotation, err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(bb))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", HistoryAnnotation, err)
+	}
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", HistoryAnnotation, err)
+	}
+	var rr []ConfigMapRevision
+	if err := json.Unmarshal(raw, &rr); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation: %w", HistoryAnnotation, err)
+	}
+
+	return rr, nil
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestConfigMapHistoryCodec(t *testing.T) {
+	rr := []ConfigMapRevision{
+		{
+			Timestamp:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			Data:       map[string]string{"k1": "v1"},
+			BinaryData: map[string][]byte{"b1": {0, 1}},
+		},
+	}
+	raw, err := encodeHistory(rr)
+	require.NoError(t, err)
+
+	dd, err := decodeHistory(raw)
+	require.NoError(t, err)
+	assert.Equal(t, rr, dd)
+
+	dd, err = decodeHistory("")
+	require.NoError(t, err)
+	assert.Empty(t, dd)
+
+	_, err = decodeHistory("fred")
+	require.Error(t, err)
+}
+
+func TestPushVersion(t *testing.T) {
+	dial := fake.NewClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cm1"},
+		Data:       map[string]string{"k1": "v1"},
+	})
+	ctx, now := context.Background(), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cc := dial.CoreV1().ConfigMaps("ns1")
+
+	for i := range 3 {
+		cm, err := cc.Get(ctx, "cm1", metav1.GetOptions{})
+		require.NoError(t, err)
+		cm.Data["k1"] = []string{"v1", "v2", "v3"}[i]
+		_, err = cc.Update(ctx, cm, metav1.UpdateOptions{})
+		require.NoError(t, err)
+		require.NoError(t, pushVersion(ctx, dial, "ns1", "cm1", 2, now.Add(time.Duration(i)*time.Minute)))
+	}
+
+	cm, err := cc.Get(ctx, "cm1", metav1.GetOptions{})
+	require.NoError(t, err)
+	rr, err := decodeHistory(cm.Annotations[HistoryAnnotation])
+	require.NoError(t, err)
+	require.Len(t, rr, 2)
+	assert.Equal(t, "v3", rr[0].Data["k1"])
+	assert.Equal(t, now.Add(2*time.Minute), rr[0].Timestamp)
+	assert.Equal(t, "v2", rr[1].Data["k1"])
+
+	require.Error(t, pushVersion(ctx, dial, "ns1", "cm2", 2, now))
+}
+
+func TestRestoreVersion(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	raw, err := encodeHistory([]ConfigMapRevision{
+		{Data: map[string]string{"k1": "v2"}},
+		{Data: map[string]string{"k1": "v1"}, BinaryData: map[string][]byte{"b1": {1}}},
+	})
+	require.NoError(t, err)
+
+	uu := map[string]struct {
+		index int
+		data  map[string]string
+		bin   map[string][]byte
+		err   bool
+	}{
+		"latest": {
+			data: map[string]string{"k1": "v2"},
+		},
+		"oldest": {
+			index: 1,
+			data:  map[string]string{"k1": "v1"},
+			bin:   map[string][]byte{"b1": {1}},
+		},
+		"toast": {
+			index: 2,
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewClientset(&v1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "ns1",
+					Name:        "cm1",
+					Annotations: map[string]string{HistoryAnnotation: raw},
+				},
+				Data: map[string]string{"k1": "v3"},
+			})
+			err := restoreVersion(context.Background(), dial, "ns1", "cm1", u.index, 5, now)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			cm, err := dial.CoreV1().ConfigMaps("ns1").Get(context.Background(), "cm1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, u.data, cm.Data)
+			assert.Equal(t, u.bin, cm.BinaryData)
+			rr, err := decodeHistory(cm.Annotations[HistoryAnnotation])
+			require.NoError(t, err)
+			require.Len(t, rr, 3)
+			assert.Equal(t, map[string]string{"k1": "v3"}, rr[0].Data)
+			assert.Equal(t, now, rr[0].Timestamp)
+		})
+	}
+}
+
+func TestSetHistory(t *testing.T) {
+	big := func(n int) map[string][]byte {
+		bb := make([]byte, n)
+		_, err := rand.Read(bb)
+		require.NoError(t, err)
+		return map[string][]byte{"b1": bb}
+	}
+	rr := []ConfigMapRevision{
+		{BinaryData: big(64 * 1024)},
+		{BinaryData: big(64 * 1024)},
+		{BinaryData: big(64 * 1024)},
+		{BinaryData: big(64 * 1024)},
+	}
+
+	cm := v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "cm1"}}
+	require.NoError(t, setHistory(&cm, rr))
+	dd, err := decodeHistory(cm.Annotations[HistoryAnnotation])
+	require.NoError(t, err)
+	assert.Len(t, dd, 2)
+	assert.Equal(t, rr[:2], dd)
+
+	err = setHistory(&cm, []ConfigMapRevision{{BinaryData: big(maxAnnotationsSize)}})
+	require.Error(t, err)
+	assert.Equal(t, "configmap ns1/cm1 data exceeds the 256KiB annotations size limit", err.Error())
+}
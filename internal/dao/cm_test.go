@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR")
+
+func TestConfigMapBinaryKeys(t *testing.T) {
+	var cm dao.ConfigMap
+	cm.Init(makeCMFactory(t, true), client.CmGVR)
+
+	kk, err := cm.BinaryKeys("default", "cm1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logo.png", "raw.bin"}, kk)
+}
+
+func TestConfigMapGetBinaryEntry(t *testing.T) {
+	uu := map[string]struct {
+		key  string
+		auth bool
+		e    []byte
+		err  bool
+	}{
+		"happy": {
+			key:  "raw.bin",
+			auth: true,
+			e:    []byte{0, 1, 2},
+		},
+		"missing": {
+			key:  "fred",
+			auth: true,
+			err:  true,
+		},
+		"denied": {
+			key: "raw.bin",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var cm dao.ConfigMap
+			cm.Init(makeCMFactory(t, u.auth), client.CmGVR)
+
+			bb, err := cm.GetBinaryEntry("default", "cm1", u.key)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, bb)
+		})
+	}
+}
+
+func TestImageType(t *testing.T) {
+	ct, ok := dao.ImageType(pngHeader)
+	assert.True(t, ok)
+	assert.Equal(t, "image/png", ct)
+
+	_, ok = dao.ImageType([]byte{0, 1, 2})
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+type cmConn struct {
+	*conn
+	auth bool
+}
+
+func (c cmConn) CanI(string, *client.GVR, string, []string) (bool, error) { return c.auth, nil }
+
+type cmFactory struct {
+	*testFactory
+	auth bool
+}
+
+func (f cmFactory) Client() client.Connection {
+	return cmConn{conn: makeConn(), auth: f.auth}
+}
+
+func makeCMFactory(t *testing.T, auth bool) dao.Factory {
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm1", Namespace: "default"},
+		BinaryData: map[string][]byte{
+			"raw.bin":  {0, 1, 2},
+			"logo.png": pngHeader,
+		},
+	})
+	require.NoError(t, err)
+
+	return cmFactory{
+		testFactory: &testFactory{
+			inventory: map[string]map[*client.GVR][]runtime.Object{
+				"default": {
+					client.CmGVR: {&unstructured.Unstructured{Object: o}},
+				},
+			},
+		},
+		auth: auth,
+	}
+}
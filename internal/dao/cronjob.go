@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
@@ -44,7 +45,14 @@ func (c *CronJob) ListImages(_ context.Context, fqn string) ([]string, error) {
 }
 
 // Run a CronJob.
-func (c *CronJob) Run(path string) error {
+func (c *CronJob) Run(path string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(c.getFactory(), c.gvr, path, "trigger", nil, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, c.gvr, n, []string{client.GetVerb, client.CreateVerb})
 	if err != nil {
@@ -140,8 +148,26 @@ func (c *CronJob) GetInstance(fqn string) (*batchv1.CronJob, error) {
 	return &cj, nil
 }
 
+// GetMissedRuns returns the cronjob scheduled runs since it was last scheduled for which
+// no job was created.
+func (c *CronJob) GetMissedRuns(_ context.Context, namespace, name string) ([]time.Time, error) {
+	cj, err := c.GetInstance(client.FQN(namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	return render.MissedCronRuns(cj, time.Now())
+}
+
 // ToggleSuspend toggles suspend/resume on a CronJob.
-func (c *CronJob) ToggleSuspend(ctx context.Context, path string) error {
+func (c *CronJob) ToggleSuspend(ctx context.Context, path string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(c.getFactory(), c.gvr, path, "toggle suspend", nil, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, c.gvr, n, []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -5,21 +5,26 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 	"k8s.io/kubectl/pkg/scheme"
 )
@@ -35,6 +40,13 @@ var (
 	_ ImageLister     = (*Deployment)(nil)
 )
 
+const (
+	// PausedGenerationAnnotation tracks a deployment generation at the time k9s paused it.
+	PausedGenerationAnnotation = "k9s.io/paused-generation"
+
+	rsRevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
 // Deployment represents a deployment K8s resource.
 type Deployment struct {
 	Resource
@@ -55,6 +67,130 @@ func (d *Deployment) Scale(ctx context.Context, path string, replicas int32) err
 	return scaleRes(ctx, d.getFactory(), client.DpGVR, path, replicas)
 }
 
+// HPABounds tracks the replicas bounds of an autoscaler targeting a resource.
+type HPABounds struct {
+	Namespace   string
+	Name        string
+	MinReplicas int32
+	MaxReplicas int32
+}
+
+// HPABounds returns the bounds of the autoscaler targeting a Deployment or nil if none.
+func (d *Deployment) HPABounds(ctx context.Context, namespace, name string) (*HPABounds, error) {
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return findHPABounds(ctx, dial, namespace, schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"}, name)
+}
+
+// UpdateHPABounds updates the replicas bounds of an autoscaler.
+func (d *Deployment) UpdateHPABounds(ctx context.Context, b HPABounds) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(d.getFactory(), client.HpaGVR, client.FQN(b.Namespace, b.Name), "update bounds", map[string]any{
+			"minReplicas": b.MinReplicas,
+			"maxReplicas": b.MaxReplicas,
+		}, err)
+	}()
+
+	if b.MinReplicas < 1 || b.MaxReplicas < b.MinReplicas {
+		return fmt.Errorf("invalid hpa bounds (min: %d, max: %d)", b.MinReplicas, b.MaxReplicas)
+	}
+	auth, err := d.Client().CanI(b.Namespace, client.HpaGVR, b.Name, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update hpa %q", client.FQN(b.Namespace, b.Name))
+	}
+	dial, err := d.Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	return patchHPABounds(ctx, dial, b)
+}
+
+// Pause pauses a Deployment rollout. Spec changes made while paused are rolled out on resume.
+func (d *Deployment) Pause(ctx context.Context, namespace, name string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(d.getFactory(), d.gvr, client.FQN(namespace, name), "pause", nil, err)
+	}()
+
+	dial, err := d.dialPatch(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return pauseDeployment(ctx, dial, namespace, name)
+}
+
+// Resume resumes a paused Deployment rollout.
+func (d *Deployment) Resume(ctx context.Context, namespace, name string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(d.getFactory(), d.gvr, client.FQN(namespace, name), "resume", nil, err)
+	}()
+
+	dial, err := d.dialPatch(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	return resumeDeployment(ctx, dial, namespace, name)
+}
+
+// PendingChanges returns true if a paused Deployment pod template differs from
+// the one its newest ReplicaSet rolled out.
+func (d *Deployment) PendingChanges(fqn string) (bool, error) {
+	dp, err := d.GetInstance(fqn)
+	if err != nil {
+		return false, err
+	}
+	if !dp.Spec.Paused {
+		return false, nil
+	}
+	oo, err := d.getFactory().List(client.RsGVR, dp.Namespace, true, labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	rss := make([]*appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return false, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var rs appsv1.ReplicaSet
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &rs); err != nil {
+			return false, err
+		}
+		rss = append(rss, &rs)
+	}
+
+	return pendingChanges(dp, rss), nil
+}
+
+func (d *Deployment) dialPatch(ns, n string) (kubernetes.Interface, error) {
+	auth, err := d.Client().CanI(ns, d.gvr, n, client.PatchAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to patch deployment %s", client.FQN(ns, n))
+	}
+
+	return d.Client().Dial()
+}
+
 // Restart a Deployment rollout.
 func (d *Deployment) Restart(ctx context.Context, path string) error {
 	return restartRes[*appsv1.Deployment](ctx, d.getFactory(), client.DpGVR, path)
@@ -198,7 +334,14 @@ func (d *Deployment) GetPodSpec(path string) (*v1.PodSpec, error) {
 }
 
 // SetImages sets container images.
-func (d *Deployment) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+func (d *Deployment) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(d.getFactory(), d.gvr, path, "set image", map[string]any{"images": imageSpecs}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := d.Client().CanI(ns, d.gvr, n, client.PatchAccess)
 	if err != nil {
@@ -358,7 +501,14 @@ func containerHasConfigMap(envFrom []v1.EnvFromSource, env []v1.EnvVar, name str
 	return false
 }
 
-func scaleRes(ctx context.Context, f Factory, gvr *client.GVR, path string, replicas int32) error {
+func scaleRes(ctx context.Context, f Factory, gvr *client.GVR, path string, replicas int32) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(f, gvr, path, "scale", map[string]any{"replicas": replicas}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := f.Client().CanI(ns, client.NewGVR(gvr.String()+":scale"), n, []string{client.GetVerb, client.UpdateVerb})
 	if err != nil {
@@ -395,7 +545,14 @@ func scaleRes(ctx context.Context, f Factory, gvr *client.GVR, path string, repl
 	}
 }
 
-func restartRes[T runtime.Object](ctx context.Context, f Factory, gvr *client.GVR, path string) error {
+func restartRes[T runtime.Object](ctx context.Context, f Factory, gvr *client.GVR, path string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(f, gvr, path, "restart", nil, err)
+	}()
+
 	o, err := f.Get(gvr, path, true, labels.Everything())
 	if err != nil {
 		return err
@@ -464,3 +621,124 @@ func restartRes[T runtime.Object](ctx context.Context, f Factory, gvr *client.GV
 
 	return err
 }
+
+// pauseDeployment pauses a deployment and records the generation it was paused at.
+func pauseDeployment(ctx context.Context, dial kubernetes.Interface, ns, n string) error {
+	dd := dial.AppsV1().Deployments(ns)
+	dp, err := dd.Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if dp.Spec.Paused {
+		return fmt.Errorf("deployment %s is already paused", client.FQN(ns, n))
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{"paused": true},
+		"metadata": map[string]any{
+			"annotations": map[string]string{PausedGenerationAnnotation: strconv.FormatInt(dp.Generation, 10)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = dd.Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// resumeDeployment resumes a deployment and clears its paused generation.
+func resumeDeployment(ctx context.Context, dial kubernetes.Interface, ns, n string) error {
+	dd := dial.AppsV1().Deployments(ns)
+	dp, err := dd.Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !dp.Spec.Paused {
+		return fmt.Errorf("deployment %s is not paused", client.FQN(ns, n))
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec":     map[string]any{"paused": false},
+		"metadata": map[string]any{"annotations": map[string]any{PausedGenerationAnnotation: nil}},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = dd.Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// pendingChanges returns true if a paused deployment pod template differs from its
+// newest replicaset one. Like the deployment controller, templates are compared
+// ignoring the pod-template-hash label so replicas changes are not reported.
+func pendingChanges(dp *appsv1.Deployment, rss []*appsv1.ReplicaSet) bool {
+	if !dp.Spec.Paused {
+		return false
+	}
+	var (
+		newest *appsv1.ReplicaSet
+		rev    int64
+	)
+	for _, rs := range rss {
+		if !metav1.IsControlledBy(rs, dp) {
+			continue
+		}
+		r, _ := strconv.ParseInt(rs.Annotations[rsRevisionAnnotation], 10, 64)
+		if newest == nil || r > rev {
+			newest, rev = rs, r
+		}
+	}
+	if newest == nil {
+		return true
+	}
+	tpl := newest.Spec.Template.DeepCopy()
+	delete(tpl.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+
+	return !apiequality.Semantic.DeepEqual(tpl, &dp.Spec.Template)
+}
+
+// findHPABounds locates the autoscaler targeting the given resource.
+func findHPABounds(ctx context.Context, dial kubernetes.Interface, ns string, gk schema.GroupKind, n string) (*HPABounds, error) {
+	ll, err := dial.AutoscalingV1().HorizontalPodAutoscalers(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range ll.Items {
+		hpa := &ll.Items[i]
+		ref := hpa.Spec.ScaleTargetRef
+		if ref.Kind != gk.Kind || ref.Name != n {
+			continue
+		}
+		if gv, err := schema.ParseGroupVersion(ref.APIVersion); err != nil || gv.Group != gk.Group {
+			continue
+		}
+		b := HPABounds{
+			Namespace:   hpa.Namespace,
+			Name:        hpa.Name,
+			MinReplicas: 1,
+			MaxReplicas: hpa.Spec.MaxReplicas,
+		}
+		if hpa.Spec.MinReplicas != nil {
+			b.MinReplicas = *hpa.Spec.MinReplicas
+		}
+		return &b, nil
+	}
+
+	return nil, nil
+}
+
+// patchHPABounds sets an autoscaler min and max replicas.
+func patchHPABounds(ctx context.Context, dial kubernetes.Interface, b HPABounds) error {
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"minReplicas": b.MinReplicas,
+			"maxReplicas": b.MaxReplicas,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = dial.AutoscalingV1().HorizontalPodAutoscalers(b.Namespace).Patch(ctx, b.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
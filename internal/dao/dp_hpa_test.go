@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func makeHPA(ns, n, apiVersion, kind, target string, lo *int32, hi int32) *autoscalingv1.HorizontalPodAutoscaler {
+	return &autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{APIVersion: apiVersion, Kind: kind, Name: target},
+			MinReplicas:    lo,
+			MaxReplicas:    hi,
+		},
+	}
+}
+
+func TestFindHPABounds(t *testing.T) {
+	dial := fake.NewClientset(
+		makeHPA("ns1", "h1", "apps/v1", "StatefulSet", "web", ptr.To[int32](2), 4),
+		makeHPA("ns1", "h2", "apps/v1", "Deployment", "web", ptr.To[int32](2), 5),
+		makeHPA("ns1", "h3", "apps/v1", "Deployment", "api", nil, 3),
+		makeHPA("ns1", "h4", "fred.io/v1", "Deployment", "crd", nil, 3),
+		makeHPA("ns2", "h5", "apps/v1", "Deployment", "db", nil, 3),
+	)
+
+	uu := map[string]struct {
+		target string
+		e      *HPABounds
+	}{
+		"bounds": {
+			target: "web",
+			e:      &HPABounds{Namespace: "ns1", Name: "h2", MinReplicas: 2, MaxReplicas: 5},
+		},
+		"default-min": {
+			target: "api",
+			e:      &HPABounds{Namespace: "ns1", Name: "h3", MinReplicas: 1, MaxReplicas: 3},
+		},
+		"other-group": {
+			target: "crd",
+		},
+		"none": {
+			target: "db",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b, err := findHPABounds(context.Background(), dial, "ns1", schema.GroupKind{Group: "apps", Kind: "Deployment"}, u.target)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, b)
+		})
+	}
+}
+
+func TestPatchHPABounds(t *testing.T) {
+	dial := fake.NewClientset(makeHPA("ns1", "h1", "apps/v1", "Deployment", "web", nil, 3))
+	ctx := context.Background()
+
+	require.NoError(t, patchHPABounds(ctx, dial, HPABounds{Namespace: "ns1", Name: "h1", MinReplicas: 2, MaxReplicas: 6}))
+	o, err := dial.AutoscalingV1().HorizontalPodAutoscalers("ns1").Get(ctx, "h1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](2), o.Spec.MinReplicas)
+	assert.Equal(t, int32(6), o.Spec.MaxReplicas)
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPauseResumeDeployment(t *testing.T) {
+	ctx := context.Background()
+	dial := fake.NewClientset(&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp1", Generation: 3},
+	})
+	dd := dial.AppsV1().Deployments("ns1")
+
+	require.NoError(t, pauseDeployment(ctx, dial, "ns1", "dp1"))
+	dp, err := dd.Get(ctx, "dp1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, dp.Spec.Paused)
+	assert.Equal(t, "3", dp.Annotations[PausedGenerationAnnotation])
+	require.Error(t, pauseDeployment(ctx, dial, "ns1", "dp1"))
+
+	require.NoError(t, resumeDeployment(ctx, dial, "ns1", "dp1"))
+	dp, err = dd.Get(ctx, "dp1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, dp.Spec.Paused)
+	assert.NotContains(t, dp.Annotations, PausedGenerationAnnotation)
+	require.Error(t, resumeDeployment(ctx, dial, "ns1", "dp1"))
+}
+
+func TestPendingChanges(t *testing.T) {
+	tpl := v1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "a1"}},
+		Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "c1", Image: "i1:0.1"}}},
+	}
+	dp := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp1", UID: "dp1"},
+		Spec:       appsv1.DeploymentSpec{Paused: true, Template: tpl},
+	}
+	makeRS := func(n, rev, image string, owner *appsv1.Deployment) *appsv1.ReplicaSet {
+		rt := tpl.DeepCopy()
+		rt.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = n
+		rt.Spec.Containers[0].Image = image
+		rs := appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "ns1",
+				Name:        n,
+				Annotations: map[string]string{rsRevisionAnnotation: rev},
+			},
+			Spec: appsv1.ReplicaSetSpec{Template: *rt},
+		}
+		if owner != nil {
+			rs.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(owner, appsv1.SchemeGroupVersion.WithKind("Deployment"))}
+		}
+		return &rs
+	}
+	running := dp.DeepCopy()
+	running.Spec.Paused = false
+
+	uu := map[string]struct {
+		dp  *appsv1.Deployment
+		rss []*appsv1.ReplicaSet
+		e   bool
+	}{
+		"none": {
+			dp:  &dp,
+			rss: []*appsv1.ReplicaSet{makeRS("rs1", "1", "i1:0.0", &dp), makeRS("rs2", "2", "i1:0.1", &dp)},
+		},
+		"pending": {
+			dp:  &dp,
+			rss: []*appsv1.ReplicaSet{makeRS("rs1", "2", "i1:0.0", &dp), makeRS("rs2", "1", "i1:0.1", &dp)},
+			e:   true,
+		},
+		"not-owned": {
+			dp:  &dp,
+			rss: []*appsv1.ReplicaSet{makeRS("rs1", "1", "i1:0.1", &dp), makeRS("rs2", "2", "i1:0.0", nil)},
+		},
+		"no-rs": {
+			dp: &dp,
+			e:  true,
+		},
+		"running": {
+			dp:  running,
+			rss: []*appsv1.ReplicaSet{makeRS("rs1", "1", "i1:0.0", running)},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, pendingChanges(u.dp, u.rss))
+		})
+	}
+}
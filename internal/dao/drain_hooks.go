@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"runtime"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// PreDrainHook tracks hooks running before a node drain.
+	PreDrainHook = "pre-drain"
+
+	// PostDrainHook tracks hooks running after a node drain.
+	PostDrainHook = "post-drain"
+
+	// DefaultDrainHookTimeout tracks how long a drain hook may run.
+	DefaultDrainHookTimeout = time.Minute
+
+	// drainHookWaitDelay tracks how long to wait for a killed hook output to drain.
+	drainHookWaitDelay = time.Second
+)
+
+// DrainHookData represents the values available to drain hooks templates.
+type DrainHookData struct {
+	NodeName string
+}
+
+// RunDrainHooks executes the given hooks sequentially for a node.
+// Each hook is a shell command template rendered with DrainHookData. Hooks output
+// is written to w and the first failing hook aborts the remaining ones.
+// Each hook is killed once the given timeout elapses or DefaultDrainHookTimeout if not set.
+func (n *Node) RunDrainHooks(ctx context.Context, kind string, hooks []string, nodeName string, timeout time.Duration, w io.Writer) error {
+	return execDrainHooks(ctx, kind, hooks, nodeName, timeout, w, auditorFor(n.getFactory()))
+}
+
+func execDrainHooks(ctx context.Context, kind string, hooks []string, nodeName string, timeout time.Duration, w io.Writer, audit auditFn) error {
+	if timeout <= 0 {
+		timeout = DefaultDrainHookTimeout
+	}
+	for i, h := range hooks {
+		cmd, err := renderDrainHook(h, nodeName)
+		if err != nil {
+			return fmt.Errorf("%s hook #%d: %w", kind, i, err)
+		}
+		_, _ = fmt.Fprintf(w, "[%s] %s hook: %s\n", nodeName, kind, cmd)
+		err = runDrainHook(ctx, cmd, timeout, w)
+		audit(client.NodeGVR, nodeName, "drain-hook", map[string]any{"kind": kind, "command": cmd}, err)
+		if err != nil {
+			return fmt.Errorf("%s hook %q failed: %w", kind, cmd, err)
+		}
+	}
+
+	return nil
+}
+
+func runDrainHook(ctx context.Context, cmd string, timeout time.Duration, w io.Writer) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := shellCmd(ctx, cmd, w).Run()
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+
+	return err
+}
+
+func renderDrainHook(h, nodeName string) (string, error) {
+	tpl, err := template.New("hook").Option("missingkey=error").Parse(h)
+	if err != nil {
+		return "", err
+	}
+	var buff bytes.Buffer
+	if err := tpl.Execute(&buff, DrainHookData{NodeName: nodeName}); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+func shellCmd(ctx context.Context, cmd string, w io.Writer) *exec.Cmd {
+	c := exec.CommandContext(ctx, "sh", "-c", cmd)
+	if runtime.GOOS == "windows" {
+		c = exec.CommandContext(ctx, "cmd", "/C", cmd)
+	}
+	c.Stdout, c.Stderr = w, w
+	c.WaitDelay = drainHookWaitDelay
+
+	return c
+}
@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderDrainHook(t *testing.T) {
+	uu := map[string]struct {
+		h, e string
+		err  bool
+	}{
+		"plain": {
+			h: "echo hello",
+			e: "echo hello",
+		},
+		"node": {
+			h: "silence --host {{.NodeName}}",
+			e: "silence --host n1",
+		},
+		"unknown-field": {
+			h:   "echo {{.Fred}}",
+			err: true,
+		},
+		"toast": {
+			h:   "echo {{.NodeName",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, err := renderDrainHook(u.h, "n1")
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, cmd)
+		})
+	}
+}
+
+func TestRunDrainHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires a posix shell")
+	}
+
+	var (
+		buff bytes.Buffer
+		aa   []string
+	)
+	audit := func(_ *client.GVR, path, action string, params map[string]any, err error) {
+		aa = append(aa, fmt.Sprintf("%s %s %v %t", path, action, params["command"], err == nil))
+	}
+	require.NoError(t, execDrainHooks(context.Background(), PreDrainHook, []string{"echo pre {{.NodeName}}"}, "n1", 0, &buff, audit))
+	assert.Equal(t, "[n1] pre-drain hook: echo pre n1\npre n1\n", buff.String())
+	assert.Equal(t, []string{"n1 drain-hook echo pre n1 true"}, aa)
+
+	buff.Reset()
+	aa = nil
+	err := execDrainHooks(context.Background(), PreDrainHook, []string{"echo boom >&2; exit 1", "echo skipped"}, "n1", 0, &buff, audit)
+	require.Error(t, err)
+	assert.Equal(t, []string{"n1 drain-hook echo boom >&2; exit 1 false"}, aa)
+	assert.Contains(t, buff.String(), "boom")
+	assert.NotContains(t, buff.String(), "skipped\n")
+
+	buff.Reset()
+	err = execDrainHooks(context.Background(), PreDrainHook, []string{"sleep 5", "echo skipped"}, "n1", 100*time.Millisecond, &buff, audit)
+	require.ErrorContains(t, err, "timed out after 100ms")
+	assert.NotContains(t, buff.String(), "skipped\n")
+}
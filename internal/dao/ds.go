@@ -235,7 +235,14 @@ func (d *DaemonSet) GetPodSpec(path string) (*v1.PodSpec, error) {
 }
 
 // SetImages sets container images.
-func (d *DaemonSet) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+func (d *DaemonSet) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(d.getFactory(), d.gvr, path, "set image", map[string]any{"images": imageSpecs}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := d.Client().CanI(ns, d.gvr, n, client.PatchAccess)
 	if err != nil {
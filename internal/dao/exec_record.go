@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// ExecInput tracks an exec session chunk typed by the user.
+	ExecInput = "in"
+
+	// ExecOutput tracks an exec session chunk emitted by the container.
+	ExecOutput = "out"
+)
+
+// ExecRecord represents a recorded exec session chunk.
+type ExecRecord struct {
+	Timestamp time.Time `json:"timestamp"`
+	Direction string    `json:"direction"`
+	Content   string    `json:"content"`
+}
+
+// execRecorder records exec session streams as JSON lines.
+type execRecorder struct {
+	mx  sync.Mutex
+	enc *json.Encoder
+	now func() time.Time
+	err error
+}
+
+func newExecRecorder(w io.Writer) *execRecorder {
+	return &execRecorder{
+		enc: json.NewEncoder(w),
+		now: time.Now,
+	}
+}
+
+// Reader tees the given input stream into the recording.
+func (r *execRecorder) Reader(dir string, in io.Reader) io.Reader {
+	return io.TeeReader(in, recordWriter{rec: r, dir: dir})
+}
+
+// Writer tees the given output stream into the recording.
+func (r *execRecorder) Writer(dir string, out io.Writer) io.Writer {
+	return io.MultiWriter(out, recordWriter{rec: r, dir: dir})
+}
+
+// Err returns the first recording error if any.
+func (r *execRecorder) Err() error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	return r.err
+}
+
+func (r *execRecorder) record(dir string, b []byte) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if r.err != nil {
+		return
+	}
+	r.err = r.enc.Encode(ExecRecord{
+		Timestamp: r.now(),
+		Direction: dir,
+		Content:   string(b),
+	})
+}
+
+// recordWriter records any written chunk without failing the session stream.
+type recordWriter struct {
+	rec *execRecorder
+	dir string
+}
+
+func (w recordWriter) Write(b []byte) (int, error) {
+	if len(b) > 0 {
+		w.rec.record(w.dir, b)
+	}
+
+	return len(b), nil
+}
+
+// ReadExecRecording loads an exec session recording.
+func ReadExecRecording(r io.Reader) ([]ExecRecord, error) {
+	var (
+		rr  []ExecRecord
+		dec = json.NewDecoder(r)
+	)
+	for {
+		var rec ExecRecord
+		err := dec.Decode(&rec)
+		if errors.Is(err, io.EOF) {
+			return rr, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid exec recording entry #%d: %w", len(rr)+1, err)
+		}
+		rr = append(rr, rec)
+	}
+}
+
+// PlaybackExec replays the recorded session output honoring the original pacing.
+// Pauses between chunks are capped by maxDelay. Input chunks are skipped since
+// terminals echo them back as output.
+func PlaybackExec(ctx context.Context, rr []ExecRecord, w io.Writer, maxDelay time.Duration) error {
+	var last time.Time
+	for _, r := range rr {
+		if r.Direction != ExecOutput {
+			continue
+		}
+		if !last.IsZero() {
+			if d := min(r.Timestamp.Sub(last), maxDelay); d > 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(d):
+				}
+			}
+		}
+		last = r.Timestamp
+		if _, err := io.WriteString(w, r.Content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecRecorder(t *testing.T) {
+	var (
+		buff, out bytes.Buffer
+		now       = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+	rec := newExecRecorder(&buff)
+	rec.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	in, err := io.ReadAll(rec.Reader(ExecInput, strings.NewReader("ls\r")))
+	require.NoError(t, err)
+	assert.Equal(t, "ls\r", string(in))
+	_, err = rec.Writer(ExecOutput, &out).Write([]byte("fred.txt\r\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "fred.txt\r\n", out.String())
+	require.NoError(t, rec.Err())
+
+	rr, err := ReadExecRecording(&buff)
+	require.NoError(t, err)
+	assert.Equal(t, []ExecRecord{
+		{
+			Timestamp: time.Date(2025, 1, 1, 0, 0, 1, 0, time.UTC),
+			Direction: ExecInput,
+			Content:   "ls\r",
+		},
+		{
+			Timestamp: time.Date(2025, 1, 1, 0, 0, 2, 0, time.UTC),
+			Direction: ExecOutput,
+			Content:   "fred.txt\r\n",
+		},
+	}, rr)
+}
+
+func TestReadExecRecording(t *testing.T) {
+	uu := map[string]struct {
+		raw string
+		n   int
+		err bool
+	}{
+		"empty": {},
+		"records": {
+			raw: `{"timestamp":"2025-01-01T00:00:00Z","direction":"in","content":"ls"}
+{"timestamp":"2025-01-01T00:00:01Z","direction":"out","content":"fred"}
+`,
+			n: 2,
+		},
+		"toast": {
+			raw: `{"timestamp":"2025-01-01T00:00:00Z","direction":"in","content":"ls"}
+blee`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, err := ReadExecRecording(strings.NewReader(u.raw))
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, rr, u.n)
+		})
+	}
+}
+
+func TestPlaybackExec(t *testing.T) {
+	now := time.Now()
+	rr := []ExecRecord{
+		{Timestamp: now, Direction: ExecOutput, Content: "$ "},
+		{Timestamp: now.Add(time.Second), Direction: ExecInput, Content: "ls\r"},
+		{Timestamp: now.Add(time.Hour), Direction: ExecOutput, Content: "ls\r\nfred\r\n"},
+	}
+
+	var buff bytes.Buffer
+	require.NoError(t, PlaybackExec(context.Background(), rr, &buff, time.Millisecond))
+	assert.Equal(t, "$ ls\r\nfred\r\n", buff.String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	buff.Reset()
+	require.ErrorIs(t, PlaybackExec(ctx, rr, &buff, time.Hour), context.Canceled)
+	assert.Equal(t, "$ ", buff.String())
+}
@@ -102,7 +102,14 @@ func (g *Generic) ToYAML(path string, showManaged bool) (string, error) {
 }
 
 // Delete deletes a resource.
-func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) error {
+func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(g.getFactory(), g.gvr, path, "delete", deleteParams(propagation, grace), err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := g.Client().CanI(ns, g.gvr, n, []string{client.DeleteVerb})
 	if err != nil {
@@ -0,0 +1,175 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// HelmRelease represents the rendered manifest of a deployed Helm release.
+type HelmRelease struct {
+	Namespace string
+	Name      string
+	Revision  int
+	Manifest  string
+}
+
+// HelmDAO compares Helm releases with their live cluster resources.
+type HelmDAO struct {
+	NonResource
+}
+
+// GetRelease returns the latest release rendered manifest as stored in the release secret.
+func (h *HelmDAO) GetRelease(namespace, name string) (*HelmRelease, error) {
+	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), namespace)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := action.NewGet(cfg).Run(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HelmRelease{
+		Namespace: rel.Namespace,
+		Name:      rel.Name,
+		Revision:  rel.Version,
+		Manifest:  rel.Manifest,
+	}, nil
+}
+
+// LiveObjects fetches the live resources of a release. Resources that no longer
+// exist in the cluster are reported by name.
+func (h *HelmDAO) LiveObjects(rel *HelmRelease) ([]runtime.Object, []string, error) {
+	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), rel.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	ii, err := cfg.KubeClient.Build(bytes.NewBufferString(rel.Manifest), false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		oo      = make([]runtime.Object, 0, len(ii))
+		missing []string
+	)
+	for _, i := range ii {
+		if err := i.Get(); err != nil {
+			if kerrors.IsNotFound(err) {
+				missing = append(missing, i.ObjectName())
+				continue
+			}
+			return nil, nil, err
+		}
+		oo = append(oo, i.Object)
+	}
+
+	return oo, missing, nil
+}
+
+// DiffWithLive returns a unified diff between a release rendered resource and its live
+// counterpart. Only fields rendered by the chart are compared.
+func (*HelmDAO) DiffWithLive(rel *HelmRelease, liveObj runtime.Object) (string, error) {
+	live, err := runtime.DefaultUnstructuredConverter.ToUnstructured(liveObj)
+	if err != nil {
+		return "", err
+	}
+	u := unstructured.Unstructured{Object: live}
+	rendered, err := renderedObject(rel, u.GetKind(), u.GetNamespace(), u.GetName())
+	if err != nil {
+		return "", err
+	}
+
+	from, err := yaml.Marshal(rendered)
+	if err != nil {
+		return "", err
+	}
+	to, err := yaml.Marshal(pruneTo(live, rendered))
+	if err != nil {
+		return "", err
+	}
+
+	fqn := strings.ToLower(u.GetKind()) + "/" + client.FQN(u.GetNamespace(), u.GetName())
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: fmt.Sprintf("helm/%s@%d", fqn, rel.Revision),
+		ToFile:   "live/" + fqn,
+		Context:  3,
+	})
+}
+
+// renderedObject locates a resource in a release manifest.
+func renderedObject(rel *HelmRelease, kind, ns, n string) (map[string]any, error) {
+	mm := releaseutil.SplitManifests(rel.Manifest)
+	kk := make([]string, 0, len(mm))
+	for k := range mm {
+		kk = append(kk, k)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(kk))
+
+	for _, k := range kk {
+		var o map[string]any
+		if err := yaml.Unmarshal([]byte(mm[k]), &o); err != nil || o == nil {
+			continue
+		}
+		u := unstructured.Unstructured{Object: o}
+		rns := u.GetNamespace()
+		if rns == "" {
+			rns = rel.Namespace
+		}
+		if u.GetKind() == kind && u.GetName() == n && (ns == "" || rns == ns) {
+			return o, nil
+		}
+	}
+
+	return nil, fmt.Errorf("resource %s/%s is not part of release %s", strings.ToLower(kind), client.FQN(ns, n), rel.Name)
+}
+
+// pruneTo trims a live value down to the fields present in the rendered value.
+func pruneTo(live, rendered any) any {
+	switch r := rendered.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return live
+		}
+		out := make(map[string]any, len(r))
+		for k, v := range r {
+			if lv, ok := l[k]; ok {
+				out[k] = pruneTo(lv, v)
+			}
+		}
+		return out
+	case []any:
+		l, ok := live.([]any)
+		if !ok {
+			return live
+		}
+		out := make([]any, len(l))
+		for i := range l {
+			if i < len(r) {
+				out[i] = pruneTo(l[i], r[i])
+				continue
+			}
+			out[i] = l[i]
+		}
+		return out
+	default:
+		return live
+	}
+}
@@ -122,7 +122,14 @@ func (h *HelmChart) Delete(_ context.Context, path string, _ *metav1.DeletionPro
 }
 
 // Uninstall uninstalls a HelmChart.
-func (h *HelmChart) Uninstall(path string, keepHist bool) error {
+func (h *HelmChart) Uninstall(path string, keepHist bool) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(h.getFactory(), h.gvr, path, "uninstall", map[string]any{"keepHistory": keepHist}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	flags := h.Client().Config().Flags()
 	cfg, err := ensureHelmConfig(flags, ns)
@@ -135,7 +135,14 @@ func (h *HelmHistory) GetValues(path string, allValues bool) ([]byte, error) {
 	return data.WriteYAML(content)
 }
 
-func (h *HelmHistory) Rollback(_ context.Context, path, rev string) error {
+func (h *HelmHistory) Rollback(_ context.Context, path, rev string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(h.getFactory(), h.gvr, path, "rollback", map[string]any{"revision": rev}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
 	if err != nil {
@@ -153,7 +160,14 @@ func (h *HelmHistory) Rollback(_ context.Context, path, rev string) error {
 }
 
 // Delete uninstall a Helm.
-func (h *HelmHistory) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+func (h *HelmHistory) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(h.getFactory(), h.gvr, path, "uninstall", nil, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	cfg, err := ensureHelmConfig(h.Client().Config().Flags(), ns)
 	if err != nil {
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const testHelmManifest = `---
+# Source: fred/templates/cm.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: fred
+data:
+  a: "1"
+---
+# Source: fred/templates/dp.yaml
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fred
+  labels:
+    app: fred
+spec:
+  replicas: 2
+`
+
+func TestHelmDiffWithLive(t *testing.T) {
+	rel := HelmRelease{Namespace: "ns1", Name: "fred", Revision: 3, Manifest: testHelmManifest}
+	var h HelmDAO
+
+	uu := map[string]struct {
+		o runtime.Object
+		e string
+	}{
+		"no-drift": {
+			o: &v1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "ns1", UID: "xxx", ResourceVersion: "12"},
+				Data:       map[string]string{"a": "1"},
+			},
+		},
+		"drift": {
+			o: func() *appsv1.Deployment {
+				dp := appsv1.Deployment{
+					TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fred",
+						Namespace: "ns1",
+						Labels:    map[string]string{"app": "fred", "extra": "blee"},
+					},
+				}
+				dp.Spec.Replicas = new(int32)
+				*dp.Spec.Replicas = 5
+				return &dp
+			}(),
+			e: `--- helm/deployment/ns1/fred@3
++++ live/deployment/ns1/fred
+@@ -5,5 +5,5 @@
+     app: fred
+   name: fred
+ spec:
+-  replicas: 2
++  replicas: 5
+ 
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			diff, err := h.DiffWithLive(&rel, u.o)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, diff)
+		})
+	}
+}
+
+func TestHelmDiffWithLiveNotInRelease(t *testing.T) {
+	rel := HelmRelease{Namespace: "ns1", Name: "fred", Manifest: testHelmManifest}
+	var h HelmDAO
+
+	_, err := h.DiffWithLive(&rel, &v1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "ns2"},
+	})
+	assert.EqualError(t, err, "resource configmap/ns2/fred is not part of release fred")
+}
+
+func TestPruneTo(t *testing.T) {
+	live := map[string]any{
+		"a": "1",
+		"b": map[string]any{"c": int64(2), "d": true},
+		"e": []any{map[string]any{"f": 1, "g": 2}, "h"},
+	}
+	rendered := map[string]any{
+		"b": map[string]any{"c": 3},
+		"e": []any{map[string]any{"f": 1}},
+		"z": "missing",
+	}
+
+	assert.Equal(t, map[string]any{
+		"b": map[string]any{"c": int64(2)},
+		"e": []any{map[string]any{"f": 1}, "h"},
+	}, pruneTo(live, rendered))
+}
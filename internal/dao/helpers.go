@@ -65,6 +65,27 @@ func FQN(ns, n string) string {
 	return ns + "/" + n
 }
 
+// BuildPodCountMap counts the given pods per node they are scheduled on.
+// Unscheduled pods are skipped.
+func BuildPodCountMap(oo []runtime.Object) (map[string]int, error) {
+	mm := make(map[string]int)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return mm, fmt.Errorf("expecting *Unstructured but got `%T", o)
+		}
+		node, _, err := unstructured.NestedString(u.Object, "spec", "nodeName")
+		if err != nil {
+			return mm, err
+		}
+		if node != "" {
+			mm[node]++
+		}
+	}
+
+	return mm, nil
+}
+
 func inList(ll []string, s string) bool {
 	for _, l := range ll {
 		if l == s {
@@ -137,3 +158,12 @@ func ContinuousRanges(indexes []int) [][]int {
 
 	return ranges
 }
+
+// activePrometheus returns the active context Prometheus client or nil if none.
+func activePrometheus(c client.Connection) *client.PrometheusClient {
+	if !client.HasPrometheus() {
+		return nil
+	}
+
+	return client.Prometheus(c.ActiveContext())
+}
@@ -0,0 +1,185 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/scale"
+)
+
+const (
+	hpaKind          = "HorizontalPodAutoscaler"
+	hpaRescaleReason = "SuccessfulRescale"
+
+	// UnknownReplicas designates a scale event with no known prior replica count.
+	UnknownReplicas = -1
+)
+
+var hpaRescaleRX = regexp.MustCompile(`New size: (\d+); reason: (.*)`)
+
+var (
+	_ Accessor       = (*HorizontalPodAutoscaler)(nil)
+	_ Scalable       = (*HorizontalPodAutoscaler)(nil)
+	_ ReplicasGetter = (*HorizontalPodAutoscaler)(nil)
+)
+
+// ScaleEvent represents an autoscaler scale up or down event.
+type ScaleEvent struct {
+	Timestamp time.Time
+	From, To  int
+	Reason    string
+}
+
+// HorizontalPodAutoscaler represents a horizontal pod autoscaler resource.
+type HorizontalPodAutoscaler struct {
+	Scaler
+}
+
+// Replicas returns the number of replicas of the autoscaler scale target.
+func (h *HorizontalPodAutoscaler) Replicas(ctx context.Context, path string) (int32, error) {
+	dial, err := h.getFactory().Client().Dial()
+	if err != nil {
+		return 0, err
+	}
+	mapper, err := h.restMapper()
+	if err != nil {
+		return 0, err
+	}
+	sc, err := h.scaleClient()
+	if err != nil {
+		return 0, err
+	}
+	ns, n := client.Namespaced(path)
+	gr, target, err := hpaScaleTarget(ctx, dial, mapper, ns, n)
+	if err != nil {
+		return 0, err
+	}
+	currScale, err := sc.Scales(ns).Get(ctx, gr, target, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	return currScale.Spec.Replicas, nil
+}
+
+// Scale scales the autoscaler scale target to the given replicas.
+// The autoscaler may later override the replicas within its bounds.
+func (h *HorizontalPodAutoscaler) Scale(ctx context.Context, path string, replicas int32) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(h.getFactory(), h.gvr, path, "scale", map[string]any{"replicas": replicas}, err)
+	}()
+
+	dial, err := h.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	mapper, err := h.restMapper()
+	if err != nil {
+		return err
+	}
+	sc, err := h.scaleClient()
+	if err != nil {
+		return err
+	}
+	ns, n := client.Namespaced(path)
+
+	return scaleHPATarget(ctx, dial, mapper, sc, ns, n, replicas)
+}
+
+// scaleHPATarget scales the resource referenced by the given autoscaler.
+func scaleHPATarget(ctx context.Context, dial kubernetes.Interface, mapper meta.RESTMapper, sc scale.ScalesGetter, ns, name string, replicas int32) error {
+	gr, target, err := hpaScaleTarget(ctx, dial, mapper, ns, name)
+	if err != nil {
+		return err
+	}
+
+	return scaleTo(ctx, sc, gr, ns, target, replicas)
+}
+
+// hpaScaleTarget returns the group resource and name of the given autoscaler scale target.
+func hpaScaleTarget(ctx context.Context, dial kubernetes.Interface, mapper meta.RESTMapper, ns, name string) (schema.GroupResource, string, error) {
+	hpa, err := dial.AutoscalingV2().HorizontalPodAutoscalers(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return schema.GroupResource{}, "", err
+	}
+	ref := hpa.Spec.ScaleTargetRef
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupResource{}, "", err
+	}
+	m, err := mapper.RESTMapping(schema.GroupKind{Group: gv.Group, Kind: ref.Kind}, gv.Version)
+	if err != nil {
+		return schema.GroupResource{}, "", err
+	}
+
+	return m.Resource.GroupResource(), ref.Name, nil
+}
+
+// GetScaleHistory returns the given autoscaler scale events, oldest first.
+func (h *HorizontalPodAutoscaler) GetScaleHistory(ctx context.Context, namespace, name string) ([]ScaleEvent, error) {
+	dial, err := h.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	sel := fields.SelectorFromSet(fields.Set{
+		"involvedObject.name": name,
+		"involvedObject.kind": hpaKind,
+	})
+	ll, err := dial.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{FieldSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	return scaleEvents(ll.Items), nil
+}
+
+// scaleEvents extracts the rescale events and chains their replica counts.
+func scaleEvents(ee []v1.Event) []ScaleEvent {
+	ss := make([]ScaleEvent, 0, len(ee))
+	for i := range ee {
+		e := &ee[i]
+		if e.Reason != hpaRescaleReason {
+			continue
+		}
+		mm := hpaRescaleRX.FindStringSubmatch(e.Message)
+		if len(mm) < 3 {
+			continue
+		}
+		to, err := strconv.Atoi(mm[1])
+		if err != nil {
+			continue
+		}
+		ss = append(ss, ScaleEvent{
+			Timestamp: eventTime(e),
+			To:        to,
+			Reason:    strings.TrimSpace(mm[2]),
+		})
+	}
+	slices.SortStableFunc(ss, func(a, b ScaleEvent) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	from := UnknownReplicas
+	for i := range ss {
+		ss[i].From, from = from, ss[i].To
+	}
+
+	return ss
+}
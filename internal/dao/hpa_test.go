@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	fakescale "k8s.io/client-go/scale/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestScaleEvents(t *testing.T) {
+	t0 := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	ee := []v1.Event{
+		makeHpaEvent(hpaRescaleReason, "New size: 3; reason: All metrics below target", t0.Add(2*time.Minute)),
+		makeHpaEvent(hpaRescaleReason, "New size: 2; reason: cpu resource utilization (percentage of request) above target", t0),
+		makeHpaEvent("FailedGetResourceMetric", "failed to get cpu utilization", t0.Add(time.Minute)),
+		makeHpaEvent(hpaRescaleReason, "New size: 5; reason: cpu resource utilization (percentage of request) above target", t0.Add(time.Minute)),
+		makeHpaEvent(hpaRescaleReason, "garbled", t0.Add(time.Minute)),
+	}
+
+	assert.Equal(t, []ScaleEvent{
+		{Timestamp: t0, From: UnknownReplicas, To: 2, Reason: "cpu resource utilization (percentage of request) above target"},
+		{Timestamp: t0.Add(time.Minute), From: 2, To: 5, Reason: "cpu resource utilization (percentage of request) above target"},
+		{Timestamp: t0.Add(2 * time.Minute), From: 5, To: 3, Reason: "All metrics below target"},
+	}, scaleEvents(ee))
+}
+
+func TestScaleHPATarget(t *testing.T) {
+	hpa := autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "h1"},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "dp1",
+			},
+		},
+	}
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(appsv1.SchemeGroupVersion.WithKind("Deployment"), meta.RESTScopeNamespace)
+
+	var scaled *autoscalingv1.Scale
+	sc := fakescale.FakeScaleClient{}
+	sc.AddReactor("get", "deployments", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp1"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: 1},
+		}, nil
+	})
+	sc.AddReactor("update", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		u, ok := a.(k8stesting.UpdateAction)
+		require.True(t, ok)
+		assert.Equal(t, "scale", u.GetSubresource())
+		scaled, ok = u.GetObject().(*autoscalingv1.Scale)
+		require.True(t, ok)
+		return true, scaled, nil
+	})
+
+	require.NoError(t, scaleHPATarget(context.Background(), fake.NewClientset(&hpa), mapper, &sc, "ns1", "h1", 5))
+	require.NotNil(t, scaled)
+	assert.Equal(t, "dp1", scaled.Name)
+	assert.Equal(t, int32(5), scaled.Spec.Replicas)
+
+	assert.Error(t, scaleHPATarget(context.Background(), fake.NewClientset(), mapper, &sc, "ns1", "h1", 5))
+}
+
+// Helpers...
+
+func makeHpaEvent(reason, msg string, t time.Time) v1.Event {
+	return v1.Event{
+		Reason:        reason,
+		Message:       msg,
+		LastTimestamp: metav1.Time{Time: t},
+	}
+}
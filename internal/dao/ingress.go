@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/cache"
+)
+
+var _ Accessor = (*Ingress)(nil)
+
+const (
+	// IngressCertCacheTTL tracks how long an ingress TLS certificate lookup is reused.
+	IngressCertCacheTTL = 10 * time.Minute
+
+	ingressCertCacheSize = 500
+)
+
+type ingressCertKey struct {
+	context, fqn string
+}
+
+type ingressCert struct {
+	cert *x509.Certificate
+	err  error
+}
+
+var ingressCerts = cache.NewLRUExpireCache(ingressCertCacheSize)
+
+// Ingress represents an ingress resource.
+type Ingress struct {
+	Resource
+}
+
+// CertExpiry represents the expiry of an ingress TLS certificate.
+type CertExpiry struct {
+	SecretName    string
+	Host          string
+	ExpiresAt     time.Time
+	DaysRemaining int
+}
+
+// List returns a collection of ingresses along with their earliest TLS certificate expiry.
+func (i *Ingress) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := i.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	now := time.Now()
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		iwc := render.IngressWithCerts{Raw: u}
+		var ing netv1.Ingress
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+			return nil, err
+		}
+		cc, err := ingressCertExpiries(&ing, i.tlsCert, now)
+		if err != nil {
+			slog.Debug("Unable to check ingress certificates",
+				slogs.FQN, client.FQN(ing.Namespace, ing.Name),
+				slogs.Error, err,
+			)
+		}
+		if c, ok := earliestExpiry(cc); ok {
+			iwc.CertDays = &c.DaysRemaining
+		}
+		res = append(res, &iwc)
+	}
+
+	return res, nil
+}
+
+// CheckTLSExpiry returns the expiry of the certificates referenced by an ingress TLS secrets.
+// Certificates that could not be checked are reported along with the checked ones.
+func (i *Ingress) CheckTLSExpiry(_ context.Context, namespace, ingressName string) ([]CertExpiry, error) {
+	o, err := i.getFactory().Get(i.gvr, client.FQN(namespace, ingressName), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var ing netv1.Ingress
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+		return nil, err
+	}
+
+	return ingressCertExpiries(&ing, i.tlsCert, time.Now())
+}
+
+// tlsCert returns the leaf certificate of a TLS secret. Secrets are fetched one at
+// a time, so only get access is required, and lookups are cached per cluster context.
+func (i *Ingress) tlsCert(fqn string) (*x509.Certificate, error) {
+	key := ingressCertKey{context: i.Client().ActiveContext(), fqn: fqn}
+	if o, ok := ingressCerts.Get(key); ok {
+		if c, ok := o.(ingressCert); ok {
+			return c.cert, c.err
+		}
+	}
+
+	cert, err := i.fetchTLSCert(fqn)
+	ingressCerts.Add(key, ingressCert{cert: cert, err: err}, IngressCertCacheTTL)
+
+	return cert, err
+}
+
+func (i *Ingress) fetchTLSCert(fqn string) (*x509.Certificate, error) {
+	dial, err := i.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), i.Client().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(fqn)
+	sec, err := dial.CoreV1().Secrets(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return leafCert(sec.Data[v1.TLSCertKey])
+}
+
+// ingressCertExpiries returns the expiry of an ingress TLS certificates, one per TLS host.
+func ingressCertExpiries(ing *netv1.Ingress, tlsCert func(string) (*x509.Certificate, error), now time.Time) ([]CertExpiry, error) {
+	var (
+		cc   []CertExpiry
+		errs []error
+	)
+	for _, t := range ing.Spec.TLS {
+		if t.SecretName == "" {
+			continue
+		}
+		cert, err := tlsCert(client.FQN(ing.Namespace, t.SecretName))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("secret %s: %w", t.SecretName, err))
+			continue
+		}
+		hosts := t.Hosts
+		if len(hosts) == 0 {
+			hosts = []string{strings.Join(cert.DNSNames, ",")}
+		}
+		for _, h := range hosts {
+			cc = append(cc, CertExpiry{
+				SecretName:    t.SecretName,
+				Host:          h,
+				ExpiresAt:     cert.NotAfter,
+				DaysRemaining: daysRemaining(cert.NotAfter, now),
+			})
+		}
+	}
+
+	return cc, errors.Join(errs...)
+}
+
+// leafCert parses the first certificate of a PEM bundle.
+func leafCert(bb []byte) (*x509.Certificate, error) {
+	for len(bb) > 0 {
+		var b *pem.Block
+		if b, bb = pem.Decode(bb); b == nil {
+			break
+		}
+		if b.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(b.Bytes)
+		}
+	}
+
+	return nil, errors.New("no certificate found")
+}
+
+func daysRemaining(t, now time.Time) int {
+	return int(math.Floor(t.Sub(now).Hours() / 24))
+}
+
+func earliestExpiry(cc []CertExpiry) (CertExpiry, bool) {
+	if len(cc) == 0 {
+		return CertExpiry{}, false
+	}
+	c := cc[0]
+	for _, e := range cc[1:] {
+		if e.ExpiresAt.Before(c.ExpiresAt) {
+			c = e
+		}
+	}
+
+	return c, true
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIngressCertExpiries(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	exp1, exp2 := now.Add(10*24*time.Hour+time.Hour), now.Add(40*24*time.Hour)
+	secrets := map[string]*v1.Secret{
+		"ns1/s1": {Data: map[string][]byte{v1.TLSCertKey: makeCertPEM(t, exp1, "a.example.com")}},
+		"ns1/s2": {Data: map[string][]byte{v1.TLSCertKey: makeCertPEM(t, exp2, "c.example.com")}},
+		"ns1/s3": {Data: map[string][]byte{v1.TLSCertKey: []byte("blee")}},
+	}
+	tlsCert := func(fqn string) (*x509.Certificate, error) {
+		if s, ok := secrets[fqn]; ok {
+			return leafCert(s.Data[v1.TLSCertKey])
+		}
+		return nil, errors.New("not found")
+	}
+	ing := netv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "ing1"},
+		Spec: netv1.IngressSpec{
+			TLS: []netv1.IngressTLS{
+				{Hosts: []string{"a.example.com", "b.example.com"}, SecretName: "s1"},
+				{SecretName: "s2"},
+				{SecretName: "s3"},
+				{SecretName: "missing"},
+				{Hosts: []string{"d.example.com"}},
+			},
+		},
+	}
+
+	cc, err := ingressCertExpiries(&ing, tlsCert, now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "secret s3: no certificate found")
+	assert.Contains(t, err.Error(), "secret missing: not found")
+	assert.Equal(t, []CertExpiry{
+		{SecretName: "s1", Host: "a.example.com", ExpiresAt: exp1, DaysRemaining: 10},
+		{SecretName: "s1", Host: "b.example.com", ExpiresAt: exp1, DaysRemaining: 10},
+		{SecretName: "s2", Host: "c.example.com", ExpiresAt: exp2, DaysRemaining: 40},
+	}, cc)
+
+	c, ok := earliestExpiry(cc)
+	assert.True(t, ok)
+	assert.Equal(t, 10, c.DaysRemaining)
+	_, ok = earliestExpiry(nil)
+	assert.False(t, ok)
+}
+
+func TestDaysRemaining(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, daysRemaining(now.Add(time.Hour), now))
+	assert.Equal(t, 2, daysRemaining(now.Add(50*time.Hour), now))
+	assert.Equal(t, -1, daysRemaining(now.Add(-time.Hour), now))
+}
+
+func makeCertPEM(t *testing.T, notAfter time.Time, hosts ...string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hosts[0]},
+		DNSNames:     hosts,
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
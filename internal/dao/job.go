@@ -4,21 +4,33 @@
 package dao
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tview"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 )
 
+// jobLogMaxLineSize tracks the longest job log line aggregated.
+const jobLogMaxLineSize = 1024 * 1024
+
 var (
 	_ Accessor    = (*Job)(nil)
 	_ Nuker       = (*Job)(nil)
@@ -92,6 +104,162 @@ func (j *Job) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error)
 	return podLogs(ctx, job.Spec.Selector.MatchLabels, opts)
 }
 
+// AggregateLogs streams the logs of all the pods owned by a job to w. Each line
+// is prefixed with its pod name in a distinct color. Pod logs are written one pod
+// after the other unless opts.Merge is set, in which case all lines are read first
+// and interleaved by timestamp.
+func (j *Job) AggregateLogs(ctx context.Context, namespace, jobName string, opts LogOptions, w io.Writer) error {
+	dial, err := j.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	return aggregateJobLogs(ctx, dial, namespace, jobName, &opts, w)
+}
+
+// jobLogLine represents a job pod log line.
+type jobLogLine struct {
+	at        time.Time
+	timestamp string
+	prefix    string
+	color     string
+	text      string
+}
+
+func aggregateJobLogs(ctx context.Context, dial kubernetes.Interface, ns, name string, opts *LogOptions, w io.Writer) error {
+	job, err := dial.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	pp, err := jobPods(ctx, dial, job)
+	if err != nil {
+		return err
+	}
+	if len(pp) == 0 {
+		return fmt.Errorf("no pods found for job %s", client.FQN(ns, name))
+	}
+
+	var (
+		ll   []jobLogLine
+		errs error
+	)
+	emit := func(l *jobLogLine) {
+		if opts.Merge {
+			ll = append(ll, *l)
+			return
+		}
+		writeJobLogLine(w, l, opts.ShowTimestamp)
+	}
+	for i := range pp {
+		color := podPalette[i%len(podPalette)]
+		for _, co := range logContainers(&pp[i], opts.Container) {
+			prefix := pp[i].Name
+			if opts.Container == "" && len(pp[i].Spec.Containers) > 1 {
+				prefix += "/" + co
+			}
+			if err := streamJobPodLogs(ctx, dial, &pp[i], co, opts, prefix, color, emit); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s logs failed: %w", prefix, err))
+			}
+		}
+	}
+	if opts.Merge {
+		mergeLogLines(ll)
+		for i := range ll {
+			writeJobLogLine(w, &ll[i], opts.ShowTimestamp)
+		}
+	}
+
+	return errs
+}
+
+// jobPods returns the pods owned by a job sorted by name.
+func jobPods(ctx context.Context, dial kubernetes.Interface, job *batchv1.Job) ([]v1.Pod, error) {
+	var sel string
+	if job.Spec.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		sel = s.String()
+	}
+	ll, err := dial.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{LabelSelector: sel})
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]v1.Pod, 0, len(ll.Items))
+	for i := range ll.Items {
+		if ref := metav1.GetControllerOf(&ll.Items[i]); ref != nil && ref.UID == job.UID {
+			pp = append(pp, ll.Items[i])
+		}
+	}
+	slices.SortFunc(pp, func(a, b v1.Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return pp, nil
+}
+
+// logContainers returns the pod containers to fetch logs from.
+func logContainers(po *v1.Pod, co string) []string {
+	if co != "" {
+		return []string{co}
+	}
+	cc := make([]string, 0, len(po.Spec.Containers))
+	for _, c := range po.Spec.Containers {
+		cc = append(cc, c.Name)
+	}
+
+	return cc
+}
+
+// streamJobPodLogs streams a pod container logs, emitting each line as it is read.
+func streamJobPodLogs(ctx context.Context, dial kubernetes.Interface, po *v1.Pod, co string, opts *LogOptions, prefix, color string, emit func(*jobLogLine)) error {
+	logOpts := opts.ToPodLogOptions()
+	logOpts.Follow, logOpts.Container = false, co
+	if opts.Lines <= 0 {
+		logOpts.TailLines = nil
+	}
+	stream, err := dial.CoreV1().Pods(po.Namespace).GetLogs(po.Name, logOpts).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			slog.Debug("Closing job log stream failed", slogs.Error, err)
+		}
+	}()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(nil, jobLogMaxLineSize)
+	for scanner.Scan() {
+		l := jobLogLine{prefix: prefix, color: color, text: scanner.Text()}
+		if ts, text, ok := strings.Cut(l.text, " "); ok {
+			if at, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+				l.at, l.timestamp, l.text = at, ts, text
+			}
+		}
+		emit(&l)
+	}
+
+	return scanner.Err()
+}
+
+// mergeLogLines interleaves log lines by timestamp, preserving each pod lines order.
+func mergeLogLines(ll []jobLogLine) {
+	slices.SortStableFunc(ll, func(a, b jobLogLine) int {
+		return a.at.Compare(b.at)
+	})
+}
+
+// writeJobLogLine writes a colored log line, escaping the log content.
+func writeJobLogLine(w io.Writer, l *jobLogLine, showTime bool) {
+	if showTime && l.timestamp != "" {
+		_, _ = fmt.Fprintf(w, "[gray::b]%s[-::-] ", l.timestamp)
+	}
+	_, _ = fmt.Fprintf(w, "[%s::]%s[-::] %s\n", l.color, tview.Escape(l.prefix), tview.Escape(l.text))
+}
+
 func (j *Job) GetInstance(fqn string) (*batchv1.Job, error) {
 	o, err := j.getFactory().Get(j.gvr, fqn, true, labels.Everything())
 	if err != nil {
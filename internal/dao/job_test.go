@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAggregateJobLogs(t *testing.T) {
+	job := batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "j1", Namespace: "ns1", UID: "j1"},
+		Spec: batchv1.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "j1"}},
+		},
+	}
+	dial := fake.NewClientset(
+		&job,
+		makeJobPod("p2", "j1", "c1"),
+		makeJobPod("p1", "j1", "c1", "c2"),
+		makeJobPod("p3", "j2", "c1"),
+	)
+
+	var w bytes.Buffer
+	require.NoError(t, aggregateJobLogs(context.Background(), dial, "ns1", "j1", &LogOptions{}, &w))
+	assert.Equal(t, "[teal::]p1/c1[-::] fake logs\n"+
+		"[teal::]p1/c2[-::] fake logs\n"+
+		"[green::]p2[-::] fake logs\n", w.String())
+
+	w.Reset()
+	require.NoError(t, aggregateJobLogs(context.Background(), dial, "ns1", "j1", &LogOptions{Container: "c1"}, &w))
+	assert.Equal(t, "[teal::]p1[-::] fake logs\n[green::]p2[-::] fake logs\n", w.String())
+
+	w.Reset()
+	require.NoError(t, aggregateJobLogs(context.Background(), dial, "ns1", "j1", &LogOptions{Container: "c1", Merge: true}, &w))
+	assert.Equal(t, "[teal::]p1[-::] fake logs\n[green::]p2[-::] fake logs\n", w.String())
+
+	require.Error(t, aggregateJobLogs(context.Background(), dial, "ns1", "fred", &LogOptions{}, &w))
+}
+
+func TestMergeLogLines(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ll := []jobLogLine{
+		{at: at, prefix: "p1", text: "a"},
+		{at: at.Add(2 * time.Second), prefix: "p1", text: "c"},
+		{at: at.Add(time.Second), prefix: "p2", text: "b"},
+		{at: at.Add(2 * time.Second), prefix: "p2", text: "d"},
+	}
+	mergeLogLines(ll)
+
+	tt := make([]string, 0, len(ll))
+	for _, l := range ll {
+		tt = append(tt, l.text)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, tt)
+}
+
+func TestWriteJobLogLine(t *testing.T) {
+	l := jobLogLine{timestamp: "2025-01-01T00:00:00Z", prefix: "p1", color: "teal", text: "fred"}
+
+	var w bytes.Buffer
+	writeJobLogLine(&w, &l, false)
+	assert.Equal(t, "[teal::]p1[-::] fred\n", w.String())
+
+	w.Reset()
+	writeJobLogLine(&w, &l, true)
+	assert.Equal(t, "[gray::b]2025-01-01T00:00:00Z[-::-] [teal::]p1[-::] fred\n", w.String())
+	w.Reset()
+	l.text = "[red]boom[-]"
+	writeJobLogLine(&w, &l, false)
+	assert.Equal(t, "[teal::]p1[-::] [red[]boom[-[]\n", w.String())
+}
+
+// Helpers...
+
+func makeJobPod(name, job string, cc ...string) *v1.Pod {
+	ctrl := true
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "ns1",
+			Labels:          map[string]string{"job-name": job},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Job", Name: job, UID: types.UID(job), Controller: &ctrl}},
+		},
+	}
+	for _, c := range cc {
+		po.Spec.Containers = append(po.Spec.Containers, v1.Container{Name: c})
+	}
+
+	return &po
+}
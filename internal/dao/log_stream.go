@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+)
+
+// maxLogLineSize caps the size of a streamed log line.
+const maxLogLineSize = 1024 * 1024
+
+var (
+	logfmtLevelRX = regexp.MustCompile(`(?:^|\s)(?:level|lvl|severity)="?([A-Za-z]+)"?`)
+	klogLevelRX   = regexp.MustCompile(`^([IWEF])\d{4} `)
+	wordLevelRX   = regexp.MustCompile(`^\[?(TRACE|DEBUG|INFO|WARN|WARNING|ERROR|FATAL|PANIC)\]?[\s:]`)
+
+	jsonLevelKeys = []string{"level", "lvl", "severity"}
+	klogLevels    = map[string]string{"I": "info", "W": "warn", "E": "error", "F": "fatal"}
+	levelAliases  = map[string]string{"warning": "warn", "err": "error", "information": "info", "critical": "fatal"}
+)
+
+// LogStreamOptions represents the options of a container log stream.
+type LogStreamOptions struct {
+	// Container tracks the streamed container name.
+	Container string
+
+	// TailLines tracks the number of trailing lines to fetch. All lines are fetched when not positive.
+	TailLines int64
+
+	// SinceSeconds tracks how far back to fetch logs. All logs are fetched when not positive.
+	SinceSeconds int64
+
+	// Follow keeps the stream open for new log lines.
+	Follow bool
+
+	// Previous streams the logs of the previous container instance.
+	Previous bool
+}
+
+// ToPodLogOptions returns pod log options.
+func (o LogStreamOptions) ToPodLogOptions() *v1.PodLogOptions {
+	opts := v1.PodLogOptions{
+		Container:  o.Container,
+		Follow:     o.Follow,
+		Previous:   o.Previous,
+		Timestamps: true,
+	}
+	if o.TailLines > 0 {
+		opts.TailLines = &o.TailLines
+	}
+	if o.SinceSeconds > 0 {
+		opts.SinceSeconds = &o.SinceSeconds
+	}
+
+	return &opts
+}
+
+// LogLine represents a parsed container log line.
+type LogLine struct {
+	Timestamp time.Time
+	Container string
+	Text      string
+	Level     string
+
+	// Err tracks the failure that ended the stream if any.
+	Err error
+
+	raw string
+}
+
+// ParseLogLine parses a timestamped container log line.
+// The level is extracted from JSON, logfmt, klog or plain level prefixed lines.
+func ParseLogLine(co, raw string) LogLine {
+	l := LogLine{
+		Container: co,
+		Text:      strings.TrimRight(raw, "\r\n"),
+	}
+	if ts, text, ok := strings.Cut(l.Text, " "); ok {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			l.Timestamp, l.Text = t, text
+		}
+	}
+	l.Level = logLevel(l.Text)
+
+	return l
+}
+
+func logLevel(text string) string {
+	if strings.HasPrefix(text, "{") {
+		var m map[string]any
+		if err := json.Unmarshal([]byte(text), &m); err == nil {
+			for _, k := range jsonLevelKeys {
+				if s, ok := m[k].(string); ok {
+					return normalizeLevel(s)
+				}
+			}
+			return ""
+		}
+	}
+	if mm := logfmtLevelRX.FindStringSubmatch(text); len(mm) == 2 {
+		return normalizeLevel(mm[1])
+	}
+	if mm := klogLevelRX.FindStringSubmatch(text); len(mm) == 2 {
+		return klogLevels[mm[1]]
+	}
+	if mm := wordLevelRX.FindStringSubmatch(text); len(mm) == 2 {
+		return normalizeLevel(mm[1])
+	}
+
+	return ""
+}
+
+func normalizeLevel(s string) string {
+	s = strings.ToLower(s)
+	if l, ok := levelAliases[s]; ok {
+		return l
+	}
+
+	return s
+}
+
+// streamLogs opens a container log stream and parses its lines.
+func streamLogs(ctx context.Context, logger Logger, path string, opts *v1.PodLogOptions) (<-chan LogLine, error) {
+	req, err := logger.Logs(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return streamLogLines(ctx, stream, opts.Container), nil
+}
+
+// streamLogLines parses the given stream lines until it ends or the context is canceled.
+// A stream failure is sent as the last line.
+func streamLogLines(ctx context.Context, stream io.ReadCloser, co string) <-chan LogLine {
+	out := make(chan LogLine, 2)
+	go func() {
+		defer func() {
+			if err := stream.Close(); err != nil {
+				slog.Error("Fail to close stream",
+					slogs.Container, co,
+					slogs.Error, err,
+				)
+			}
+			close(out)
+		}()
+
+		sc := bufio.NewScanner(stream)
+		sc.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLogLineSize)
+		for sc.Scan() {
+			l := ParseLogLine(co, sc.Text())
+			l.raw = sc.Text()
+			select {
+			case <-ctx.Done():
+				return
+			case out <- l:
+			}
+		}
+		if err := sc.Err(); err != nil && ctx.Err() == nil {
+			select {
+			case <-ctx.Done():
+			case out <- LogLine{Container: co, Err: err}:
+			}
+		}
+	}()
+
+	return out
+}
@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+)
+
+func TestLogStreamOptionsToPodLogOptions(t *testing.T) {
+	o := LogStreamOptions{Container: "c1", TailLines: 10, Follow: true}
+	opts := o.ToPodLogOptions()
+	assert.Equal(t, "c1", opts.Container)
+	assert.True(t, opts.Follow)
+	assert.True(t, opts.Timestamps)
+	assert.Equal(t, ptr.To(int64(10)), opts.TailLines)
+	assert.Nil(t, opts.SinceSeconds)
+
+	o = LogStreamOptions{SinceSeconds: 60, Previous: true}
+	opts = o.ToPodLogOptions()
+	assert.False(t, opts.Follow)
+	assert.True(t, opts.Previous)
+	assert.Nil(t, opts.TailLines)
+	assert.Equal(t, ptr.To(int64(60)), opts.SinceSeconds)
+}
+
+func TestParseLogLine(t *testing.T) {
+	ts := time.Date(2024, 3, 1, 10, 0, 0, 500, time.UTC)
+	uu := map[string]struct {
+		raw string
+		e   LogLine
+	}{
+		"json": {
+			raw: `2024-03-01T10:00:00.0000005Z {"level":"WARNING","msg":"blee"}`,
+			e:   LogLine{Timestamp: ts, Text: `{"level":"WARNING","msg":"blee"}`, Level: "warn"},
+		},
+		"json-severity": {
+			raw: `2024-03-01T10:00:00.0000005Z {"severity":"error"}`,
+			e:   LogLine{Timestamp: ts, Text: `{"severity":"error"}`, Level: "error"},
+		},
+		"logfmt": {
+			raw: `2024-03-01T10:00:00.0000005Z time=now level=debug msg="blee"` + "\n",
+			e:   LogLine{Timestamp: ts, Text: `time=now level=debug msg="blee"`, Level: "debug"},
+		},
+		"klog": {
+			raw: `2024-03-01T10:00:00.0000005Z E0301 10:00:00.000000 1 main.go:10] boom`,
+			e:   LogLine{Timestamp: ts, Text: `E0301 10:00:00.000000 1 main.go:10] boom`, Level: "error"},
+		},
+		"word": {
+			raw: `2024-03-01T10:00:00.0000005Z [INFO] started`,
+			e:   LogLine{Timestamp: ts, Text: `[INFO] started`, Level: "info"},
+		},
+		"plain": {
+			raw: `2024-03-01T10:00:00.0000005Z hello world`,
+			e:   LogLine{Timestamp: ts, Text: `hello world`},
+		},
+		"no-timestamp": {
+			raw: `fatal: bad things`,
+			e:   LogLine{Text: `fatal: bad things`},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.e.Container = "c1"
+			l := ParseLogLine("c1", u.raw)
+			assert.True(t, u.e.Timestamp.Equal(l.Timestamp))
+			u.e.Timestamp = l.Timestamp
+			assert.Equal(t, u.e, l)
+		})
+	}
+}
+
+func TestStreamLogLines(t *testing.T) {
+	stream := io.NopCloser(strings.NewReader("2024-03-01T10:00:00Z level=info msg=a\n2024-03-01T10:00:01Z level=error msg=b\n"))
+
+	var ll []LogLine
+	for l := range streamLogLines(context.Background(), stream, "c1") {
+		ll = append(ll, l)
+	}
+
+	assert.Len(t, ll, 2)
+	assert.Equal(t, "info", ll[0].Level)
+	assert.Equal(t, "error", ll[1].Level)
+	assert.Equal(t, "level=error msg=b", ll[1].Text)
+	assert.Equal(t, "c1", ll[1].Container)
+}
+
+func TestStreamLogLinesCanceled(t *testing.T) {
+	r, w := io.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	out := streamLogLines(ctx, r, "c1")
+	go func() {
+		_, _ = io.WriteString(w, "line 1\n")
+	}()
+
+	assert.Equal(t, "line 1", (<-out).Text)
+	cancel()
+	_ = w.CloseWithError(context.Canceled)
+	for range out {
+	}
+}
+
+func TestStreamLogLinesFailed(t *testing.T) {
+	r, w := io.Pipe()
+	out := streamLogLines(context.Background(), r, "c1")
+	go func() {
+		_, _ = io.WriteString(w, "line 1\n")
+		_ = w.CloseWithError(errors.New("boom"))
+	}()
+
+	var ll []LogLine
+	for l := range out {
+		ll = append(ll, l)
+	}
+
+	assert.Len(t, ll, 2)
+	assert.NoError(t, ll[0].Err)
+	assert.EqualError(t, ll[1].Err, "boom")
+}
+
+func TestReadLogs(t *testing.T) {
+	uu := map[string]struct {
+		lines []LogLine
+		e     []string
+	}{
+		"eof": {
+			lines: []LogLine{{raw: "a [b]"}},
+			e:     []string{"a [b[]\n", "stream closed EOF for fred/blee (c1)"},
+		},
+		"failed": {
+			lines: []LogLine{{raw: "a"}, {Err: errors.New("boom")}},
+			e:     []string{"a\n", "stream canceled boom for fred/blee (c1)"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			lines := make(chan LogLine, len(u.lines))
+			for _, l := range u.lines {
+				lines <- l
+			}
+			close(lines)
+			out := make(chan *LogItem, len(u.e))
+			var wg sync.WaitGroup
+			wg.Add(1)
+			readLogs(context.Background(), &wg, lines, out, &LogOptions{Path: "fred/blee", Container: "c1"})
+			close(out)
+
+			ii := make([]*LogItem, 0, len(u.e))
+			for i := range out {
+				ii = append(ii, i)
+			}
+			assert.Len(t, ii, len(u.e))
+			assert.Equal(t, u.e[0], string(ii[0].Bytes))
+			assert.True(t, ii[1].IsError)
+			assert.Contains(t, string(ii[1].Bytes), u.e[1])
+		})
+	}
+}
@@ -0,0 +1,291 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os/exec"
+	"runtime"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor = (*Maintenance)(nil)
+	_ Nuker    = (*Maintenance)(nil)
+)
+
+const (
+	// MaintenancePending tracks a maintenance window waiting on its timer.
+	MaintenancePending = "Pending"
+
+	// MaintenanceCordoning tracks a maintenance window cordoning its node.
+	MaintenanceCordoning = "Cordoning"
+
+	// MaintenanceDraining tracks a maintenance window draining its node.
+	MaintenanceDraining = "Draining"
+
+	notifyTitle = "K9s Node Maintenance"
+)
+
+// MaintenanceNotifier emits a notification for each maintenance stage.
+var MaintenanceNotifier = desktopNotify
+
+type maintenanceWindow struct {
+	render.MaintenanceRes
+
+	// context and uid track the cluster context and node the window was scheduled against.
+	context string
+	uid     types.UID
+	timer   *time.Timer
+	audit   auditFn
+}
+
+var maintenanceWindows = struct {
+	sync.Mutex
+	windows map[string]*maintenanceWindow
+}{
+	windows: make(map[string]*maintenanceWindow),
+}
+
+// Maintenance represents scheduled node maintenance windows.
+type Maintenance struct {
+	NonResource
+}
+
+// Delete cancels a pending maintenance window.
+func (*Maintenance) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
+	return CancelMaintenanceWindow(path)
+}
+
+// List returns the scheduled maintenance windows.
+func (*Maintenance) List(context.Context, string) ([]kruntime.Object, error) {
+	ww := MaintenanceWindows()
+	oo := make([]kruntime.Object, 0, len(ww))
+	for _, w := range ww {
+		oo = append(oo, w)
+	}
+
+	return oo, nil
+}
+
+// ScheduleMaintenanceWindow cordons and drains the given node once at is reached.
+// The window is aborted if the cluster context or the node changed by then.
+// The returned function cancels the window as long as it has not started yet.
+func (n *Node) ScheduleMaintenanceWindow(nodeName string, at time.Time, opts DrainOptions) (cancelFunc func(), err error) {
+	if nodeName == "" {
+		return nil, errors.New("no node specified for maintenance window")
+	}
+	if !at.After(time.Now()) {
+		return nil, fmt.Errorf("maintenance window for node %q must be scheduled in the future", nodeName)
+	}
+	uid, err := n.nodeUID(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	ctx := n.Client().ActiveContext()
+
+	return scheduleMaintenanceWindow(nodeName, ctx, uid, at, func() {
+		n.runMaintenance(nodeName, ctx, uid, opts)
+	}, auditorFor(n.getFactory()))
+}
+
+func (n *Node) nodeUID(nodeName string) (types.UID, error) {
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.Client().Config().CallTimeout())
+	defer cancel()
+	no, err := dial.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	return no.UID, nil
+}
+
+func scheduleMaintenanceWindow(nodeName, ctx string, uid types.UID, at time.Time, run func(), audit auditFn) (cancelFunc func(), err error) {
+	maintenanceWindows.Lock()
+	defer maintenanceWindows.Unlock()
+	defer func() {
+		audit(client.NodeGVR, nodeName, "schedule-maintenance", map[string]any{"context": ctx, "at": at.Format(time.RFC3339)}, err)
+	}()
+	if _, ok := maintenanceWindows.windows[nodeName]; ok {
+		return nil, fmt.Errorf("a maintenance window is already scheduled for node %q", nodeName)
+	}
+	w := maintenanceWindow{
+		MaintenanceRes: render.MaintenanceRes{
+			Node:  nodeName,
+			At:    at,
+			Stage: MaintenancePending,
+		},
+		context: ctx,
+		uid:     uid,
+		audit:   audit,
+	}
+	w.timer = time.AfterFunc(time.Until(at), run)
+	maintenanceWindows.windows[nodeName] = &w
+
+	return func() {
+		if err := CancelMaintenanceWindow(nodeName); err != nil {
+			slog.Warn("Maintenance window cancel failed",
+				slogs.ResName, nodeName,
+				slogs.Error, err,
+			)
+		}
+	}, nil
+}
+
+// MaintenanceWindows returns the scheduled maintenance windows, soonest first.
+func MaintenanceWindows() []render.MaintenanceRes {
+	maintenanceWindows.Lock()
+	defer maintenanceWindows.Unlock()
+
+	ww := make([]render.MaintenanceRes, 0, len(maintenanceWindows.windows))
+	for _, w := range maintenanceWindows.windows {
+		ww = append(ww, w.MaintenanceRes)
+	}
+	slices.SortFunc(ww, func(a, b render.MaintenanceRes) int {
+		return a.At.Compare(b.At)
+	})
+
+	return ww
+}
+
+// CancelMaintenanceWindow cancels the pending maintenance window of the given node.
+func CancelMaintenanceWindow(nodeName string) (err error) {
+	maintenanceWindows.Lock()
+	defer maintenanceWindows.Unlock()
+
+	w, ok := maintenanceWindows.windows[nodeName]
+	if !ok {
+		return fmt.Errorf("no maintenance window scheduled for node %q", nodeName)
+	}
+	defer func() {
+		w.audit(client.NodeGVR, nodeName, "cancel-maintenance", map[string]any{"context": w.context}, err)
+	}()
+	if w.Stage != MaintenancePending || !w.timer.Stop() {
+		return fmt.Errorf("maintenance window for node %q is already in progress", nodeName)
+	}
+	delete(maintenanceWindows.windows, nodeName)
+
+	return nil
+}
+
+// CancelMaintenanceWindows cancels all pending maintenance windows, ie on context switch.
+// It returns the number of canceled windows.
+func CancelMaintenanceWindows() int {
+	maintenanceWindows.Lock()
+	defer maintenanceWindows.Unlock()
+
+	var count int
+	for name, w := range maintenanceWindows.windows {
+		if w.Stage != MaintenancePending || !w.timer.Stop() {
+			continue
+		}
+		delete(maintenanceWindows.windows, name)
+		count++
+		w.audit(client.NodeGVR, name, "cancel-maintenance", map[string]any{"context": w.context}, nil)
+	}
+
+	return count
+}
+
+func (n *Node) runMaintenance(nodeName, ctx string, uid types.UID, opts DrainOptions) {
+	err := n.checkMaintenanceTarget(nodeName, ctx, uid)
+	if err == nil {
+		err = n.maintain(nodeName, opts)
+	}
+
+	maintenanceWindows.Lock()
+	delete(maintenanceWindows.windows, nodeName)
+	maintenanceWindows.Unlock()
+
+	auditAction(n.getFactory(), client.NodeGVR, nodeName, "maintenance", map[string]any{"context": ctx}, err)
+	if err != nil {
+		notifyMaintenance(fmt.Sprintf("Node %s maintenance failed: %s", nodeName, err))
+		return
+	}
+	notifyMaintenance(fmt.Sprintf("Node %s maintenance completed", nodeName))
+}
+
+// checkMaintenanceTarget ensures the scheduled node is still the one in the active context.
+func (n *Node) checkMaintenanceTarget(nodeName, ctx string, uid types.UID) error {
+	if curr := n.Client().ActiveContext(); curr != ctx {
+		return fmt.Errorf("maintenance aborted: context switched from %q to %q", ctx, curr)
+	}
+	curr, err := n.nodeUID(nodeName)
+	if err != nil {
+		return err
+	}
+	if curr != uid {
+		return fmt.Errorf("maintenance aborted: node %q was replaced", nodeName)
+	}
+
+	return nil
+}
+
+func (n *Node) maintain(nodeName string, opts DrainOptions) error {
+	setMaintenanceStage(nodeName, MaintenanceCordoning)
+	notifyMaintenance(fmt.Sprintf("Cordoning node %s", nodeName))
+	cordoned, err := n.ensureCordoned(nodeName, opts.CacheTTL)
+	if err != nil {
+		return err
+	}
+	if !cordoned {
+		if err := n.toggleCordon(nodeName, true, opts.CacheTTL); err != nil {
+			return err
+		}
+	}
+
+	setMaintenanceStage(nodeName, MaintenanceDraining)
+	notifyMaintenance(fmt.Sprintf("Draining node %s", nodeName))
+
+	return n.Drain(nodeName, opts, io.Discard)
+}
+
+func setMaintenanceStage(nodeName, stage string) {
+	maintenanceWindows.Lock()
+	defer maintenanceWindows.Unlock()
+
+	if w, ok := maintenanceWindows.windows[nodeName]; ok {
+		w.Stage = stage
+	}
+}
+
+func notifyMaintenance(msg string) {
+	if err := MaintenanceNotifier(notifyTitle, msg); err != nil {
+		slog.Debug("Maintenance notification failed", slogs.Error, err)
+	}
+}
+
+// desktopNotify pops a desktop notification using the platform notifier.
+func desktopNotify(title, msg string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", strconv.Quote(msg), strconv.Quote(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "linux", "freebsd", "openbsd", "netbsd":
+		cmd = exec.Command("notify-send", title, msg)
+	default:
+		return fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+	}
+
+	return cmd.Run()
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestScheduleMaintenanceWindow(t *testing.T) {
+	var n Node
+	now := time.Now()
+
+	_, err := n.ScheduleMaintenanceWindow("", now.Add(time.Hour), DrainOptions{})
+	require.Error(t, err)
+	_, err = n.ScheduleMaintenanceWindow("n1", now.Add(-time.Minute), DrainOptions{})
+	require.Error(t, err)
+
+	schedule := func(node string, at time.Time) (func(), error) {
+		return scheduleMaintenanceWindow(node, "ct1", "uid-"+types.UID(node), at, func() {}, noAudit)
+	}
+
+	cancel1, err := schedule("n1", now.Add(2*time.Hour))
+	require.NoError(t, err)
+	cancel2, err := schedule("n2", now.Add(time.Hour))
+	require.NoError(t, err)
+	_, err = schedule("n1", now.Add(3*time.Hour))
+	require.Error(t, err)
+
+	ww := MaintenanceWindows()
+	require.Len(t, ww, 2)
+	assert.Equal(t, "n2", ww[0].Node)
+	assert.Equal(t, MaintenancePending, ww[0].Stage)
+	assert.Equal(t, "n1", ww[1].Node)
+
+	cancel1()
+	require.Error(t, CancelMaintenanceWindow("n1"))
+	require.NoError(t, CancelMaintenanceWindow("n2"))
+	cancel2()
+	assert.Empty(t, MaintenanceWindows())
+}
+
+func TestCancelMaintenanceWindowInProgress(t *testing.T) {
+	_, err := scheduleMaintenanceWindow("n1", "ct1", "uid1", time.Now().Add(time.Hour), func() {}, noAudit)
+	require.NoError(t, err)
+	setMaintenanceStage("n1", MaintenanceDraining)
+
+	require.Error(t, CancelMaintenanceWindow("n1"))
+
+	maintenanceWindows.Lock()
+	maintenanceWindows.windows["n1"].timer.Stop()
+	delete(maintenanceWindows.windows, "n1")
+	maintenanceWindows.Unlock()
+}
+
+func TestCancelMaintenanceWindows(t *testing.T) {
+	now := time.Now()
+	_, err := scheduleMaintenanceWindow("n1", "ct1", "uid1", now.Add(time.Hour), func() {}, noAudit)
+	require.NoError(t, err)
+	_, err = scheduleMaintenanceWindow("n2", "ct1", "uid2", now.Add(2*time.Hour), func() {}, noAudit)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, CancelMaintenanceWindows())
+	assert.Empty(t, MaintenanceWindows())
+}
+
+func TestMaintenanceWindowAudit(t *testing.T) {
+	var aa []string
+	audit := func(_ *client.GVR, path, action string, params map[string]any, err error) {
+		aa = append(aa, fmt.Sprintf("%s %s %v %t", path, action, params["context"], err == nil))
+	}
+	now := time.Now()
+	_, err := scheduleMaintenanceWindow("n1", "ct1", "uid1", now.Add(time.Hour), func() {}, audit)
+	require.NoError(t, err)
+	_, err = scheduleMaintenanceWindow("n1", "ct1", "uid1", now.Add(time.Hour), func() {}, audit)
+	require.Error(t, err)
+	require.NoError(t, CancelMaintenanceWindow("n1"))
+
+	assert.Equal(t, []string{
+		"n1 schedule-maintenance ct1 true",
+		"n1 schedule-maintenance ct1 false",
+		"n1 cancel-maintenance ct1 true",
+	}, aa)
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"sync"
+)
+
+// DefaultMetricsHistoryDepth tracks the default number of samples retained per resource.
+const DefaultMetricsHistoryDepth = 60
+
+// NodeMetricsHistory tracks nodes metrics across list cycles.
+var NodeMetricsHistory = NewMetricsHistory(DefaultMetricsHistoryDepth)
+
+// MetricsSample tracks cpu/mem utilization percentages.
+type MetricsSample struct {
+	CPU, MEM int
+}
+
+// MetricsHistory tracks a fixed size metrics ring buffer per cluster context and resource.
+type MetricsHistory struct {
+	depth   int
+	samples map[historyKey]*samplesRing
+	mx      sync.RWMutex
+}
+
+type historyKey struct {
+	context, name string
+}
+
+type samplesRing struct {
+	samples []MetricsSample
+	next    int
+	full    bool
+}
+
+// NewMetricsHistory returns a new instance.
+func NewMetricsHistory(depth int) *MetricsHistory {
+	if depth <= 0 {
+		depth = DefaultMetricsHistoryDepth
+	}
+
+	return &MetricsHistory{
+		depth:   depth,
+		samples: make(map[historyKey]*samplesRing),
+	}
+}
+
+// SetDepth resets the history depth. Existing samples are dropped if the depth changes.
+func (h *MetricsHistory) SetDepth(depth int) {
+	if depth <= 0 {
+		depth = DefaultMetricsHistoryDepth
+	}
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if h.depth == depth {
+		return
+	}
+	h.depth, h.samples = depth, make(map[historyKey]*samplesRing)
+}
+
+// Add records a new sample for the given context resource.
+func (h *MetricsHistory) Add(context, name string, s MetricsSample) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	key := historyKey{context: context, name: name}
+	r, ok := h.samples[key]
+	if !ok {
+		r = &samplesRing{samples: make([]MetricsSample, h.depth)}
+		h.samples[key] = r
+	}
+	r.samples[r.next] = s
+	r.next = (r.next + 1) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// Samples returns the given context resource samples, oldest first.
+func (h *MetricsHistory) Samples(context, name string) []MetricsSample {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	r, ok := h.samples[historyKey{context: context, name: name}]
+	if !ok {
+		return nil
+	}
+	if !r.full {
+		return append([]MetricsSample(nil), r.samples[:r.next]...)
+	}
+
+	ss := make([]MetricsSample, 0, len(r.samples))
+	ss = append(ss, r.samples[r.next:]...)
+
+	return append(ss, r.samples[:r.next]...)
+}
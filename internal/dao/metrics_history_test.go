@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsHistory(t *testing.T) {
+	uu := map[string]struct {
+		depth, count int
+		e            []dao.MetricsSample
+	}{
+		"empty": {
+			depth: 3,
+		},
+		"partial": {
+			depth: 3,
+			count: 2,
+			e:     []dao.MetricsSample{{CPU: 0, MEM: 0}, {CPU: 1, MEM: 2}},
+		},
+		"wrapped": {
+			depth: 3,
+			count: 5,
+			e:     []dao.MetricsSample{{CPU: 2, MEM: 4}, {CPU: 3, MEM: 6}, {CPU: 4, MEM: 8}},
+		},
+		"default-depth": {
+			count: 1,
+			e:     []dao.MetricsSample{{CPU: 0, MEM: 0}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := dao.NewMetricsHistory(u.depth)
+			for i := range u.count {
+				h.Add("c1", "n1", dao.MetricsSample{CPU: i, MEM: 2 * i})
+			}
+			assert.Equal(t, u.e, h.Samples("c1", "n1"))
+			assert.Nil(t, h.Samples("c1", "n2"))
+			assert.Nil(t, h.Samples("c2", "n1"))
+		})
+	}
+}
+
+func TestMetricsHistorySetDepth(t *testing.T) {
+	h := dao.NewMetricsHistory(2)
+	h.Add("c1", "n1", dao.MetricsSample{CPU: 1})
+
+	h.SetDepth(2)
+	assert.Len(t, h.Samples("c1", "n1"), 1)
+
+	h.SetDepth(5)
+	assert.Empty(t, h.Samples("c1", "n1"))
+}
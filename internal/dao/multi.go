@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MultiClient fans out identical resource calls to two clusters.
+type MultiClient struct {
+	left, right Factory
+}
+
+// NewMultiClient returns a new client for the given cluster factories.
+func NewMultiClient(left, right Factory) *MultiClient {
+	return &MultiClient{left: left, right: right}
+}
+
+// List concurrently lists a resource on both clusters using the given accessor kind.
+func (m *MultiClient) List(ctx context.Context, a Accessor, gvr *client.GVR, ns string) (left, right []runtime.Object, err error) {
+	var (
+		wg         sync.WaitGroup
+		lerr, rerr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		left, lerr = listWith(ctx, newAccessor(a), m.left, gvr, ns)
+	}()
+	go func() {
+		defer wg.Done()
+		right, rerr = listWith(ctx, newAccessor(a), m.right, gvr, ns)
+	}()
+	wg.Wait()
+
+	if lerr != nil {
+		return nil, nil, fmt.Errorf("left cluster: %w", lerr)
+	}
+	if rerr != nil {
+		return nil, nil, fmt.Errorf("right cluster: %w", rerr)
+	}
+
+	return left, right, nil
+}
+
+func listWith(ctx context.Context, a Accessor, f Factory, gvr *client.GVR, ns string) ([]runtime.Object, error) {
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+	a.Init(f, gvr)
+	if client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+
+	return a.List(ctx, ns)
+}
+
+// newAccessor returns a fresh accessor of the same kind so concurrent calls
+// against different factories do not share state.
+func newAccessor(a Accessor) Accessor {
+	if a == nil {
+		return new(Resource)
+	}
+	t := reflect.TypeOf(a)
+	if t.Kind() != reflect.Pointer {
+		return a
+	}
+	if n, ok := reflect.New(t.Elem()).Interface().(Accessor); ok {
+		return n
+	}
+
+	return new(Resource)
+}
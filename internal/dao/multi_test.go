@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestMultiClientList(t *testing.T) {
+	left := &testFactory{
+		inventory: map[string]map[*client.GVR][]runtime.Object{
+			"kube-system": {
+				client.SecGVR: {load("secret")},
+			},
+		},
+	}
+	right := &testFactory{
+		inventory: map[string]map[*client.GVR][]runtime.Object{
+			"kube-system": {
+				client.SecGVR: {load("secret"), load("secret")},
+			},
+		},
+	}
+	shared := new(dao.Resource)
+
+	m := dao.NewMultiClient(left, right)
+	lo, ro, err := m.List(context.Background(), shared, client.SecGVR, "kube-system")
+	require.NoError(t, err)
+	assert.Len(t, lo, 1)
+	assert.Len(t, ro, 2)
+	assert.Nil(t, shared.Factory)
+}
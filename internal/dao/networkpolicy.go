@@ -0,0 +1,217 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*NetworkPolicy)(nil)
+
+// PolicyMatrix tracks pod to pod connectivity within a namespace.
+type PolicyMatrix struct {
+	Namespace string
+	Pods      []string
+
+	// Allowed[from][to] reports whether traffic from a pod to another pod is allowed.
+	Allowed [][]bool
+}
+
+// Allows checks if traffic from one pod to another is allowed.
+func (m *PolicyMatrix) Allows(from, to int) bool {
+	if from < 0 || from >= len(m.Allowed) || to < 0 || to >= len(m.Allowed[from]) {
+		return false
+	}
+
+	return m.Allowed[from][to]
+}
+
+// NetworkPolicy represents a network policy resource.
+type NetworkPolicy struct {
+	Resource
+}
+
+// ConnectivityMatrix evaluates all network policies in a namespace and
+// computes which pods may talk to each other. Ports are not considered.
+func (n *NetworkPolicy) ConnectivityMatrix(ctx context.Context, namespace string) (*PolicyMatrix, error) {
+	if client.IsAllNamespaces(namespace) {
+		return nil, fmt.Errorf("a namespace is required to compute a connectivity matrix")
+	}
+
+	f := n.getFactory()
+	pp, err := f.List(client.PodGVR, namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(pp))
+	for _, o := range pp {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+	slices.SortFunc(pods, func(a, b v1.Pod) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	nps, err := dial.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ns, err := dial.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	m := PolicyMatrix{
+		Namespace: namespace,
+		Pods:      make([]string, 0, len(pods)),
+		Allowed:   connectivity(pods, nps.Items, ns.Labels),
+	}
+	for i := range pods {
+		m.Pods = append(m.Pods, pods[i].Name)
+	}
+
+	return &m, nil
+}
+
+func fromUnstructured(o runtime.Object, v any) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, v)
+}
+
+// connectivity returns the pod to pod allowed traffic matrix for pods
+// living in the same namespace. Pods can always reach themselves.
+func connectivity(pods []v1.Pod, nps []netv1.NetworkPolicy, nsLabels map[string]string) [][]bool {
+	mm := make([][]bool, len(pods))
+	for i := range pods {
+		mm[i] = make([]bool, len(pods))
+		for j := range pods {
+			mm[i][j] = i == j ||
+				policiesAllow(&pods[i], &pods[j], nps, netv1.PolicyTypeEgress, nsLabels) &&
+					policiesAllow(&pods[i], &pods[j], nps, netv1.PolicyTypeIngress, nsLabels)
+		}
+	}
+
+	return mm
+}
+
+// policiesAllow checks if traffic between two pods is allowed in a given direction.
+// A pod not selected by any policy of that type is not isolated and allows all traffic.
+func policiesAllow(from, to *v1.Pod, nps []netv1.NetworkPolicy, pt netv1.PolicyType, nsLabels map[string]string) bool {
+	subject, peer := to, from
+	if pt == netv1.PolicyTypeEgress {
+		subject, peer = from, to
+	}
+
+	var isolated bool
+	for i := range nps {
+		np := &nps[i]
+		if !hasPolicyType(np, pt) || !selectorMatches(&np.Spec.PodSelector, subject.Labels) {
+			continue
+		}
+		isolated = true
+		if pt == netv1.PolicyTypeIngress {
+			for _, r := range np.Spec.Ingress {
+				if peersMatch(r.From, peer, nsLabels) {
+					return true
+				}
+			}
+			continue
+		}
+		for _, r := range np.Spec.Egress {
+			if peersMatch(r.To, peer, nsLabels) {
+				return true
+			}
+		}
+	}
+
+	return !isolated
+}
+
+// hasPolicyType checks if a policy applies to the given traffic direction.
+// Policies without types always apply to ingress and to egress when egress rules are set.
+func hasPolicyType(np *netv1.NetworkPolicy, pt netv1.PolicyType) bool {
+	if len(np.Spec.PolicyTypes) > 0 {
+		return slices.Contains(np.Spec.PolicyTypes, pt)
+	}
+
+	return pt == netv1.PolicyTypeIngress || len(np.Spec.Egress) > 0
+}
+
+// peersMatch checks if a pod matches any of the given peers. No peers matches all pods.
+func peersMatch(pp []netv1.NetworkPolicyPeer, po *v1.Pod, nsLabels map[string]string) bool {
+	if len(pp) == 0 {
+		return true
+	}
+	for i := range pp {
+		if peerMatches(&pp[i], po, nsLabels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func peerMatches(p *netv1.NetworkPolicyPeer, po *v1.Pod, nsLabels map[string]string) bool {
+	if p.IPBlock != nil {
+		return ipBlockMatches(p.IPBlock, po.Status.PodIP)
+	}
+	if p.NamespaceSelector != nil && !selectorMatches(p.NamespaceSelector, nsLabels) {
+		return false
+	}
+	if p.PodSelector == nil {
+		return true
+	}
+
+	return selectorMatches(p.PodSelector, po.Labels)
+}
+
+func ipBlockMatches(b *netv1.IPBlock, ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	cidr, err := netip.ParsePrefix(b.CIDR)
+	if err != nil || !cidr.Contains(addr) {
+		return false
+	}
+	for _, e := range b.Except {
+		if x, err := netip.ParsePrefix(e); err == nil && x.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func selectorMatches(s *metav1.LabelSelector, ll map[string]string) bool {
+	sel, err := metav1.LabelSelectorAsSelector(s)
+	if err != nil {
+		return false
+	}
+
+	return sel.Matches(labels.Set(ll))
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConnectivity(t *testing.T) {
+	pods := []v1.Pod{
+		makeNpPod("api", "10.0.0.1", map[string]string{"app": "api"}),
+		makeNpPod("db", "10.0.0.2", map[string]string{"app": "db"}),
+		makeNpPod("web", "10.0.1.1", map[string]string{"app": "web"}),
+	}
+	nsLabels := map[string]string{"env": "prod"}
+
+	uu := map[string]struct {
+		nps []netv1.NetworkPolicy
+		e   [][]bool
+	}{
+		"no-policies": {
+			e: [][]bool{
+				{true, true, true},
+				{true, true, true},
+				{true, true, true},
+			},
+		},
+		"deny-all-ingress": {
+			nps: []netv1.NetworkPolicy{
+				makeNp("deny", metav1.LabelSelector{}, nil, nil, netv1.PolicyTypeIngress),
+			},
+			e: [][]bool{
+				{true, false, false},
+				{false, true, false},
+				{false, false, true},
+			},
+		},
+		"db-from-api": {
+			nps: []netv1.NetworkPolicy{
+				makeNp("db", metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					[]netv1.NetworkPolicyIngressRule{
+						{From: []netv1.NetworkPolicyPeer{
+							{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}}},
+						}},
+					},
+					nil,
+				),
+			},
+			e: [][]bool{
+				{true, true, true},
+				{true, true, true},
+				{true, false, true},
+			},
+		},
+		"web-egress-ipblock": {
+			nps: []netv1.NetworkPolicy{
+				makeNp("web", metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+					nil,
+					[]netv1.NetworkPolicyEgressRule{
+						{To: []netv1.NetworkPolicyPeer{
+							{IPBlock: &netv1.IPBlock{CIDR: "10.0.0.0/24", Except: []string{"10.0.0.2/32"}}},
+						}},
+					},
+					netv1.PolicyTypeEgress,
+				),
+			},
+			e: [][]bool{
+				{true, true, true},
+				{true, true, true},
+				{true, false, true},
+			},
+		},
+		"namespace-selector": {
+			nps: []netv1.NetworkPolicy{
+				makeNp("api", metav1.LabelSelector{MatchLabels: map[string]string{"app": "api"}},
+					[]netv1.NetworkPolicyIngressRule{
+						{From: []netv1.NetworkPolicyPeer{
+							{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}},
+						}},
+					},
+					nil,
+				),
+			},
+			e: [][]bool{
+				{true, true, true},
+				{false, true, true},
+				{false, true, true},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, connectivity(pods, u.nps, nsLabels))
+		})
+	}
+}
+
+func TestPolicyMatrixAllows(t *testing.T) {
+	m := PolicyMatrix{
+		Pods:    []string{"a", "b"},
+		Allowed: [][]bool{{true, false}, {true, true}},
+	}
+
+	assert.True(t, m.Allows(1, 0))
+	assert.False(t, m.Allows(0, 1))
+	assert.False(t, m.Allows(2, 0))
+	assert.False(t, m.Allows(0, -1))
+}
+
+// Helpers...
+
+func makeNpPod(n, ip string, ll map[string]string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: n, Labels: ll},
+		Status:     v1.PodStatus{PodIP: ip},
+	}
+}
+
+func makeNp(n string, sel metav1.LabelSelector, in []netv1.NetworkPolicyIngressRule, out []netv1.NetworkPolicyEgressRule, tt ...netv1.PolicyType) netv1.NetworkPolicy {
+	return netv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "fred", Name: n},
+		Spec: netv1.NetworkPolicySpec{
+			PodSelector: sel,
+			Ingress:     in,
+			Egress:      out,
+			PolicyTypes: tt,
+		},
+	}
+}
@@ -4,47 +4,263 @@
 package dao
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
+	"github.com/pmezard/go-difflib/difflib"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/kubectl/pkg/drain"
 	"k8s.io/kubectl/pkg/scheme"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"sigs.k8s.io/yaml"
 )
 
 var (
 	_ Accessor       = (*Node)(nil)
+	_ Describer      = (*Node)(nil)
 	_ NodeMaintainer = (*Node)(nil)
 )
 
+const (
+	// DrainEvicting tracks a pod eviction in flight.
+	DrainEvicting = "Evicting"
+
+	// DrainDeleting tracks a pod deletion in flight.
+	DrainDeleting = "Deleting"
+
+	// DrainEvicted tracks an evicted pod.
+	DrainEvicted = "Evicted"
+
+	// DrainDeleted tracks a deleted pod.
+	DrainDeleted = "Deleted"
+
+	// DrainFailed tracks a failed pod eviction.
+	DrainFailed = "Failed"
+
+	// DrainEvictionUnavailable tracks a drain falling back to pod deletions.
+	DrainEvictionUnavailable = "EvictionUnavailable"
+
+	// DrainModeEviction tracks a drain evicting pods.
+	DrainModeEviction = "eviction"
+
+	// DrainModeDeletion tracks a drain deleting pods.
+	DrainModeDeletion = "deletion"
+
+	// CordonReasonAnnotation tracks why a node was cordoned.
+	CordonReasonAnnotation = "k9s.io/cordon-reason"
+
+	// CordonTimeAnnotation tracks when a node was cordoned.
+	CordonTimeAnnotation = "k9s.io/cordon-time"
+
+	// IsolateCordonAnnotation tracks a node cordoned by its isolation.
+	IsolateCordonAnnotation = "k9s.io/isolate-cordon"
+
+	// NoZone groups nodes without a topology zone label.
+	NoZone = "<none>"
+
+	// nodeEditRetries caps the number of node edit retries on conflicts.
+	nodeEditRetries = 3
+)
+
+const (
+	// DefaultNodeCacheTTL tracks how long a fetched node is reused.
+	DefaultNodeCacheTTL = 5 * time.Second
+
+	nodeCacheSize = 100
+
+	// largeClusterNodes tracks the node count above which node pods are listed server side.
+	largeClusterNodes = 50
+
+	// clusterSizeTTL tracks how long a cluster size hint is reused.
+	clusterSizeTTL = time.Minute
+
+	// ZoneDrainSettleTimeout tracks how long a zone drain waits for the disruption
+	// budgets to recover between nodes.
+	ZoneDrainSettleTimeout = 5 * time.Minute
+
+	// zoneDrainSettlePoll tracks how often a zone drain checks the disruption budgets.
+	zoneDrainSettlePoll = 5 * time.Second
+)
+
+type nodeCacheKey struct {
+	context, fqn string
+}
+
+var (
+	nodeCache = cache.NewLRUExpireCache(nodeCacheSize)
+
+	// nodesMetricsDown tracks whether the last nodes metrics fetch failed.
+	nodesMetricsDown atomic.Bool
+
+	// clusterSizeCache tracks the large cluster hint per context.
+	clusterSizeCache = cache.NewLRUExpireCache(nodeCacheSize)
+)
+
+// nodeConditionReasons maps the kubelet node status event reasons to their condition status.
+var nodeConditionReasons = map[string]struct {
+	kind   v1.NodeConditionType
+	status v1.ConditionStatus
+}{
+	"NodeReady":                 {kind: v1.NodeReady, status: v1.ConditionTrue},
+	"NodeNotReady":              {kind: v1.NodeReady, status: v1.ConditionFalse},
+	"NodeHasInsufficientMemory": {kind: v1.NodeMemoryPressure, status: v1.ConditionTrue},
+	"NodeHasSufficientMemory":   {kind: v1.NodeMemoryPressure, status: v1.ConditionFalse},
+	"NodeHasDiskPressure":       {kind: v1.NodeDiskPressure, status: v1.ConditionTrue},
+	"NodeHasNoDiskPressure":     {kind: v1.NodeDiskPressure, status: v1.ConditionFalse},
+	"NodeHasInsufficientPID":    {kind: v1.NodePIDPressure, status: v1.ConditionTrue},
+	"NodeHasSufficientPID":      {kind: v1.NodePIDPressure, status: v1.ConditionFalse},
+}
+
+const nodeStatusPrefix = "status is now: "
+
+// leaderAnnotation tracks the leader election record annotation of endpoints based locks.
+const leaderAnnotation = "control-plane.alpha.kubernetes.io/leader"
+
+// DefaultIsolateTaint tracks the taint applied to isolated nodes by default.
+// Running pods are not evicted, drain the node to move them.
+var DefaultIsolateTaint = v1.Taint{
+	Key:    "k9s.io/isolate",
+	Value:  "true",
+	Effect: v1.TaintEffectNoSchedule,
+}
+
+// ErrNoGPUs indicates a node does not advertise any GPUs.
+var ErrNoGPUs = errors.New("node does not advertise any GPUs")
+
+// requestedResources tracks the pods resources tallied per node.
+var requestedResources = func() []v1.ResourceName {
+	rr := []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory}
+	for _, g := range render.GPUResources {
+		rr = append(rr, g.Resource)
+	}
+
+	return rr
+}()
+
+var errEvictionUnavailable = errors.New("eviction API unavailable. Falling back to pod deletions")
+
+// immutableNodeFields tracks the node fields that can not be changed by an edit.
+// Only metadata and spec sections are editable.
+var immutableNodeFields = map[string][]string{
+	"metadata": {"name", "namespace", "uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "selfLink", "managedFields"},
+	"spec":     {"podCIDR", "podCIDRs", "providerID", "externalID"},
+}
+
+var annotationChanges = struct {
+	sync.RWMutex
+	changes map[string][]AnnotationChange
+}{
+	changes: make(map[string][]AnnotationChange),
+}
+
 // NodeMetricsFunc retrieves node metrics.
 type NodeMetricsFunc func() (*mv1beta1.NodeMetricsList, error)
 
 // Node represents a node model.
 type Node struct {
 	Resource
+
+	// Concurrency caps the number of nodes processed at once by batch operations.
+	Concurrency int
+
+	// LabelSelector scopes node listings to the matching nodes when set.
+	LabelSelector labels.Selector
+}
+
+// NodeOption represents a node accessor option.
+type NodeOption func(*Node)
+
+// WithLabelSelector scopes node listings to the nodes matching the given selector.
+// A nil selector lists all nodes.
+func WithLabelSelector(sel labels.Selector) NodeOption {
+	return func(n *Node) {
+		n.LabelSelector = sel
+	}
+}
+
+// WithOptions returns a copy of the node accessor with the given options applied.
+// The registered accessor is shared by all node views and is left untouched.
+func (n *Node) WithOptions(oo ...NodeOption) *Node {
+	cp := Node{
+		Concurrency:   n.Concurrency,
+		LabelSelector: n.LabelSelector,
+	}
+	cp.Init(n.Factory, n.gvr)
+	for _, o := range oo {
+		o(&cp)
+	}
+
+	return &cp
+}
+
+// labelSelector returns the selector scoping the node listings. A selector
+// passed in the context takes precedence over the accessor one.
+func (n *Node) labelSelector(ctx context.Context) labels.Selector {
+	if sel, ok := ctx.Value(internal.KeyNodeSelector).(labels.Selector); ok {
+		return sel
+	}
+
+	return n.LabelSelector
 }
 
 // ToggleCordon toggles cordon/uncordon a node.
 func (n *Node) ToggleCordon(fqn string, cordon bool) error {
+	return n.toggleCordon(fqn, cordon, DefaultNodeCacheTTL)
+}
+
+// toggleCordon toggles cordon/uncordon a node, reusing a node fetched within the given ttl.
+func (n *Node) toggleCordon(fqn string, cordon bool, ttl time.Duration) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(n.getFactory(), n.gvr, fqn, cordonAction(cordon), nil, err)
+	}()
+
 	slog.Debug("Toggle cordon on node",
 		slogs.GVR, n.GVR(),
 		slogs.FQN, fqn,
 		slogs.Bool, cordon,
 	)
-	o, err := FetchNode(context.Background(), n.Factory, fqn)
+	o, err := CachedFetchNode(context.Background(), n.Factory, fqn, ttl)
 	if err != nil {
 		return err
 	}
@@ -70,16 +286,213 @@ func (n *Node) ToggleCordon(fqn string, cordon bool) error {
 	}
 
 	err, patchErr := h.PatchOrReplace(dial, false)
+	n.invalidateNode(fqn)
 	if patchErr != nil {
 		return patchErr
 	}
 	if err != nil {
 		return err
 	}
+	if !cordon && hasCordonReason(o) {
+		return n.patchAnnotations(fqn, map[string]any{
+			CordonReasonAnnotation: nil,
+			CordonTimeAnnotation:   nil,
+		})
+	}
 
 	return nil
 }
 
+// Cordon marks a node as unschedulable.
+func (n *Node) Cordon(fqn string) error {
+	return n.ToggleCordon(fqn, true)
+}
+
+// Uncordon marks a node as schedulable.
+func (n *Node) Uncordon(fqn string) error {
+	return n.ToggleCordon(fqn, false)
+}
+
+// CordonWithReason cordons a node and records why via node annotations.
+func (n *Node) CordonWithReason(fqn, reason string) error {
+	if err := n.Cordon(fqn); err != nil {
+		return err
+	}
+	if reason == "" {
+		return nil
+	}
+
+	return n.patchAnnotations(fqn, map[string]any{
+		CordonReasonAnnotation: reason,
+		CordonTimeAnnotation:   time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Isolate cordons a node and adds the given taint so pods not tolerating it are kept off.
+// A blank taint defaults to DefaultIsolateTaint.
+func (n *Node) Isolate(ctx context.Context, nodeName string, taint v1.Taint) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	if taint.Key == "" {
+		taint = DefaultIsolateTaint
+	}
+	defer func() {
+		auditAction(n.getFactory(), n.gvr, nodeName, "isolate", map[string]any{"taint": taint.ToString()}, err)
+	}()
+
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	defer n.invalidateNode(nodeName)
+
+	return isolateNode(ctx, dial, nodeName, taint, true)
+}
+
+// Deisolate removes the given isolation taint and uncordons a node if its isolation
+// cordoned it. A blank taint defaults to DefaultIsolateTaint.
+func (n *Node) Deisolate(ctx context.Context, nodeName string, taint v1.Taint) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	if taint.Key == "" {
+		taint = DefaultIsolateTaint
+	}
+	defer func() {
+		auditAction(n.getFactory(), n.gvr, nodeName, "deisolate", map[string]any{"taint": taint.ToString()}, err)
+	}()
+
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	defer n.invalidateNode(nodeName)
+
+	return isolateNode(ctx, dial, nodeName, taint, false)
+}
+
+// isolateNode toggles a node isolation, retrying on update conflicts.
+func isolateNode(ctx context.Context, dial kubernetes.Interface, fqn string, taint v1.Taint, isolate bool) error {
+	_, name := client.Namespaced(fqn)
+	nn := dial.CoreV1().Nodes()
+	for i := 0; ; i++ {
+		no, err := nn.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !setIsolation(no, taint, isolate, time.Now()) {
+			if isolate {
+				return fmt.Errorf("node %s is already isolated", name)
+			}
+			return fmt.Errorf("node %s is not isolated", name)
+		}
+		_, err = nn.Update(ctx, no, metav1.UpdateOptions{})
+		if !kerrors.IsConflict(err) || i == nodeEditRetries {
+			return err
+		}
+		slog.Debug("Node isolation conflict. Retrying", slogs.ResName, name, slogs.Error, err)
+	}
+}
+
+// setIsolation cordons and taints a node or reverses both. A node already cordoned is
+// left cordoned on deisolation. It reports whether the node changed.
+func setIsolation(no *v1.Node, taint v1.Taint, isolate bool, now time.Time) bool {
+	idx := slices.IndexFunc(no.Spec.Taints, func(t v1.Taint) bool {
+		return t.MatchTaint(&taint)
+	})
+	_, cordoned := no.Annotations[IsolateCordonAnnotation]
+	if !isolate {
+		if idx < 0 && !cordoned {
+			return false
+		}
+		if idx >= 0 {
+			no.Spec.Taints = slices.Delete(no.Spec.Taints, idx, idx+1)
+		}
+		if cordoned {
+			no.Spec.Unschedulable = false
+			delete(no.Annotations, IsolateCordonAnnotation)
+		}
+		return true
+	}
+
+	if idx >= 0 && no.Spec.Taints[idx].Value == taint.Value && no.Spec.Unschedulable {
+		return false
+	}
+	if taint.Effect == v1.TaintEffectNoExecute && taint.TimeAdded == nil {
+		taint.TimeAdded = &metav1.Time{Time: now}
+	}
+	if idx >= 0 {
+		no.Spec.Taints[idx] = taint
+	} else {
+		no.Spec.Taints = append(no.Spec.Taints, taint)
+	}
+	if !no.Spec.Unschedulable {
+		no.Spec.Unschedulable = true
+		if no.Annotations == nil {
+			no.Annotations = make(map[string]string, 1)
+		}
+		no.Annotations[IsolateCordonAnnotation] = now.UTC().Format(time.RFC3339)
+	}
+
+	return true
+}
+
+// BatchCordonWithReason cordons a collection of nodes concurrently, recording the given reason.
+func (n *Node) BatchCordonWithReason(fqns []string, reason string) []error {
+	return n.batch(fqns, true, func(fqn string) error {
+		return n.CordonWithReason(fqn, reason)
+	})
+}
+
+// BatchToggleCordon cordons/uncordons a collection of nodes concurrently.
+func (n *Node) BatchToggleCordon(fqns []string, cordon bool) []error {
+	fn := n.Uncordon
+	if cordon {
+		fn = n.Cordon
+	}
+
+	return n.batch(fqns, cordon, fn)
+}
+
+func (n *Node) batch(fqns []string, cordon bool, fn func(string) error) []error {
+	size := n.Concurrency
+	if size <= 0 {
+		size = internal.DefaultPoolSize
+	}
+	pool := internal.NewWorkerPool(context.Background(), size)
+	for _, fqn := range fqns {
+		pool.Add(func(context.Context) error {
+			err := fn(fqn)
+			if err != nil {
+				slog.Warn("Node cordon toggle failed",
+					slogs.FQN, fqn,
+					slogs.Bool, cordon,
+					slogs.Error, err,
+				)
+				return fmt.Errorf("%s: %w", fqn, err)
+			}
+			slog.Info("Node cordon toggled",
+				slogs.FQN, fqn,
+				slogs.Bool, cordon,
+			)
+
+			return nil
+		})
+	}
+
+	return pool.Drain()
+}
+
+// Mode returns the pods removal mode used by a drain.
+func (o DrainOptions) Mode() string {
+	if o.DisableEviction {
+		return DrainModeDeletion
+	}
+
+	return DrainModeEviction
+}
+
 func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.Helper {
 	return drain.Helper{
 		Client:              k,
@@ -91,18 +504,105 @@ func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.H
 		Out:                 w,
 		ErrOut:              w,
 		Force:               o.Force,
+		AdditionalFilters:   []drain.PodFilter{o.safeToEvictFilter},
+	}
+}
+
+// safeToEvictFilter refuses to drain pods annotated as not safe to evict unless
+// they are explicitly marked safe for the drain.
+func (o DrainOptions) safeToEvictFilter(po v1.Pod) drain.PodDeleteStatus {
+	if o.MarkSafeToEvict || !isUnsafeToEvict(&po) {
+		return drain.MakePodDeleteStatusOkay()
+	}
+
+	return drain.MakePodDeleteStatusWithError(fmt.Sprintf("pod %s is annotated %s=false", client.FQN(po.Namespace, po.Name), SafeToEvictAnnotation))
+}
+
+// markSafeToEvict annotates the given pods not safe to evict as safe. The returned
+// function restores the annotation on the pods that were not evicted.
+func markSafeToEvict(ctx context.Context, dial kubernetes.Interface, pp []*v1.Pod, audit auditFn) (restore func(), err error) {
+	var marked []*v1.Pod
+	restore = func() {
+		for _, po := range marked {
+			curr, err := dial.CoreV1().Pods(po.Namespace).Get(ctx, po.Name, metav1.GetOptions{})
+			if err != nil || curr.UID != po.UID || curr.DeletionTimestamp != nil {
+				continue
+			}
+			err = annotateSafeToEvict(ctx, dial, po.Namespace, po.Name, false)
+			audit(client.PodGVR, client.FQN(po.Namespace, po.Name), "annotate", map[string]any{SafeToEvictAnnotation: false}, err)
+		}
+	}
+	for _, po := range pp {
+		if !isUnsafeToEvict(po) {
+			continue
+		}
+		err := annotateSafeToEvict(ctx, dial, po.Namespace, po.Name, true)
+		audit(client.PodGVR, client.FQN(po.Namespace, po.Name), "annotate", map[string]any{SafeToEvictAnnotation: true}, err)
+		if err != nil {
+			restore()
+			return nil, err
+		}
+		marked = append(marked, po)
 	}
+
+	return restore, nil
 }
 
-// Drain drains a node.
+// Drain drains a node. Pre-drain hooks run first and abort the drain on failure.
+// Post-drain hooks run once the node is drained.
 func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
-	cordoned, err := n.ensureCordoned(path)
+	if err := ReadonlyGuard(); err != nil {
+		return err
+	}
+	_, name := client.Namespaced(path)
+	if err := n.RunDrainHooks(context.Background(), PreDrainHook, opts.PreHooks, name, opts.HookTimeout, w); err != nil {
+		return err
+	}
+
+	events, done := make(chan DrainEvent), make(chan struct{})
+	mode := opts.Mode()
+	go func() {
+		defer close(done)
+		for evt := range events {
+			if evt.Phase == DrainEvictionUnavailable {
+				mode = DrainModeDeletion
+			}
+			if evt.PodName == "" {
+				_, _ = fmt.Fprintf(w, "[%s] %s\n", path, evt.Err)
+				continue
+			}
+			_, _ = fmt.Fprintln(w, evt)
+		}
+	}()
+	err := n.DrainWithEvents(path, opts, events)
+	<-done
+	if err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "Node %s drained (%s)!\n", path, mode)
+
+	return n.RunDrainHooks(context.Background(), PostDrainHook, opts.PostHooks, name, opts.HookTimeout, w)
+}
+
+// DrainWithEvents drains a node and emits an event per pod eviction attempt.
+// The events channel is closed once the drain completes.
+func (n *Node) DrainWithEvents(path string, opts DrainOptions, events chan<- DrainEvent) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(n.getFactory(), n.gvr, path, "drain", opts.auditParams(), err)
+	}()
+
+	defer close(events)
+
+	cordoned, err := n.ensureCordoned(path, opts.CacheTTL)
 	if err != nil {
 		return err
 	}
 
 	if !cordoned {
-		if e := n.ToggleCordon(path, true); e != nil {
+		if e := n.toggleCordon(path, true, opts.CacheTTL); e != nil {
 			return e
 		}
 	}
@@ -111,25 +611,94 @@ func (n *Node) Drain(path string, opts DrainOptions, w io.Writer) error {
 	if err != nil {
 		return err
 	}
-	h := opts.toDrainHelper(dial, w)
+	if opts.MarkSafeToEvict {
+		pp, err := listNodePods(context.Background(), dial, path)
+		if err != nil {
+			return err
+		}
+		restore, err := markSafeToEvict(context.Background(), dial, pp, auditorFor(n.getFactory()))
+		if err != nil {
+			return err
+		}
+		defer restore()
+	}
+	h := opts.toDrainHelper(dial, io.Discard)
+	h.OnPodDeletionOrEvictionStarted = func(po *v1.Pod, usingEviction bool) {
+		phase := DrainDeleting
+		if usingEviction {
+			phase = DrainEvicting
+		}
+		events <- DrainEvent{PodName: po.Name, Namespace: po.Namespace, Phase: phase}
+	}
+	h.OnPodDeletionOrEvictionFinished = func(po *v1.Pod, usingEviction bool, err error) {
+		evt := DrainEvent{PodName: po.Name, Namespace: po.Namespace, Phase: DrainDeleted, Err: err}
+		switch {
+		case err != nil:
+			evt.Phase = DrainFailed
+		case usingEviction:
+			evt.Phase = DrainEvicted
+		}
+		events <- evt
+	}
+
 	dd, errs := h.GetPodsForDeletion(path)
 	if len(errs) != 0 {
 		for _, e := range errs {
-			if _, err := fmt.Fprintf(h.ErrOut, "[%s] %s\n", path, e.Error()); err != nil {
-				return err
-			}
+			events <- DrainEvent{Phase: DrainFailed, Err: e}
 		}
 		return errors.Join(errs...)
 	}
+	if !h.DisableEviction && len(dd.Pods()) > 0 && !evictionSupported(h.Client) {
+		h.DisableEviction = true
+		events <- DrainEvent{Phase: DrainEvictionUnavailable, Err: errEvictionUnavailable}
+	}
 
-	if err := h.DeleteOrEvictPods(dd.Pods()); err != nil {
-		return err
+	for i, pp := range chunkPods(orderPods(dd.Pods(), opts.EvictionOrder), opts.ChunkSize) {
+		if i > 0 && opts.InterChunkDelay > 0 {
+			time.Sleep(opts.InterChunkDelay)
+		}
+		if err := h.DeleteOrEvictPods(pp); err != nil {
+			return err
+		}
 	}
-	_, _ = fmt.Fprintf(h.Out, "Node %s drained!", path)
 
 	return nil
 }
 
+// DrainDryRun previews a node drain without evicting any pods. It returns the
+// pods that would be evicted along with DaemonSet and PDB warnings.
+func (n *Node) DrainDryRun(path string, opts DrainOptions, w io.Writer) ([]string, []error) {
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return nil, []error{err}
+	}
+	h := opts.toDrainHelper(dial, w)
+	dd, errs := h.GetPodsForDeletion(path)
+	if dd == nil {
+		return nil, errs
+	}
+	if ww := dd.Warnings(); ww != "" {
+		errs = append(errs, errors.New(ww))
+	}
+
+	pp := dd.Pods()
+	if !h.DisableEviction && len(pp) > 0 && !evictionSupported(h.Client) {
+		errs = append(errs, errEvictionUnavailable)
+	}
+	fqns := make([]string, 0, len(pp))
+	for _, po := range pp {
+		fqn := client.FQN(po.Namespace, po.Name)
+		fqns = append(fqns, fqn)
+		_, _ = fmt.Fprintf(w, "[%s] would evict pod %s\n", path, fqn)
+	}
+	errs = append(errs, pdbConflicts(dial, pp)...)
+	for _, e := range errs {
+		_, _ = fmt.Fprintf(w, "[%s] %s\n", path, e)
+	}
+
+	return fqns, errs
+}
+
 // Get returns a node resource.
 func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 	oo, err := n.Resource.List(ctx, "")
@@ -149,31 +718,76 @@ func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 
 	var nmx *mv1beta1.NodeMetrics
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); ok && withMx {
-		nmx, _ = client.DialMetrics(n.Client()).FetchNodeMetrics(ctx, path)
+		nmx, err = client.DialRetryingMetrics(n.Client()).FetchNodeMetrics(ctx, path)
+		reportNodesMetrics(err, slogs.ResName, path)
 	}
 
 	return &render.NodeWithMetrics{Raw: raw, MX: nmx}, nil
 }
 
 // List returns a collection of node resources.
+// Nodes are scoped by both the context labels and the accessor label selector.
 func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	oo, err := n.Resource.List(ctx, ns)
+	oo, err := n.listNodes(ctx, ns)
 	if err != nil {
 		return oo, err
 	}
+	if gpuOnly, _ := ctx.Value(internal.KeyGPUNodes).(bool); gpuOnly {
+		oo = gpuNodes(oo)
+	}
 
 	var nmx client.NodesMetricsMap
 	if withMx, ok := ctx.Value(internal.KeyWithMetrics).(bool); withMx || !ok {
-		nmx, _ = client.DialMetrics(n.Client()).FetchNodesMetricsMap(ctx)
+		mx := client.DialRetryingMetrics(n.Client())
+		if count := client.MultiMetricsEndpoints(); count > 1 {
+			nmx, err = mx.FetchMergedNodesMetricsMap(ctx, count)
+		} else {
+			nmx, err = mx.FetchNodesMetricsMap(ctx)
+		}
+		reportNodesMetrics(err)
+	}
+
+	var nnx client.NodesNetworkMetricsMap
+	if withNet, _ := ctx.Value(internal.KeyNetworkMetrics).(bool); withNet {
+		nnx = FetchNodesNetworkMetrics(ctx, NodeNetworkMetrics(n.getFactory()), nodeNames(oo))
+	}
+
+	var throttles map[string]float64
+	if pc := activePrometheus(n.Client()); pc != nil {
+		throttles = pc.CachedNodesCPUThrottle()
+	}
+
+	var certs map[string]int
+	if withCerts, _ := ctx.Value(internal.KeyCertExpiry).(bool); withCerts {
+		certs = CachedNodesCertExpiry(n.getFactory(), nodeNames(oo))
+	}
+
+	var cves map[string]int
+	if feed := client.KernelCVEFeed(); feed != nil {
+		cves = CachedKernelCVECounts(feed, nodeKernels(oo))
 	}
 
 	shouldCountPods, _ := ctx.Value(internal.KeyPodCounting).(bool)
-	var pods []runtime.Object
+	var (
+		counts map[string]int
+		phases map[string]map[v1.PodPhase]int
+		reqs   map[string]v1.ResourceList
+	)
 	if shouldCountPods {
-		pods, err = n.getFactory().List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
+		pods, err := n.getFactory().List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
 		if err != nil {
 			slog.Error("Unable to list pods", slogs.Error, err)
 		}
+		if counts, err = BuildPodCountMap(pods); err != nil {
+			slog.Error("Unable to get pods count", slogs.Error, err)
+		}
+		if phases, err = buildPodPhasesMap(pods); err != nil {
+			slog.Error("Unable to get pods phases", slogs.Error, err)
+		}
+		reqs, err = nodesRequests(pods)
+		if err != nil {
+			slog.Error("Unable to compute pods requests", slogs.Error, err)
+		}
 	}
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
@@ -185,78 +799,1754 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		fqn := extractFQN(o)
 		_, name := client.Namespaced(fqn)
 		podCount := -1
+		var podPhases map[v1.PodPhase]int
 		if shouldCountPods {
-			podCount, err = n.CountPods(pods, name)
-			if err != nil {
-				slog.Error("Unable to get pods count",
-					slogs.ResName, name,
-					slogs.Error, err,
-				)
+			podCount, podPhases = counts[name], phases[name]
+			if podPhases == nil {
+				podPhases = make(map[v1.PodPhase]int)
 			}
 		}
-		res = append(res, &render.NodeWithMetrics{
-			Raw:      u,
-			MX:       nmx[name],
-			PodCount: podCount,
-		})
+		if mx := nmx[name]; mx != nil {
+			if sample, ok := nodeUtilization(u, mx); ok {
+				NodeMetricsHistory.Add(n.Client().ActiveContext(), name, sample)
+			}
+		}
+		nwm := render.NodeWithMetrics{
+			Raw:       u,
+			MX:        nmx[name],
+			Net:       nnx[name],
+			PodCount:  podCount,
+			PodPhases: podPhases,
+			Requested: reqs[name],
+		}
+		if t, ok := throttles[name]; ok {
+			nwm.CPUThrottle = &t
+		}
+		if d, ok := certs[name]; ok {
+			nwm.CertDays = &d
+		}
+		if c, ok := cves[name]; ok {
+			nwm.CVECount = &c
+		}
+		res = append(res, &nwm)
 	}
 
 	return res, nil
 }
 
+func (n *Node) listNodes(ctx context.Context, ns string) ([]runtime.Object, error) {
+	sel := n.labelSelector(ctx)
+	if sel == nil || sel.Empty() {
+		return n.Resource.List(ctx, ns)
+	}
+	strLabel, _ := ctx.Value(internal.KeyLabels).(string)
+
+	return n.getFactory().List(n.gvr, ns, false, mergeSelectors(sel, strLabel))
+}
+
 // CountPods counts the pods scheduled on a given node.
 func (*Node) CountPods(oo []runtime.Object, nodeName string) (int, error) {
-	var count int
+	mm, err := BuildPodCountMap(oo)
+
+	return mm[nodeName], err
+}
+
+// CountPodsByPhase counts the pods scheduled on a given node per pod phase.
+func (*Node) CountPodsByPhase(oo []runtime.Object, nodeName string) (map[v1.PodPhase]int, error) {
+	counts := make(map[v1.PodPhase]int)
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
-			return count, fmt.Errorf("expecting *Unstructured but got `%T", o)
+			return counts, fmt.Errorf("expecting *Unstructured but got `%T", o)
 		}
-		spec, ok := u.Object["spec"].(map[string]any)
-		if !ok {
-			return count, fmt.Errorf("expecting spec interface map but got `%T", o)
+		node, _, err := unstructured.NestedString(u.Object, "spec", "nodeName")
+		if err != nil {
+			return counts, err
 		}
-		if node, ok := spec["nodeName"]; ok && node == nodeName {
-			count++
+		if node != nodeName {
+			continue
 		}
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		counts[v1.PodPhase(phase)]++
 	}
 
-	return count, nil
+	return counts, nil
 }
 
-// GetPods returns all pods running on given node.
-func (n *Node) GetPods(nodeName string) ([]*v1.Pod, error) {
-	oo, err := n.getFactory().List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
-	if err != nil {
-		return nil, err
-	}
-
-	pp := make([]*v1.Pod, 0, len(oo))
+// buildPodPhasesMap counts the scheduled pods per node and pod phase.
+func buildPodPhasesMap(oo []runtime.Object) (map[string]map[v1.PodPhase]int, error) {
+	mm := make(map[string]map[v1.PodPhase]int)
 	for _, o := range oo {
-		po := new(v1.Pod)
-		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, po); err != nil {
-			return nil, err
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return mm, fmt.Errorf("expecting *Unstructured but got `%T", o)
+		}
+		node, _, err := unstructured.NestedString(u.Object, "spec", "nodeName")
+		if err != nil {
+			return mm, err
+		}
+		if node == "" {
+			continue
 		}
-		if po.Spec.NodeName == nodeName {
-			pp = append(pp, po)
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		if mm[node] == nil {
+			mm[node] = make(map[v1.PodPhase]int)
 		}
+		mm[node][v1.PodPhase(phase)]++
 	}
 
-	return pp, nil
+	return mm, nil
 }
 
-// ensureCordoned returns whether the given node has been cordoned
-func (n *Node) ensureCordoned(path string) (bool, error) {
-	o, err := FetchNode(context.Background(), n.Factory, path)
-	if err != nil {
-		return false, err
+// PatchNodeLabels sets the node labels to the given set via a strategic merge patch.
+// Existing labels missing from the set are removed.
+func (n *Node) PatchNodeLabels(ctx context.Context, nodeName string, ll map[string]string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(n.getFactory(), n.gvr, nodeName, "patch", map[string]any{"labels": ll}, err)
+	}()
+
+	if errs := metav1validation.ValidateLabels(ll, field.NewPath("metadata", "labels")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	no, err := FetchNode(ctx, n.Factory, nodeName)
+	if err != nil {
+		return err
+	}
+	patch, err := labelsPatch(no.Labels, ll)
+	if err != nil {
+		return err
+	}
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Nodes().Patch(ctx, no.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	n.invalidateNode(nodeName)
+
+	return err
+}
+
+// EditNode patches a node with the changes between its original and edited manifests.
+// Patches touching immutable fields are rejected. When the node was modified
+// concurrently, the edits are rebased onto the latest version and retried. Edits
+// clashing with concurrent changes yield a NodeEditConflictError.
+func (n *Node) EditNode(ctx context.Context, nodeName string, orig, edited []byte) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	var patch []byte
+	defer func() {
+		auditAction(n.getFactory(), n.gvr, nodeName, "patch", map[string]any{"patch": string(patch)}, err)
+	}()
+
+	patch, err = NodeEditPatch(orig, edited)
+	if err != nil {
+		return err
+	}
+	if err := ValidateNodePatch(patch); err != nil {
+		return err
+	}
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	_, name := client.Namespaced(nodeName)
+	err = editNode(ctx, dial.CoreV1().Nodes(), name, orig, edited, patch)
+	n.invalidateNode(nodeName)
+
+	return err
+}
+
+// NodeEditConflictError tracks node edits clashing with concurrent changes.
+type NodeEditConflictError struct {
+	Node string
+	Diff string
+}
+
+// Error returns the error message.
+func (e *NodeEditConflictError) Error() string {
+	return fmt.Sprintf("node %s was modified concurrently with conflicting changes. Resolve manually", e.Node)
+}
+
+// editNode applies a node patch guarded by the original resource version. On conflict
+// the patch is rebased onto the latest node and retried.
+func editNode(ctx context.Context, nn corev1.NodeInterface, name string, orig, edited, patch []byte) error {
+	oj, err := yaml.YAMLToJSON(orig)
+	if err != nil {
+		return err
+	}
+	var o struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(oj, &o); err != nil {
+		return err
+	}
+
+	rv := o.Metadata.ResourceVersion
+	for i := 0; ; i++ {
+		p, err := withResourceVersion(patch, rv)
+		if err != nil {
+			return err
+		}
+		_, err = nn.Patch(ctx, name, types.StrategicMergePatchType, p, metav1.PatchOptions{})
+		if !kerrors.IsConflict(err) || i == nodeEditRetries {
+			return err
+		}
+		slog.Debug("Node edit conflict. Rebasing edits", slogs.ResName, name, slogs.Error, err)
+
+		latest, err := nn.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		conflict, err := nodeEditsConflict(oj, patch, latest)
+		if err != nil {
+			return err
+		}
+		if conflict {
+			return &NodeEditConflictError{Node: name, Diff: nodeEditDiff(latest, edited)}
+		}
+		rv = latest.ResourceVersion
+	}
+}
+
+// nodeEditsConflict checks whether the user edits and the changes made to a node
+// since it was fetched touch the same fields.
+func nodeEditsConflict(orig, patch []byte, latest *v1.Node) (bool, error) {
+	lj, err := json.Marshal(latest)
+	if err != nil {
+		return false, err
+	}
+	theirs, err := strategicpatch.CreateTwoWayMergeMapPatch(jsonMap(orig), jsonMap(lj), v1.Node{})
+	if err != nil {
+		return false, err
+	}
+	var ours map[string]any
+	if err := json.Unmarshal(patch, &ours); err != nil {
+		return false, err
+	}
+	schema, err := strategicpatch.NewPatchMetaFromStruct(v1.Node{})
+	if err != nil {
+		return false, err
+	}
+	unstructured.RemoveNestedField(theirs, "metadata", "resourceVersion")
+	unstructured.RemoveNestedField(theirs, "metadata", "managedFields")
+
+	return strategicpatch.MergingMapsHaveConflicts(ours, theirs, schema)
+}
+
+// nodeEditDiff returns a unified diff between the latest node manifest and the edited one.
+func nodeEditDiff(latest *v1.Node, edited []byte) string {
+	no := latest.DeepCopy()
+	no.ManagedFields = nil
+	from, err := yaml.Marshal(no)
+	if err != nil {
+		return err.Error()
+	}
+	var o map[string]any
+	if err := yaml.Unmarshal(edited, &o); err != nil {
+		return err.Error()
+	}
+	to, err := yaml.Marshal(o)
+	if err != nil {
+		return err.Error()
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(from)),
+		B:        difflib.SplitLines(string(to)),
+		FromFile: "latest/" + latest.Name,
+		ToFile:   "edited/" + latest.Name,
+		Context:  3,
+	})
+	if err != nil {
+		return err.Error()
+	}
+
+	return diff
+}
+
+// withResourceVersion guards a patch with the given resource version.
+func withResourceVersion(patch []byte, rv string) ([]byte, error) {
+	if rv == "" {
+		return patch, nil
+	}
+	var mm map[string]any
+	if err := json.Unmarshal(patch, &mm); err != nil {
+		return nil, err
+	}
+	if err := unstructured.SetNestedField(mm, rv, "metadata", "resourceVersion"); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mm)
+}
+
+func jsonMap(bb []byte) map[string]any {
+	var mm map[string]any
+	_ = json.Unmarshal(bb, &mm)
+
+	return mm
+}
+
+// NodeEditPatch computes the strategic merge patch turning the original node manifest
+// into the edited one.
+func NodeEditPatch(orig, edited []byte) ([]byte, error) {
+	oj, err := yaml.YAMLToJSON(orig)
+	if err != nil {
+		return nil, err
+	}
+	ej, err := yaml.YAMLToJSON(edited)
+	if err != nil {
+		return nil, err
+	}
+
+	return strategicpatch.CreateTwoWayMergePatch(oj, ej, v1.Node{})
+}
+
+// ValidateNodePatch ensures a node patch only touches mutable fields.
+func ValidateNodePatch(patch []byte) error {
+	var mm map[string]any
+	if err := json.Unmarshal(patch, &mm); err != nil {
+		return fmt.Errorf("invalid node patch: %w", err)
+	}
+
+	var errs []error
+	for _, k := range slices.Sorted(maps.Keys(mm)) {
+		ff, ok := immutableNodeFields[k]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s is not editable", k))
+			continue
+		}
+		m, ok := mm[k].(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s can not be removed", k))
+			continue
+		}
+		for _, f := range ff {
+			if _, ok := m[f]; ok {
+				errs = append(errs, fmt.Errorf("%s.%s is immutable", k, f))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetAllocatableResources returns the node allocatable resources along with the
+// resources requested by the pods scheduled on it.
+func (n *Node) GetAllocatableResources(nodeName string) (*NodeResourceSummary, error) {
+	no, err := CachedFetchNode(context.Background(), n.Factory, nodeName, DefaultNodeCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	rl := make(v1.ResourceList, 2)
+	for _, po := range pp {
+		addPodRequests(rl, po)
+	}
+
+	return &NodeResourceSummary{
+		AllocatableCPU:    no.Status.Allocatable.Cpu().MilliValue(),
+		AllocatableMemory: no.Status.Allocatable.Memory().Value(),
+		RequestedCPU:      rl.Cpu().MilliValue(),
+		RequestedMemory:   rl.Memory().Value(),
+	}, nil
+}
+
+// GetPods returns all pods running on given node.
+// On large clusters, pods are listed server side using a node field selector
+// rather than filtering all the cached cluster pods.
+func (n *Node) GetPods(nodeName string) ([]*v1.Pod, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.Client().Config().CallTimeout())
+	defer cancel()
+
+	if n.isLargeCluster(ctx) {
+		dial, err := n.Client().Dial()
+		if err == nil {
+			var pp []*v1.Pod
+			if pp, err = listNodePods(ctx, dial, nodeName); err == nil {
+				return pp, nil
+			}
+		}
+		slog.Warn("Node pods field selector list failed. Using cache",
+			slogs.ResName, nodeName,
+			slogs.Error, err,
+		)
+	}
+
+	oo, err := n.getFactory().List(client.PodGVR, client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return filterNodePods(oo, nodeName)
+}
+
+// GetPodDisruptionBudgets returns the PDBs protecting at least one pod running on the given node.
+func (n *Node) GetPodDisruptionBudgets(nodeName string) ([]*policyv1.PodDisruptionBudget, error) {
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	pdbs, err := n.listPDBs()
+	if err != nil {
+		return nil, err
+	}
+
+	return coveringPDBs(pdbs, pp), nil
+}
+
+func (n *Node) listPDBs() ([]*policyv1.PodDisruptionBudget, error) {
+	oo, err := n.getFactory().List(client.PdbGVR, client.BlankNamespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pdbs := make([]*policyv1.PodDisruptionBudget, 0, len(oo))
+	for _, o := range oo {
+		pdb := new(policyv1.PodDisruptionBudget)
+		if err := fromUnstructured(o, pdb); err != nil {
+			return nil, err
+		}
+		pdbs = append(pdbs, pdb)
+	}
+
+	return pdbs, nil
+}
+
+// SimulateDrain evaluates the PDBs covering the pods running on the given nodes and reports
+// which pods could not be evicted without violating a disruption budget. Disruptions add up
+// across nodes as they would when draining them all.
+func (n *Node) SimulateDrain(nodeNames ...string) (*DrainSimulation, error) {
+	var pp []*v1.Pod
+	for _, name := range nodeNames {
+		nn, err := n.GetPods(name)
+		if err != nil {
+			return nil, err
+		}
+		pp = append(pp, nn...)
+	}
+	pdbs, err := n.listPDBs()
+	if err != nil {
+		return nil, err
+	}
+	cc := coveringPDBs(pdbs, pp)
+	sim := simulateDrain(pp, cc)
+	sim.PDBs = cc
+
+	return sim, nil
+}
+
+// LeaderElection represents a leader election lock held by a node.
+type LeaderElection struct {
+	// Kind tracks the lock kind ie Lease or Endpoints.
+	Kind      string
+	Namespace string
+	Name      string
+
+	// Holder tracks the lock holder identity.
+	Holder string
+
+	// Node tracks the node holding the lock either directly or via one of its pods.
+	Node string
+
+	// Pod tracks the holder pod if the holder identity matches a pod on the node.
+	Pod string
+}
+
+// lockHolder tracks a node or a pod on a node able to hold a leader election lock.
+type lockHolder struct {
+	node, pod string
+}
+
+// String returns the leader election description.
+func (l LeaderElection) String() string {
+	s := fmt.Sprintf("%s %s held by %s", strings.ToLower(l.Kind), client.FQN(l.Namespace, l.Name), l.Holder)
+	if l.Pod != "" {
+		s += " (" + l.Pod + ")"
+	}
+
+	return s
+}
+
+// leaderRecord represents a leader election record stored in a lock annotation.
+type leaderRecord struct {
+	HolderIdentity       string      `json:"holderIdentity"`
+	LeaseDurationSeconds int         `json:"leaseDurationSeconds"`
+	RenewTime            metav1.Time `json:"renewTime"`
+}
+
+// CheckLeaderElections returns the active leader election locks, leases or endpoints,
+// held by the given nodes or the pods they run.
+func (n *Node) CheckLeaderElections(nodeNames ...string) ([]LeaderElection, error) {
+	pods := make(map[string][]*v1.Pod, len(nodeNames))
+	for _, name := range nodeNames {
+		pp, err := n.GetPods(name)
+		if err != nil {
+			return nil, err
+		}
+		pods[name] = pp
+	}
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.Client().Config().CallTimeout())
+	defer cancel()
+
+	return leaderElections(ctx, dial, lockHolders(pods), time.Now())
+}
+
+// lockHolders indexes the given nodes and their pods by the identity they would hold a lock with.
+func lockHolders(pods map[string][]*v1.Pod) map[string]lockHolder {
+	hh := make(map[string]lockHolder)
+	for node, pp := range pods {
+		hh[node] = lockHolder{node: node}
+		for _, po := range pp {
+			hh[po.Name] = lockHolder{node: node, pod: client.FQN(po.Namespace, po.Name)}
+		}
+	}
+
+	return hh
+}
+
+func leaderElections(ctx context.Context, dial kubernetes.Interface, holders map[string]lockHolder, now time.Time) ([]LeaderElection, error) {
+	var (
+		ll   []LeaderElection
+		errs []error
+	)
+	leases, err := dial.CoordinationV1().Leases(client.BlankNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to list leases: %w", err))
+	} else {
+		for i := range leases.Items {
+			l := &leases.Items[i]
+			if l.Namespace == v1.NamespaceNodeLease || l.Spec.HolderIdentity == nil {
+				continue
+			}
+			var (
+				renew time.Time
+				ttl   int
+			)
+			if l.Spec.RenewTime != nil {
+				renew = l.Spec.RenewTime.Time
+			}
+			if l.Spec.LeaseDurationSeconds != nil {
+				ttl = int(*l.Spec.LeaseDurationSeconds)
+			}
+			if le, ok := leaderElection("Lease", l.Namespace, l.Name, *l.Spec.HolderIdentity, renew, ttl, holders, now); ok {
+				ll = append(ll, le)
+			}
+		}
+	}
+
+	ee, err := dial.CoreV1().Endpoints(client.BlankNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to list endpoints: %w", err))
+	} else {
+		for i := range ee.Items {
+			e := &ee.Items[i]
+			raw, ok := e.Annotations[leaderAnnotation]
+			if !ok {
+				continue
+			}
+			var r leaderRecord
+			if err := json.Unmarshal([]byte(raw), &r); err != nil {
+				continue
+			}
+			if le, ok := leaderElection("Endpoints", e.Namespace, e.Name, r.HolderIdentity, r.RenewTime.Time, r.LeaseDurationSeconds, holders, now); ok {
+				ll = append(ll, le)
+			}
+		}
+	}
+
+	return ll, errors.Join(errs...)
+}
+
+// leaderElection checks if an active lock is held by one of the given holders.
+// Holder identities are commonly either a pod or host name optionally suffixed by _<uid>.
+func leaderElection(kind, ns, name, holder string, renew time.Time, ttl int, holders map[string]lockHolder, now time.Time) (LeaderElection, bool) {
+	if holder == "" {
+		return LeaderElection{}, false
+	}
+	if !renew.IsZero() && ttl > 0 && renew.Add(time.Duration(ttl)*time.Second).Before(now) {
+		return LeaderElection{}, false
+	}
+	id, _, _ := strings.Cut(holder, "_")
+	h, ok := holders[holder]
+	if !ok {
+		h, ok = holders[id]
+	}
+	if !ok {
+		return LeaderElection{}, false
+	}
+
+	return LeaderElection{Kind: kind, Namespace: ns, Name: name, Holder: holder, Node: h.node, Pod: h.pod}, true
+}
+
+// GetNodesByZone returns the cluster nodes grouped by topology zone. Nodes lacking
+// a zone label are grouped under NoZone.
+func (n *Node) GetNodesByZone(ctx context.Context) (map[string][]*v1.Node, error) {
+	nn, err := FetchNodes(ctx, n.getFactory(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesByZone(nn.Items), nil
+}
+
+// GetZoneNodes returns the given topology zone nodes sorted by name.
+func (n *Node) GetZoneNodes(ctx context.Context, zone string) ([]*v1.Node, error) {
+	if zone == NoZone {
+		zz, err := n.GetNodesByZone(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return zz[NoZone], nil
+	}
+	sel := labels.SelectorFromSet(labels.Set{v1.LabelTopologyZone: zone})
+	nn, err := FetchNodesBySelector(ctx, n.getFactory(), sel)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesByZone(nn.Items)[zone], nil
+}
+
+// ZoneAwareDrain drains all nodes in a zone one at a time. All the zone nodes are cordoned
+// upfront so evicted pods do not land on a node about to be drained. The disruption budgets
+// are re-evaluated before each node, the drain halts on the first node that would violate
+// one and waits for the budgets to recover before moving on to the next node.
+// Nodes already cordoned are left as is and the nodes cordoned by this run are reported
+// when the drain halts.
+func (n *Node) ZoneAwareDrain(zone string, opts DrainOptions, w io.Writer) (err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.Client().Config().CallTimeout())
+	defer cancel()
+	nn, err := n.GetZoneNodes(ctx, zone)
+	if err != nil {
+		return err
+	}
+	if len(nn) == 0 {
+		return fmt.Errorf("no nodes found in zone %q", zone)
+	}
+
+	_, _ = fmt.Fprintf(w, "Draining %d node(s) in zone %s\n", len(nn), zone)
+	var cordoned []string
+	defer func() {
+		if err != nil && len(cordoned) > 0 {
+			_, _ = fmt.Fprintf(w, "Nodes cordoned by this drain remain unschedulable: %s\n", strings.Join(cordoned, ","))
+			err = fmt.Errorf("%w (cordoned: %s)", err, strings.Join(cordoned, ","))
+		}
+	}()
+	if !opts.DryRun {
+		for _, no := range nn {
+			done, e := n.ensureCordoned(no.Name, opts.CacheTTL)
+			if e != nil {
+				return fmt.Errorf("zone %s cordon failed on node %s: %w", zone, no.Name, e)
+			}
+			if done {
+				_, _ = fmt.Fprintf(w, "[%s] already cordoned\n", no.Name)
+				continue
+			}
+			if e := n.toggleCordon(no.Name, true, opts.CacheTTL); e != nil {
+				return fmt.Errorf("zone %s cordon failed on node %s: %w", zone, no.Name, e)
+			}
+			cordoned = append(cordoned, no.Name)
+			_, _ = fmt.Fprintf(w, "[%s] cordoned\n", no.Name)
+		}
+	}
+
+	return n.drainZoneNodes(zone, nn, opts, w)
+}
+
+// drainZoneNodes drains the given zone nodes one at a time, waiting for the disruption
+// budgets covering each node evicted pods to recover before moving on to the next node.
+func (n *Node) drainZoneNodes(zone string, nn []*v1.Node, opts DrainOptions, w io.Writer) error {
+	for i, no := range nn {
+		sim, err := n.SimulateDrain(no.Name)
+		if err != nil {
+			return err
+		}
+		if len(sim.BlockedPods) > 0 {
+			for _, b := range sim.BlockedPods {
+				_, _ = fmt.Fprintf(w, "[%s] pod %s blocked by PDB %s (%d/%d disruptions)\n",
+					no.Name, b.Pod, b.PDBName, b.CurrentDisruptions, b.MaxDisruptions)
+			}
+			return fmt.Errorf("zone %s drain halted on node %s: %d pod(s) would violate a disruption budget (%d/%d nodes drained)",
+				zone, no.Name, len(sim.BlockedPods), i, len(nn))
+		}
+		if opts.DryRun {
+			_, _ = n.DrainDryRun(no.Name, opts, w)
+			continue
+		}
+		pp, err := n.GetPods(no.Name)
+		if err != nil {
+			return err
+		}
+		if err := n.Drain(no.Name, opts, w); err != nil {
+			return fmt.Errorf("zone %s drain failed on node %s: %w", zone, no.Name, err)
+		}
+		if i == len(nn)-1 {
+			break
+		}
+		_, _ = fmt.Fprintf(w, "[%s] waiting for disruption budgets to recover...\n", no.Name)
+		if err := waitForBudgets(context.Background(), coveredBudgets(n.listPDBs, pp), ZoneDrainSettleTimeout, zoneDrainSettlePoll); err != nil {
+			return fmt.Errorf("zone %s drain halted after node %s: %w (%d/%d nodes drained)", zone, no.Name, err, i+1, len(nn))
+		}
+	}
+	_, _ = fmt.Fprintf(w, "Zone %s drained!\n", zone)
+
+	return nil
+}
+
+// waitForBudgets polls the disruption budgets until they all report enough healthy pods.
+func waitForBudgets(ctx context.Context, list func() ([]*policyv1.PodDisruptionBudget, error), timeout, poll time.Duration) error {
+	var pending []string
+	err := wait.PollUntilContextTimeout(ctx, poll, timeout, true, func(context.Context) (bool, error) {
+		pdbs, err := list()
+		if err != nil {
+			return false, err
+		}
+		pending = pending[:0]
+		for _, pdb := range pdbs {
+			if !budgetSettled(pdb) {
+				pending = append(pending, client.FQN(pdb.Namespace, pdb.Name))
+			}
+		}
+
+		return len(pending) == 0, nil
+	})
+	if err != nil && len(pending) > 0 {
+		return fmt.Errorf("disruption budgets %s did not recover within %s", strings.Join(pending, ","), timeout)
+	}
+
+	return err
+}
+
+// coveredBudgets restricts the listed disruption budgets to the ones covering the given pods.
+func coveredBudgets(list func() ([]*policyv1.PodDisruptionBudget, error), pp []*v1.Pod) func() ([]*policyv1.PodDisruptionBudget, error) {
+	return func() ([]*policyv1.PodDisruptionBudget, error) {
+		pdbs, err := list()
+		if err != nil {
+			return nil, err
+		}
+
+		return coveringPDBs(pdbs, pp), nil
+	}
+}
+
+// budgetSettled checks if a disruption budget observed its latest spec and has
+// as many healthy pods as it desires.
+func budgetSettled(pdb *policyv1.PodDisruptionBudget) bool {
+	return pdb.Status.ObservedGeneration >= pdb.Generation &&
+		pdb.Status.CurrentHealthy >= pdb.Status.DesiredHealthy
+}
+
+// CheckKernelCVEs returns the known CVEs affecting a node kernel version.
+func (n *Node) CheckKernelCVEs(nodeName string, feed client.CVEFeed) ([]client.CVE, error) {
+	if feed == nil {
+		return nil, client.ErrNoCVEFeed
+	}
+	no, err := CachedFetchNode(context.Background(), n.Factory, nodeName, DefaultNodeCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return feed.KernelCVEs(no.Status.NodeInfo.KernelVersion)
+}
+
+// GPUCapacity represents the GPU resources of a node.
+type GPUCapacity struct {
+	Vendor      string
+	Total       int64
+	Allocatable int64
+	InUse       int64
+}
+
+// GetGPUCapacity returns the GPU capacity of a node along with the GPUs requested by its pods.
+func (n *Node) GetGPUCapacity(nodeName string) (*GPUCapacity, error) {
+	no, err := CachedFetchNode(context.Background(), n.Factory, nodeName, DefaultNodeCacheTTL)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := render.NodeGPU(no); !ok {
+		return nil, ErrNoGPUs
+	}
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodeGPUCapacity(no, pp)
+}
+
+func nodeGPUCapacity(no *v1.Node, pp []*v1.Pod) (*GPUCapacity, error) {
+	gpu, ok := render.NodeGPU(no)
+	if !ok {
+		return nil, ErrNoGPUs
+	}
+	rl := make(v1.ResourceList, len(requestedResources))
+	for _, po := range pp {
+		addPodRequests(rl, po)
+	}
+
+	return &GPUCapacity{
+		Vendor:      gpu.Vendor,
+		Total:       no.Status.Capacity.Name(gpu.Resource, resource.DecimalSI).Value(),
+		Allocatable: no.Status.Allocatable.Name(gpu.Resource, resource.DecimalSI).Value(),
+		InUse:       rl.Name(gpu.Resource, resource.DecimalSI).Value(),
+	}, nil
+}
+
+// gpuNodes returns the nodes advertising GPUs.
+func gpuNodes(oo []runtime.Object) []runtime.Object {
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if render.IsGPUNode(u) {
+			res = append(res, o)
+		}
+	}
+
+	return res
+}
+
+// GetUnsafeToEvictPods returns the pods on a node annotated as not safe to evict.
+func (n *Node) GetUnsafeToEvictPods(nodeName string) ([]string, error) {
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return UnsafeToEvict(pp), nil
+}
+
+// TopPods returns the top n pods running on a given node in the given namespace ranked
+// by CPU or memory usage. All pods are returned when n is not positive.
+func (n *Node) TopPods(nodeName, ns string, count int, sortBy ResourceField) ([]*PodWithMetrics, error) {
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	if !client.IsAllNamespaces(ns) {
+		pp = slices.DeleteFunc(pp, func(po *v1.Pod) bool {
+			return po.Namespace != ns
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.Client().Config().CallTimeout())
+	defer cancel()
+	mx, err := client.DialRetryingMetrics(n.Client()).FetchPodsMetricsMap(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return topPods(pp, mx, count, sortBy), nil
+}
+
+// GetPodsByPhase returns the pods running on a given node in any of the given phases.
+// All pods are returned when no phases are specified.
+func (n *Node) GetPodsByPhase(nodeName string, phases []v1.PodPhase) ([]*v1.Pod, error) {
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterPodsByPhase(pp, phases), nil
+}
+
+// GetSystemPods returns the kube-system and DaemonSet pods running on a given node.
+// These pods are not evicted by a regular drain.
+func (n *Node) GetSystemPods(nodeName string) ([]*v1.Pod, error) {
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterSystemPods(pp), nil
+}
+
+// reportNodesMetrics warns once when nodes metrics become unavailable and
+// notes when they recover, so a metrics server outage does not flood the logs.
+func reportNodesMetrics(err error, attrs ...any) {
+	if err == nil {
+		if nodesMetricsDown.Swap(false) {
+			slog.Info("Nodes metrics available again")
+		}
+		return
+	}
+	attrs = append(attrs, slogs.Error, err)
+	if nodesMetricsDown.Swap(true) {
+		slog.Debug("Unable to fetch nodes metrics", attrs...)
+		return
+	}
+	slog.Warn("Unable to fetch nodes metrics", attrs...)
+}
+
+// isLargeCluster uses the metrics server node count as a cluster size hint.
+func (n *Node) isLargeCluster(ctx context.Context) bool {
+	return cachedLargeCluster(n.Client().ActiveContext(), func() bool {
+		mx, err := client.DialMetrics(n.Client()).FetchNodesMetrics(ctx)
+		if err != nil || mx == nil {
+			return false
+		}
+
+		return len(mx.Items) >= largeClusterNodes
+	})
+}
+
+// cachedLargeCluster returns the cached cluster size hint for the given context
+// or computes it when missing or expired.
+func cachedLargeCluster(context string, large func() bool) bool {
+	if o, ok := clusterSizeCache.Get(context); ok {
+		if b, ok := o.(bool); ok {
+			return b
+		}
+	}
+	b := large()
+	clusterSizeCache.Add(context, b, clusterSizeTTL)
+
+	return b
+}
+
+// WatchReadinessChanges streams node Ready condition transitions until the context is canceled.
+// The out channel is closed once the watch completes.
+func (n *Node) WatchReadinessChanges(ctx context.Context, out chan<- NodeReadinessEvent) error {
+	defer close(out)
+
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	state := make(map[string]bool)
+	for {
+		w, err := dial.CoreV1().Nodes().Watch(ctx, metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		if err := watchReadiness(ctx, w, state, out); err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		slog.Debug("Node readiness watch closed. Restarting...")
+	}
+}
+
+// GetEvents returns the events related to the given node, most recent first.
+func (n *Node) GetEvents(ctx context.Context, nodeName string) ([]*v1.Event, error) {
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	sel := fields.SelectorFromSet(fields.Set{
+		"involvedObject.name": nodeName,
+		"involvedObject.kind": "Node",
+	})
+	ll, err := dial.CoreV1().Events(client.BlankNamespace).List(ctx, metav1.ListOptions{FieldSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]*v1.Event, 0, len(ll.Items))
+	for i := range ll.Items {
+		ee = append(ee, &ll.Items[i])
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return eventTime(ee[j]).Before(eventTime(ee[i]))
+	})
+
+	return ee, nil
+}
+
+// GetConditionHistory returns the given node condition transitions reported by events,
+// most recent first. A blank condition returns the transitions of all conditions.
+func (n *Node) GetConditionHistory(nodeName string, condition v1.NodeConditionType) ([]ConditionEvent, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.Client().Config().CallTimeout())
+	defer cancel()
+
+	ee, err := n.GetEvents(ctx, nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return conditionEvents(ee, condition), nil
+}
+
+// Snapshot writes the node, its events and its pods to destPath as a multi-document YAML file.
+func (n *Node) Snapshot(ctx context.Context, nodeName, destPath string) error {
+	no, err := FetchNode(ctx, n.Factory, nodeName)
+	if err != nil {
+		return err
+	}
+	ee, err := n.GetEvents(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+	pp, err := n.GetPods(nodeName)
+	if err != nil {
+		return err
+	}
+
+	oo := make([]runtime.Object, 0, 1+len(ee)+len(pp))
+	oo = append(oo, no)
+	for _, e := range ee {
+		oo = append(oo, e)
+	}
+	for _, po := range pp {
+		oo = append(oo, po)
+	}
+	bb, err := snapshotYAML(oo)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(destPath, bb, 0600)
+}
+
+// ensureCordoned returns whether the given node has been cordoned
+func (n *Node) ensureCordoned(path string, ttl time.Duration) (bool, error) {
+	o, err := CachedFetchNode(context.Background(), n.Factory, path, ttl)
+	if err != nil {
+		return false, err
+	}
+
+	return o.Spec.Unschedulable, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// String returns a drain event representation.
+func (e DrainEvent) String() string {
+	if e.PodName == "" {
+		return fmt.Sprintf("%s -- %s", e.Phase, e.Err)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("[%s] %s -- %s", client.FQN(e.Namespace, e.PodName), e.Phase, e.Err)
+	}
+
+	return fmt.Sprintf("[%s] %s", client.FQN(e.Namespace, e.PodName), e.Phase)
+}
+
+// snapshotYAML serializes the given objects as a multi-document YAML stream.
+// Type information is restored and managed fields are omitted.
+func snapshotYAML(oo []runtime.Object) ([]byte, error) {
+	var buff bytes.Buffer
+	for i, o := range oo {
+		o = o.DeepCopyObject()
+		gvks, _, err := scheme.Scheme.ObjectKinds(o)
+		if err != nil {
+			return nil, err
+		}
+		o.GetObjectKind().SetGroupVersionKind(gvks[0])
+		if m, err := meta.Accessor(o); err == nil {
+			m.SetManagedFields(nil)
+		}
+		bb, err := yaml.Marshal(o)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buff.WriteString("---\n")
+		}
+		buff.Write(bb)
+	}
+
+	return buff.Bytes(), nil
+}
+
+// evictionSupported checks whether the api server advertises the pods eviction
+// subresource. Evictions are assumed supported when discovery fails so PDBs are
+// never bypassed by mistake.
+func evictionSupported(dial kubernetes.Interface) bool {
+	gv, err := drain.CheckEvictionSupport(dial)
+	if err != nil {
+		slog.Warn("Eviction support check failed", slogs.Error, err)
+		return true
+	}
+
+	return !gv.Empty()
+}
+
+// watchReadiness tracks nodes readiness and emits an event whenever a known node Ready condition flips.
+// It returns once the watch channel closes or the context is canceled.
+func watchReadiness(ctx context.Context, w watch.Interface, state map[string]bool, out chan<- NodeReadinessEvent) error {
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case evt, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			//nolint:exhaustive
+			switch evt.Type {
+			case watch.Error:
+				return kerrors.FromObject(evt.Object)
+			case watch.Deleted:
+				if no, ok := evt.Object.(*v1.Node); ok {
+					delete(state, no.Name)
+				}
+			case watch.Added, watch.Modified:
+				no, ok := evt.Object.(*v1.Node)
+				if !ok {
+					continue
+				}
+				ready := isNodeReady(no)
+				old, known := state[no.Name]
+				state[no.Name] = ready
+				if !known || old == ready {
+					continue
+				}
+				select {
+				case out <- NodeReadinessEvent{Name: no.Name, OldReady: old, NewReady: ready, Timestamp: time.Now()}:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		}
 	}
+}
 
-	return o.Spec.Unschedulable, nil
+func isNodeReady(no *v1.Node) bool {
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
 }
 
-// ----------------------------------------------------------------------------
-// Helpers...
+// orderPods splits the pods to drain into successive eviction tiers.
+// Each tier must be fully evicted before the next one starts. Since the drain
+// timeout applies per tier, a PDB blocking an eviction stalls its tier until the
+// timeout expires and aborts the drain, leaving the remaining tiers untouched.
+func orderPods(pp []v1.Pod, order EvictionOrder) [][]v1.Pod {
+	var key func(po *v1.Pod) string
+	switch order {
+	case EvictionOrderPriority:
+		sort.SliceStable(pp, func(i, j int) bool {
+			return podPriority(&pp[i]) < podPriority(&pp[j])
+		})
+		key = func(po *v1.Pod) string {
+			return strconv.Itoa(int(podPriority(po)))
+		}
+	case EvictionOrderNamespace:
+		sort.SliceStable(pp, func(i, j int) bool {
+			return pp[i].Namespace < pp[j].Namespace
+		})
+		key = func(po *v1.Pod) string {
+			return po.Namespace
+		}
+	default:
+		if len(pp) == 0 {
+			return nil
+		}
+		return [][]v1.Pod{pp}
+	}
+
+	var (
+		tiers [][]v1.Pod
+		last  string
+	)
+	for i := range pp {
+		k := key(&pp[i])
+		if len(tiers) == 0 || k != last {
+			tiers = append(tiers, nil)
+			last = k
+		}
+		tiers[len(tiers)-1] = append(tiers[len(tiers)-1], pp[i])
+	}
+
+	return tiers
+}
+
+// chunkPods splits each pods group into chunks of at most size pods.
+// Groups are left untouched when size is not positive.
+func chunkPods(gg [][]v1.Pod, size int) [][]v1.Pod {
+	if size <= 0 {
+		return gg
+	}
+
+	cc := make([][]v1.Pod, 0, len(gg))
+	for _, g := range gg {
+		cc = slices.AppendSeq(cc, slices.Chunk(g, size))
+	}
+
+	return cc
+}
+
+func podPriority(po *v1.Pod) int32 {
+	if po.Spec.Priority == nil {
+		return 0
+	}
+
+	return *po.Spec.Priority
+}
+
+// nodeUtilization computes the node cpu/mem usage percentages against its allocatable resources.
+func nodeUtilization(u *unstructured.Unstructured, mx *mv1beta1.NodeMetrics) (MetricsSample, bool) {
+	alloc, _, _ := unstructured.NestedStringMap(u.Object, "status", "allocatable")
+	cpu, err := resource.ParseQuantity(alloc[string(v1.ResourceCPU)])
+	if err != nil {
+		return MetricsSample{}, false
+	}
+	mem, err := resource.ParseQuantity(alloc[string(v1.ResourceMemory)])
+	if err != nil {
+		return MetricsSample{}, false
+	}
+
+	return MetricsSample{
+		CPU: client.ToPercentage(mx.Usage.Cpu().MilliValue(), cpu.MilliValue()),
+		MEM: client.ToPercentage(mx.Usage.Memory().Value(), mem.Value()),
+	}, true
+}
+
+// listNodePods lists the pods scheduled on the given node using a field selector.
+func listNodePods(ctx context.Context, dial kubernetes.Interface, nodeName string) ([]*v1.Pod, error) {
+	sel := fields.OneTermEqualSelector("spec.nodeName", nodeName)
+	ll, err := dial.CoreV1().Pods(client.BlankNamespace).List(ctx, metav1.ListOptions{FieldSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]*v1.Pod, 0, len(ll.Items))
+	for i := range ll.Items {
+		pp = append(pp, &ll.Items[i])
+	}
+
+	return pp, nil
+}
+
+// filterNodePods returns the pods scheduled on the given node.
+func filterNodePods(oo []runtime.Object, nodeName string) ([]*v1.Pod, error) {
+	pp := make([]*v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got `%T", o)
+		}
+		if node, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName"); node != nodeName {
+			continue
+		}
+		po := new(v1.Pod)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+
+	return pp, nil
+}
+
+func filterSystemPods(pp []*v1.Pod) []*v1.Pod {
+	return slices.DeleteFunc(pp, func(po *v1.Pod) bool {
+		return !IsSystemPod(po)
+	})
+}
+
+// IsSystemPod returns true if the pod runs in kube-system or is owned by a DaemonSet.
+func IsSystemPod(po *v1.Pod) bool {
+	if po.Namespace == metav1.NamespaceSystem {
+		return true
+	}
+	ref := metav1.GetControllerOf(po)
+
+	return ref != nil && ref.Kind == "DaemonSet"
+}
+
+// topPods joins pods with their metrics and returns the top n ranked by the given resource.
+// Pods without metrics rank last.
+func topPods(pp []*v1.Pod, mx client.PodsMetricsMap, n int, sortBy ResourceField) []*PodWithMetrics {
+	res := make([]*PodWithMetrics, 0, len(pp))
+	for _, po := range pp {
+		pwm := PodWithMetrics{Pod: po, MX: mx[client.FQN(po.Namespace, po.Name)]}
+		if pwm.MX != nil {
+			for _, co := range pwm.MX.Containers {
+				pwm.CPU += co.Usage.Cpu().MilliValue()
+				pwm.MEM += co.Usage.Memory().Value()
+			}
+		}
+		res = append(res, &pwm)
+	}
+
+	slices.SortStableFunc(res, func(a, b *PodWithMetrics) int {
+		if sortBy == ResourceMEM {
+			return cmp.Compare(b.MEM, a.MEM)
+		}
+		return cmp.Compare(b.CPU, a.CPU)
+	})
+	if n > 0 && len(res) > n {
+		res = res[:n]
+	}
+
+	return res
+}
+
+// mergeSelectors adds the given label selector requirements to a base selector.
+// Invalid label selectors are ignored.
+func mergeSelectors(base labels.Selector, strLabel string) labels.Selector {
+	if strLabel == "" {
+		return base
+	}
+	sel, err := labels.Parse(strLabel)
+	if err != nil {
+		return base
+	}
+	rr, _ := sel.Requirements()
+
+	return base.Add(rr...)
+}
+
+// labelsPatch builds a patch turning the old labels into the new ones.
+func labelsPatch(old, ll map[string]string) ([]byte, error) {
+	patch := make(map[string]any, len(ll))
+	for k, v := range ll {
+		patch[k] = v
+	}
+	for k := range old {
+		if _, ok := ll[k]; !ok {
+			patch[k] = nil
+		}
+	}
+
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"labels": patch,
+		},
+	})
+}
+
+// podRequestsCache tracks the pods resource requests per pod revision so a pod
+// is only converted once across list cycles.
+var podRequestsCache = struct {
+	sync.Mutex
+	entries map[types.UID]podRequestsEntry
+}{
+	entries: make(map[types.UID]podRequestsEntry),
+}
+
+type podRequestsEntry struct {
+	rev, node string
+	requests  v1.ResourceList
+}
+
+// nodesRequests sums up the pods resource requests per node.
+func nodesRequests(oo []runtime.Object) (map[string]v1.ResourceList, error) {
+	podRequestsCache.Lock()
+	defer podRequestsCache.Unlock()
+
+	res := make(map[string]v1.ResourceList)
+	seen := make(map[types.UID]struct{}, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *Unstructured but got `%T", o)
+		}
+		e, err := cachedPodRequests(u)
+		if err != nil {
+			return res, err
+		}
+		seen[u.GetUID()] = struct{}{}
+		if e.node == "" {
+			continue
+		}
+		rl, ok := res[e.node]
+		if !ok {
+			rl = make(v1.ResourceList, 2)
+			res[e.node] = rl
+		}
+		addRequests(rl, e.requests)
+	}
+	for uid := range podRequestsCache.entries {
+		if _, ok := seen[uid]; !ok {
+			delete(podRequestsCache.entries, uid)
+		}
+	}
+
+	return res, nil
+}
+
+// cachedPodRequests returns the pod requests, converting the pod only when its revision changed.
+// The cache lock must be held by the caller.
+func cachedPodRequests(u *unstructured.Unstructured) (podRequestsEntry, error) {
+	uid, rev := u.GetUID(), u.GetResourceVersion()
+	if e, ok := podRequestsCache.entries[uid]; ok && uid != "" && rev != "" && e.rev == rev {
+		return e, nil
+	}
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return podRequestsEntry{}, err
+	}
+	e := podRequestsEntry{rev: rev, node: po.Spec.NodeName, requests: podRequests(&po)}
+	if uid != "" {
+		podRequestsCache.entries[uid] = e
+	}
+
+	return e, nil
+}
+
+// addPodRequests adds the cpu/mem/gpu requests of an active pod to the given list.
+func addPodRequests(rl v1.ResourceList, po *v1.Pod) {
+	addRequests(rl, podRequests(po))
+}
+
+func addRequests(rl, req v1.ResourceList) {
+	for r, q := range req {
+		total := rl[r]
+		total.Add(q)
+		rl[r] = total
+	}
+}
+
+// podRequests returns the effective cpu/mem/gpu requests of an active pod.
+// Init containers run ahead of the app containers, so only the largest of their
+// requests counts, unless they are sidecars running alongside the app containers.
+// Containers without requests fall back to their limits.
+func podRequests(po *v1.Pod) v1.ResourceList {
+	rl := make(v1.ResourceList, len(requestedResources))
+	if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+		return rl
+	}
+	for i := range po.Spec.Containers {
+		addRequests(rl, containerRequests(&po.Spec.Containers[i]))
+	}
+	initMax := make(v1.ResourceList, len(requestedResources))
+	for i := range po.Spec.InitContainers {
+		co := &po.Spec.InitContainers[i]
+		if co.RestartPolicy != nil && *co.RestartPolicy == v1.ContainerRestartPolicyAlways {
+			addRequests(rl, containerRequests(co))
+			continue
+		}
+		for r, q := range containerRequests(co) {
+			if q.Cmp(initMax[r]) > 0 {
+				initMax[r] = q
+			}
+		}
+	}
+	for r, q := range initMax {
+		if q.Cmp(rl[r]) > 0 {
+			rl[r] = q
+		}
+	}
+
+	return rl
+}
+
+func containerRequests(co *v1.Container) v1.ResourceList {
+	req := co.Resources.Requests
+	if len(req) == 0 {
+		req = co.Resources.Limits
+	}
+	rl := make(v1.ResourceList, len(requestedResources))
+	for _, r := range requestedResources {
+		if q, ok := req[r]; ok {
+			rl[r] = q
+		}
+	}
+
+	return rl
+}
+
+func (n *Node) patchAnnotations(fqn string, aa map[string]any) error {
+	_, name := client.Namespaced(fqn)
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": aa,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	before, err := dial.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	after, err := dial.CoreV1().Nodes().Patch(
+		context.Background(),
+		name,
+		types.MergePatchType,
+		patch,
+		metav1.PatchOptions{},
+	)
+	n.invalidateNode(fqn)
+	if err != nil {
+		return err
+	}
+	recordAnnotationChanges(fqn, AnnotationDiff(before, after))
+
+	return nil
+}
+
+// AnnotationChanges returns the annotation changes k9s made on the given node this session.
+func (*Node) AnnotationChanges(fqn string) []AnnotationChange {
+	annotationChanges.RLock()
+	defer annotationChanges.RUnlock()
+
+	return slices.Clone(annotationChanges.changes[fqn])
+}
+
+func recordAnnotationChanges(fqn string, cc []AnnotationChange) {
+	if len(cc) == 0 {
+		return
+	}
+	annotationChanges.Lock()
+	defer annotationChanges.Unlock()
+
+	annotationChanges.changes[fqn] = append(annotationChanges.changes[fqn], cc...)
+}
+
+// AnnotationDiff returns the annotation changes between two node revisions sorted by key.
+func AnnotationDiff(before, after *v1.Node) []AnnotationChange {
+	var old, cur map[string]string
+	if before != nil {
+		old = before.Annotations
+	}
+	if after != nil {
+		cur = after.Annotations
+	}
+
+	cc := make([]AnnotationChange, 0, len(cur))
+	for k, v := range cur {
+		ov, ok := old[k]
+		switch {
+		case !ok:
+			cc = append(cc, AnnotationChange{Key: k, NewValue: v, Op: AnnotationAdd})
+		case ov != v:
+			cc = append(cc, AnnotationChange{Key: k, OldValue: ov, NewValue: v, Op: AnnotationUpdate})
+		}
+	}
+	for k, v := range old {
+		if _, ok := cur[k]; !ok {
+			cc = append(cc, AnnotationChange{Key: k, OldValue: v, Op: AnnotationRemove})
+		}
+	}
+	slices.SortFunc(cc, func(a, b AnnotationChange) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return cc
+}
+
+func hasCordonReason(no *v1.Node) bool {
+	_, ok1 := no.Annotations[CordonReasonAnnotation]
+	_, ok2 := no.Annotations[CordonTimeAnnotation]
+
+	return ok1 || ok2
+}
+
+// eventTime returns the most relevant timestamp for an event.
+func eventTime(e *v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+// conditionEvents extracts the node condition transitions from the given events.
+// The transition is read off the event message, falling back to the event reason.
+func conditionEvents(ee []*v1.Event, condition v1.NodeConditionType) []ConditionEvent {
+	cc := make([]ConditionEvent, 0, len(ee))
+	for _, e := range ee {
+		reason := e.Reason
+		if _, s, ok := strings.Cut(e.Message, nodeStatusPrefix); ok {
+			reason = strings.TrimSpace(s)
+		}
+		r, ok := nodeConditionReasons[reason]
+		if !ok || (condition != "" && r.kind != condition) {
+			continue
+		}
+		cc = append(cc, ConditionEvent{
+			Condition: r.kind,
+			Timestamp: eventTime(e),
+			Status:    r.status,
+			Reason:    reason,
+			Message:   strings.TrimSpace(e.Message),
+		})
+	}
+	slices.SortStableFunc(cc, func(a, b ConditionEvent) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+
+	return cc
+}
+
+// coveringPDBs returns the PDBs whose selector matches at least one of the given pods.
+// PDBs without a selector protect no pods.
+func coveringPDBs(pdbs []*policyv1.PodDisruptionBudget, pp []*v1.Pod) []*policyv1.PodDisruptionBudget {
+	cc := make([]*policyv1.PodDisruptionBudget, 0, len(pdbs))
+	for _, pdb := range pdbs {
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if slices.ContainsFunc(pp, func(po *v1.Pod) bool {
+			return po.Namespace == pdb.Namespace && sel.Matches(labels.Set(po.Labels))
+		}) {
+			cc = append(cc, pdb)
+		}
+	}
+	slices.SortFunc(cc, func(a, b *policyv1.PodDisruptionBudget) int {
+		return strings.Compare(client.FQN(a.Namespace, a.Name), client.FQN(b.Namespace, b.Name))
+	})
+
+	return cc
+}
+
+// simulateDrain evicts the given pods one at a time, tracking the disruptions each PDB
+// incurs along the way. DaemonSet and terminated pods are skipped as they are not evicted.
+func simulateDrain(pp []*v1.Pod, pdbs []*policyv1.PodDisruptionBudget) *DrainSimulation {
+	type budget struct {
+		pdb     *policyv1.PodDisruptionBudget
+		sel     labels.Selector
+		current int32
+		max     int32
+	}
+	bb := make([]*budget, 0, len(pdbs))
+	for _, pdb := range pdbs {
+		if pdb.Spec.Selector == nil {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		bb = append(bb, &budget{
+			pdb:     pdb,
+			sel:     sel,
+			current: pdb.Status.ExpectedPods - pdb.Status.CurrentHealthy,
+			max:     pdb.Status.ExpectedPods - pdb.Status.DesiredHealthy,
+		})
+	}
+
+	pp = slices.Clone(pp)
+	slices.SortFunc(pp, func(a, b *v1.Pod) int {
+		return strings.Compare(client.FQN(a.Namespace, a.Name), client.FQN(b.Namespace, b.Name))
+	})
+	sim := DrainSimulation{
+		BlockedPods: make([]BlockedPod, 0),
+		SafePods:    make([]string, 0, len(pp)),
+	}
+	for _, po := range pp {
+		if ref := metav1.GetControllerOf(po); ref != nil && ref.Kind == "DaemonSet" {
+			continue
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		fqn := client.FQN(po.Namespace, po.Name)
+		matches := make([]*budget, 0, len(bb))
+		var blocker *budget
+		for _, b := range bb {
+			if b.pdb.Namespace != po.Namespace || !b.sel.Matches(labels.Set(po.Labels)) {
+				continue
+			}
+			matches = append(matches, b)
+			if blocker == nil && b.current >= b.max {
+				blocker = b
+			}
+		}
+		if blocker != nil {
+			sim.BlockedPods = append(sim.BlockedPods, BlockedPod{
+				Pod:                fqn,
+				PDBName:            client.FQN(blocker.pdb.Namespace, blocker.pdb.Name),
+				CurrentDisruptions: blocker.current,
+				MaxDisruptions:     blocker.max,
+			})
+			continue
+		}
+		for _, b := range matches {
+			b.current++
+		}
+		sim.SafePods = append(sim.SafePods, fqn)
+	}
+
+	return &sim
+}
+
+// pdbConflicts checks whether evicting the given pods would violate a PodDisruptionBudget.
+func pdbConflicts(dial kubernetes.Interface, pp []v1.Pod) []error {
+	byNS := make(map[string][]v1.Pod)
+	for _, po := range pp {
+		byNS[po.Namespace] = append(byNS[po.Namespace], po)
+	}
+
+	var errs []error
+	for ns, pods := range byNS {
+		ll, err := dial.PolicyV1().PodDisruptionBudgets(ns).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for i := range ll.Items {
+			pdb := &ll.Items[i]
+			sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			if err != nil || sel.Empty() {
+				continue
+			}
+			var count int32
+			for _, po := range pods {
+				if sel.Matches(labels.Set(po.Labels)) {
+					count++
+				}
+			}
+			if count > pdb.Status.DisruptionsAllowed {
+				errs = append(errs, fmt.Errorf("pdb %s allows %d disruption(s) but %d pod(s) would be evicted",
+					client.FQN(ns, pdb.Name), pdb.Status.DisruptionsAllowed, count))
+			}
+		}
+	}
+
+	return errs
+}
+
+// CachedFetchNode retrieves a node, reusing a copy fetched within the given ttl
+// from the active cluster context.
+func CachedFetchNode(ctx context.Context, f Factory, path string, ttl time.Duration) (*v1.Node, error) {
+	key := nodeCacheKey{context: f.Client().ActiveContext(), fqn: path}
+	if o, ok := nodeCache.Get(key); ok {
+		if no, ok := o.(*v1.Node); ok {
+			return no.DeepCopy(), nil
+		}
+	}
+
+	no, err := FetchNode(ctx, f, path)
+	if err != nil {
+		return nil, err
+	}
+	if ttl <= 0 {
+		ttl = DefaultNodeCacheTTL
+	}
+	nodeCache.Add(key, no.DeepCopy(), ttl)
+
+	return no, nil
+}
+
+func (n *Node) invalidateNode(path string) {
+	nodeCache.Remove(nodeCacheKey{context: n.Client().ActiveContext(), fqn: path})
+}
 
 // FetchNode retrieves a node.
 func FetchNode(_ context.Context, f Factory, path string) (*v1.Node, error) {
@@ -284,7 +2574,12 @@ func FetchNode(_ context.Context, f Factory, path string) (*v1.Node, error) {
 }
 
 // FetchNodes retrieves all nodes.
-func FetchNodes(_ context.Context, f Factory, _ string) (*v1.NodeList, error) {
+func FetchNodes(ctx context.Context, f Factory, _ string) (*v1.NodeList, error) {
+	return FetchNodesBySelector(ctx, f, labels.Everything())
+}
+
+// FetchNodesBySelector retrieves all nodes matching the given label selector.
+func FetchNodesBySelector(_ context.Context, f Factory, sel labels.Selector) (*v1.NodeList, error) {
 	auth, err := f.Client().CanI(client.ClusterScope, client.NodeGVR, "", client.ListAccess)
 	if err != nil {
 		return nil, err
@@ -293,7 +2588,7 @@ func FetchNodes(_ context.Context, f Factory, _ string) (*v1.NodeList, error) {
 		return nil, fmt.Errorf("user is not authorized to list nodes")
 	}
 
-	oo, err := f.List(client.NodeGVR, "", false, labels.Everything())
+	oo, err := f.List(client.NodeGVR, "", false, sel)
 	if err != nil {
 		return nil, err
 	}
@@ -309,3 +2604,38 @@ func FetchNodes(_ context.Context, f Factory, _ string) (*v1.NodeList, error) {
 
 	return &v1.NodeList{Items: nn}, nil
 }
+
+// nodesByZone groups nodes by topology zone sorted by name.
+func nodesByZone(nn []v1.Node) map[string][]*v1.Node {
+	zz := make(map[string][]*v1.Node)
+	for i := range nn {
+		zone, ok := nn[i].Labels[v1.LabelTopologyZone]
+		if !ok || zone == "" {
+			zone = NoZone
+		}
+		zz[zone] = append(zz[zone], &nn[i])
+	}
+	for _, no := range zz {
+		slices.SortFunc(no, func(a, b *v1.Node) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+
+	return zz
+}
+
+// NodeArchitectures returns the sorted cpu architectures found across all nodes.
+func NodeArchitectures(ctx context.Context, f Factory) ([]string, error) {
+	nn, err := FetchNodes(ctx, f, "")
+	if err != nil {
+		return nil, err
+	}
+	aa := sets.New[string]()
+	for i := range nn.Items {
+		if a := render.NodeArch(&nn.Items[i]); a != "" {
+			aa.Insert(a)
+		}
+	}
+
+	return sets.List(aa), nil
+}
@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultBenchmarkImage tracks the node benchmark stress image.
+	DefaultBenchmarkImage = "polinux/stress"
+
+	// DefaultBenchmarkDuration tracks how long a node benchmark stresses the node.
+	DefaultBenchmarkDuration = 30 * time.Second
+
+	benchmarkPodPrefix     = "k9s-benchmark-"
+	benchmarkContainer     = "stress"
+	benchmarkStartTimeout  = 2 * time.Minute
+	defaultBenchmarkSample = 5 * time.Second
+)
+
+// BenchmarkOptions tracks a node benchmark settings.
+type BenchmarkOptions struct {
+	// Namespace where the stress pod runs.
+	Namespace string
+
+	// Image is the stress image. Defaults to polinux/stress.
+	Image string
+
+	// CPUWorkers tracks the number of stress cpu workers.
+	CPUWorkers int
+
+	// IOWorkers tracks the number of stress io workers.
+	IOWorkers int
+
+	// Duration tracks how long the node is stressed.
+	Duration time.Duration
+
+	// SampleInterval tracks how often node metrics are sampled during the benchmark.
+	SampleInterval time.Duration
+
+	// Out streams the stress pod output when set.
+	Out io.Writer
+}
+
+// BenchmarkResult tracks a node benchmark CPU impact in millicores.
+type BenchmarkResult struct {
+	Node        string
+	Duration    time.Duration
+	BaselineCPU int64
+	MinCPU      int64
+	MaxCPU      int64
+	AvgCPU      int64
+	Samples     int
+}
+
+// Benchmark stresses a node cpu and io using a temporary pod and reports
+// the node CPU usage impact. The stress pod is deleted once the benchmark completes.
+func (n *Node) Benchmark(nodeName string, opts BenchmarkOptions) (_ *BenchmarkResult, err error) {
+	if err := ReadonlyGuard(); err != nil {
+		return nil, err
+	}
+	opts = opts.withDefaults()
+	if nodeName == "" {
+		return nil, errors.New("benchmark requires a node name")
+	}
+	defer func() {
+		auditAction(n.getFactory(), client.NodeGVR, nodeName, "benchmark", map[string]any{"duration": opts.Duration.String()}, err)
+	}()
+	dial, err := n.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	mxDial, err := n.Client().MXDial()
+	if err != nil {
+		return nil, err
+	}
+	sampleFn := func(ctx context.Context) (int64, error) {
+		mx, err := mxDial.MetricsV1beta1().NodeMetricses().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			return 0, err
+		}
+		return mx.Usage.Cpu().MilliValue(), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), opts.Duration+benchmarkStartTimeout)
+	defer cancel()
+
+	no, err := dial.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := sampleFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sample node %s metrics: %w", nodeName, err)
+	}
+
+	po, err := dial.CoreV1().Pods(opts.Namespace).Create(ctx, benchmarkPod(nodeHostname(no), opts), metav1.CreateOptions{})
+	if err != nil {
+		auditAction(n.getFactory(), client.PodGVR, client.FQN(opts.Namespace, benchmarkPodPrefix), "create", map[string]any{"node": nodeName}, err)
+		return nil, err
+	}
+	auditAction(n.getFactory(), client.PodGVR, client.FQN(po.Namespace, po.Name), "create", map[string]any{"node": nodeName}, nil)
+	defer n.deleteBenchmarkPod(dial, po)
+
+	if err := waitForPodStart(ctx, dial, po); err != nil {
+		return nil, err
+	}
+	go streamBenchmarkLogs(ctx, dial, po, opts.Out)
+
+	var samples []int64
+	start := time.Now()
+	err = wait.PollUntilContextTimeout(ctx, opts.SampleInterval, opts.Duration+opts.SampleInterval, false, func(ctx context.Context) (bool, error) {
+		if cpu, err := sampleFn(ctx); err == nil {
+			samples = append(samples, cpu)
+		}
+		p, err := dial.CoreV1().Pods(po.Namespace).Get(ctx, po.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		return p.Status.Phase == v1.PodSucceeded || p.Status.Phase == v1.PodFailed, nil
+	})
+	if err != nil && !wait.Interrupted(err) {
+		return nil, err
+	}
+	if len(samples) == 0 {
+		return nil, fmt.Errorf("no metrics collected for node %s", nodeName)
+	}
+
+	res := benchmarkStats(baseline, samples)
+	res.Node, res.Duration = nodeName, time.Since(start)
+
+	return &res, nil
+}
+
+func (o BenchmarkOptions) withDefaults() BenchmarkOptions {
+	if o.Namespace == "" {
+		o.Namespace = client.DefaultNamespace
+	}
+	if o.Image == "" {
+		o.Image = DefaultBenchmarkImage
+	}
+	if o.CPUWorkers <= 0 && o.IOWorkers <= 0 {
+		o.CPUWorkers, o.IOWorkers = 1, 1
+	}
+	if o.Duration <= 0 {
+		o.Duration = DefaultBenchmarkDuration
+	}
+	if o.SampleInterval <= 0 {
+		o.SampleInterval = defaultBenchmarkSample
+	}
+	if o.Out == nil {
+		o.Out = io.Discard
+	}
+
+	return o
+}
+
+// benchmarkPod returns a stress pod pinned to the node with the given hostname.
+func benchmarkPod(hostname string, opts BenchmarkOptions) *v1.Pod {
+	var grace int64
+	args := make([]string, 0, 6)
+	if opts.CPUWorkers > 0 {
+		args = append(args, "--cpu", strconv.Itoa(opts.CPUWorkers))
+	}
+	if opts.IOWorkers > 0 {
+		args = append(args, "--io", strconv.Itoa(opts.IOWorkers))
+	}
+	args = append(args, "--timeout", strconv.Itoa(int(opts.Duration.Seconds()))+"s")
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: benchmarkPodPrefix,
+			Namespace:    opts.Namespace,
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": "k9s"},
+		},
+		Spec: v1.PodSpec{
+			NodeSelector:                  map[string]string{v1.LabelHostname: hostname},
+			RestartPolicy:                 v1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: &grace,
+			Tolerations:                   []v1.Toleration{{Operator: v1.TolerationOpExists}},
+			Containers: []v1.Container{
+				{
+					Name:    benchmarkContainer,
+					Image:   opts.Image,
+					Command: []string{"stress"},
+					Args:    args,
+				},
+			},
+		},
+	}
+}
+
+// benchmarkStats computes the CPU impact of the given samples over a baseline.
+func benchmarkStats(baseline int64, samples []int64) BenchmarkResult {
+	res := BenchmarkResult{BaselineCPU: baseline, Samples: len(samples)}
+	if len(samples) == 0 {
+		return res
+	}
+
+	var total int64
+	for i, s := range samples {
+		d := max(s-baseline, 0)
+		if i == 0 {
+			res.MinCPU, res.MaxCPU = d, d
+		}
+		res.MinCPU, res.MaxCPU = min(res.MinCPU, d), max(res.MaxCPU, d)
+		total += d
+	}
+	res.AvgCPU = total / int64(len(samples))
+
+	return res
+}
+
+func waitForPodStart(ctx context.Context, dial kubernetes.Interface, po *v1.Pod) error {
+	return wait.PollUntilContextTimeout(ctx, time.Second, benchmarkStartTimeout, true, func(ctx context.Context) (bool, error) {
+		p, err := dial.CoreV1().Pods(po.Namespace).Get(ctx, po.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case v1.PodFailed:
+			return false, fmt.Errorf("benchmark pod %s failed", client.FQN(p.Namespace, p.Name))
+		case v1.PodRunning, v1.PodSucceeded:
+			return true, nil
+		default:
+			return false, nil
+		}
+	})
+}
+
+func streamBenchmarkLogs(ctx context.Context, dial kubernetes.Interface, po *v1.Pod, w io.Writer) {
+	req := dial.CoreV1().Pods(po.Namespace).GetLogs(po.Name, &v1.PodLogOptions{Container: benchmarkContainer, Follow: true})
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		slog.Warn("Unable to stream benchmark logs", slogs.Error, err)
+		return
+	}
+	defer stream.Close()
+	_, _ = io.Copy(w, stream)
+}
+
+func (n *Node) deleteBenchmarkPod(dial kubernetes.Interface, po *v1.Pod) {
+	ctx, cancel := context.WithTimeout(context.Background(), benchmarkStartTimeout)
+	defer cancel()
+
+	err := dial.CoreV1().Pods(po.Namespace).Delete(ctx, po.Name, metav1.DeleteOptions{})
+	auditAction(n.getFactory(), client.PodGVR, client.FQN(po.Namespace, po.Name), "delete", nil, err)
+	if err != nil {
+		slog.Warn("Unable to delete benchmark pod",
+			slogs.FQN, client.FQN(po.Namespace, po.Name),
+			slogs.Error, err,
+		)
+	}
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestBenchmarkStats(t *testing.T) {
+	uu := map[string]struct {
+		baseline int64
+		samples  []int64
+		e        BenchmarkResult
+	}{
+		"none": {
+			baseline: 100,
+			e:        BenchmarkResult{BaselineCPU: 100},
+		},
+		"samples": {
+			baseline: 100,
+			samples:  []int64{300, 1100, 500},
+			e:        BenchmarkResult{BaselineCPU: 100, MinCPU: 200, MaxCPU: 1000, AvgCPU: 533, Samples: 3},
+		},
+		"below-baseline": {
+			baseline: 500,
+			samples:  []int64{400, 700},
+			e:        BenchmarkResult{BaselineCPU: 500, MaxCPU: 200, AvgCPU: 100, Samples: 2},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, benchmarkStats(u.baseline, u.samples))
+		})
+	}
+}
+
+func TestBenchmarkPod(t *testing.T) {
+	opts := BenchmarkOptions{CPUWorkers: 2}.withDefaults()
+	po := benchmarkPod("host-1", opts)
+
+	assert.Equal(t, "default", po.Namespace)
+	assert.Equal(t, map[string]string{v1.LabelHostname: "host-1"}, po.Spec.NodeSelector)
+	assert.Equal(t, v1.RestartPolicyNever, po.Spec.RestartPolicy)
+	assert.Len(t, po.Spec.Containers, 1)
+	assert.Equal(t, DefaultBenchmarkImage, po.Spec.Containers[0].Image)
+	assert.Equal(t, []string{"--cpu", "2", "--timeout", "30s"}, po.Spec.Containers[0].Args)
+}
+
+func TestBenchmarkOptionsDefaults(t *testing.T) {
+	o := BenchmarkOptions{}.withDefaults()
+
+	assert.Equal(t, 1, o.CPUWorkers)
+	assert.Equal(t, 1, o.IOWorkers)
+	assert.Equal(t, DefaultBenchmarkDuration, o.Duration)
+	assert.Equal(t, 5*time.Second, o.SampleInterval)
+	assert.NotNil(t, o.Out)
+}
@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/slogs"
+)
+
+const (
+	nodeMetricsPath = "/api/v1/nodes/%s/proxy/metrics"
+
+	// KubeletCertExpiryMetric tracks the kubelet client certificate expiration metric.
+	KubeletCertExpiryMetric = "kubelet_certificate_manager_client_expiration_seconds"
+
+	// NodeCertCacheTTL tracks how long a node kubelet certificate expiry is reused.
+	NodeCertCacheTTL = 6 * time.Hour
+
+	// nodeCertRetryTTL tracks how long to wait before retrying a failed lookup.
+	nodeCertRetryTTL = 10 * time.Minute
+
+	// nodeCertFetchTimeout caps a kubelet metrics scrape.
+	nodeCertFetchTimeout = 10 * time.Second
+)
+
+type nodeCertKey struct {
+	context, node string
+}
+
+// nodeCertEntry tracks a node cached kubelet certificate status.
+type nodeCertEntry struct {
+	status  *CertStatus
+	fetched time.Time
+	loading bool
+}
+
+var nodeCerts = struct {
+	entries map[nodeCertKey]*nodeCertEntry
+	// fetches caps the number of concurrent kubelet metrics scrapes.
+	fetches chan struct{}
+	mx      sync.Mutex
+}{
+	entries: make(map[nodeCertKey]*nodeCertEntry),
+	fetches: make(chan struct{}, internal.DefaultPoolSize),
+}
+
+// ErrNoKubeletCertExpiry indicates a kubelet does not report its client certificate expiry.
+var ErrNoKubeletCertExpiry = errors.New("kubelet does not report a client certificate expiry")
+
+// CertStatus tracks a kubelet client certificate expiry.
+type CertStatus struct {
+	NodeName      string
+	ExpiresAt     time.Time
+	DaysRemaining int
+}
+
+// NodeCertExpiryFunc retrieves a node kubelet client certificate status.
+type NodeCertExpiryFunc func(ctx context.Context, nodeName string) (*CertStatus, error)
+
+// KubeletCertExpiry returns a node cached kubelet client certificate status or nil
+// if not known yet. Missing or stale entries are refreshed in the background.
+func (n *Node) KubeletCertExpiry(nodeName string) *CertStatus {
+	return cachedNodeCertExpiry(n.Client().ActiveContext(), NodeCertExpiry(n.getFactory()), nodeName, time.Now())
+}
+
+// NodeCertExpiry returns a function retrieving nodes kubelet client certificate status.
+func NodeCertExpiry(f Factory) NodeCertExpiryFunc {
+	return func(ctx context.Context, nodeName string) (*CertStatus, error) {
+		dial, err := f.Client().Dial()
+		if err != nil {
+			return nil, err
+		}
+		bb, err := dial.CoreV1().RESTClient().
+			Get().
+			AbsPath(fmt.Sprintf(nodeMetricsPath, nodeName)).
+			DoRaw(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseKubeletCertExpiry(nodeName, bb, time.Now())
+	}
+}
+
+// CachedNodesCertExpiry returns the given nodes cached kubelet certificate days remaining.
+// Nodes whose expiry is not known yet are skipped and fetched in the background.
+func CachedNodesCertExpiry(f Factory, nodes []string) map[string]int {
+	return cachedNodesCertExpiry(f.Client().ActiveContext(), NodeCertExpiry(f), nodes, time.Now())
+}
+
+func cachedNodesCertExpiry(ctxName string, fn NodeCertExpiryFunc, nodes []string, now time.Time) map[string]int {
+	mm := make(map[string]int, len(nodes))
+	for _, n := range nodes {
+		if cs := cachedNodeCertExpiry(ctxName, fn, n, now); cs != nil {
+			mm[n] = cs.DaysRemaining
+		}
+	}
+
+	return mm
+}
+
+func cachedNodeCertExpiry(ctxName string, fn NodeCertExpiryFunc, nodeName string, now time.Time) *CertStatus {
+	key := nodeCertKey{context: ctxName, node: nodeName}
+	nodeCerts.mx.Lock()
+	defer nodeCerts.mx.Unlock()
+
+	e, ok := nodeCerts.entries[key]
+	if !ok {
+		e = new(nodeCertEntry)
+		nodeCerts.entries[key] = e
+	}
+	ttl := NodeCertCacheTTL
+	if e.status == nil {
+		ttl = nodeCertRetryTTL
+	}
+	if !e.loading && now.Sub(e.fetched) >= ttl {
+		e.loading = true
+		go refreshNodeCert(e, fn, nodeName)
+	}
+	if e.status == nil {
+		return nil
+	}
+	cs := *e.status
+	cs.DaysRemaining = daysRemaining(cs.ExpiresAt, now)
+
+	return &cs
+}
+
+func refreshNodeCert(e *nodeCertEntry, fn NodeCertExpiryFunc, nodeName string) {
+	nodeCerts.fetches <- struct{}{}
+	defer func() { <-nodeCerts.fetches }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), nodeCertFetchTimeout)
+	defer cancel()
+	cs, err := fn(ctx, nodeName)
+	if err != nil {
+		slog.Debug("Unable to fetch node kubelet certificate expiry",
+			slogs.ResName, nodeName,
+			slogs.Error, err,
+		)
+	}
+
+	nodeCerts.mx.Lock()
+	defer nodeCerts.mx.Unlock()
+	e.loading, e.fetched = false, time.Now()
+	if err == nil {
+		e.status = cs
+	}
+}
+
+// parseKubeletCertExpiry extracts the client certificate expiry from kubelet metrics
+// in the prometheus text format.
+func parseKubeletCertExpiry(nodeName string, bb []byte, now time.Time) (*CertStatus, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(bb))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		rest, ok := strings.CutPrefix(line, KubeletCertExpiryMetric)
+		if !ok || (!strings.HasPrefix(rest, " ") && !strings.HasPrefix(rest, "{")) {
+			continue
+		}
+		ff := strings.Fields(rest[strings.LastIndex(rest, "}")+1:])
+		if len(ff) == 0 {
+			return nil, fmt.Errorf("invalid metric %q", line)
+		}
+		secs, err := strconv.ParseFloat(ff[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid metric %q: %w", line, err)
+		}
+		if secs <= 0 {
+			return nil, ErrNoKubeletCertExpiry
+		}
+		sec, frac := math.Modf(secs)
+		t := time.Unix(int64(sec), int64(frac*1e9))
+
+		return &CertStatus{
+			NodeName:      nodeName,
+			ExpiresAt:     t,
+			DaysRemaining: daysRemaining(t, now),
+		}, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, ErrNoKubeletCertExpiry
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKubeletCertExpiry(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	uu := map[string]struct {
+		bb   string
+		days int
+		err  error
+	}{
+		"plain": {
+			bb: `# HELP kubelet_certificate_manager_client_expiration_seconds [ALPHA] Gauge of the TTL (time-to-live) of the Kubelet's client certificate.
+# TYPE kubelet_certificate_manager_client_expiration_seconds gauge
+kubelet_certificate_manager_client_expiration_seconds 1.7008640e+09
+kubelet_certificate_manager_client_ttl_seconds 864000
+`,
+			days: 10,
+		},
+		"labels": {
+			bb:   `kubelet_certificate_manager_client_expiration_seconds{node="n1"} 1700172800`,
+			days: 2,
+		},
+		"expired": {
+			bb:   `kubelet_certificate_manager_client_expiration_seconds 1699913600`,
+			days: -1,
+		},
+		"no-cert": {
+			bb:  `kubelet_certificate_manager_client_expiration_seconds 0`,
+			err: ErrNoKubeletCertExpiry,
+		},
+		"missing": {
+			bb:  `kubelet_certificate_manager_client_ttl_seconds 864000`,
+			err: ErrNoKubeletCertExpiry,
+		},
+		"toast": {
+			bb:  `kubelet_certificate_manager_client_expiration_seconds fred`,
+			err: errors.New("invalid"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cs, err := parseKubeletCertExpiry("n1", []byte(u.bb), now)
+			if u.err != nil {
+				require.Error(t, err)
+				if errors.Is(u.err, ErrNoKubeletCertExpiry) {
+					require.ErrorIs(t, err, ErrNoKubeletCertExpiry)
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "n1", cs.NodeName)
+			assert.Equal(t, u.days, cs.DaysRemaining)
+		})
+	}
+}
+
+func TestCachedNodesCertExpiry(t *testing.T) {
+	now := time.Now()
+	var calls atomic.Int32
+	fn := func(_ context.Context, n string) (*CertStatus, error) {
+		calls.Add(1)
+		if n == "n2" {
+			return nil, ErrNoKubeletCertExpiry
+		}
+		return &CertStatus{NodeName: n, ExpiresAt: now.Add(12*24*time.Hour + time.Hour)}, nil
+	}
+	nodes := []string{"n1", "n2", "n3"}
+	// Entries are cached per context, use a fresh one.
+	ctxName := now.String()
+
+	assert.Empty(t, cachedNodesCertExpiry(ctxName, fn, nodes, now))
+	require.Eventually(t, func() bool {
+		return len(cachedNodesCertExpiry(ctxName, fn, nodes, now)) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, map[string]int{"n1": 12, "n3": 12}, cachedNodesCertExpiry(ctxName, fn, nodes, now))
+	assert.Equal(t, int32(3), calls.Load())
+
+	later := now.Add(nodeCertRetryTTL + time.Minute)
+	assert.Equal(t, map[string]int{"n1": 12, "n3": 12}, cachedNodesCertExpiry(ctxName, fn, nodes, later))
+	require.Eventually(t, func() bool {
+		return calls.Load() == 4
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// KernelCVECacheTTL tracks how long a kernel CVE count is reused.
+	KernelCVECacheTTL = 6 * time.Hour
+
+	// kernelCVERetryTTL tracks how long to wait before retrying a failed lookup.
+	kernelCVERetryTTL = 5 * time.Minute
+)
+
+type kernelCVEKey struct {
+	feed   client.CVEFeed
+	kernel string
+}
+
+// kernelCVEEntry tracks a kernel cached CVE count.
+type kernelCVEEntry struct {
+	count   *int
+	fetched time.Time
+	loading bool
+}
+
+var kernelCVEs = struct {
+	entries map[kernelCVEKey]*kernelCVEEntry
+	// fetches serializes the feed lookups to honor the feed rate limits.
+	fetches chan struct{}
+	mx      sync.Mutex
+}{
+	entries: make(map[kernelCVEKey]*kernelCVEEntry),
+	fetches: make(chan struct{}, 1),
+}
+
+// CachedKernelCVECounts returns the given nodes kernels cached CVE counts keyed by node name.
+// Kernels whose CVEs are not known yet are skipped and looked up in the background.
+func CachedKernelCVECounts(feed client.CVEFeed, kernels map[string]string) map[string]int {
+	return cachedKernelCVECounts(feed, kernels, time.Now())
+}
+
+func cachedKernelCVECounts(feed client.CVEFeed, kernels map[string]string, now time.Time) map[string]int {
+	mm := make(map[string]int, len(kernels))
+	for node, kernel := range kernels {
+		if c, ok := cachedKernelCVECount(feed, kernel, now); ok {
+			mm[node] = c
+		}
+	}
+
+	return mm
+}
+
+func cachedKernelCVECount(feed client.CVEFeed, kernel string, now time.Time) (int, bool) {
+	if kernel == "" {
+		return 0, false
+	}
+	key := kernelCVEKey{feed: feed, kernel: kernel}
+	kernelCVEs.mx.Lock()
+	defer kernelCVEs.mx.Unlock()
+
+	e, ok := kernelCVEs.entries[key]
+	if !ok {
+		e = new(kernelCVEEntry)
+		kernelCVEs.entries[key] = e
+	}
+	ttl := KernelCVECacheTTL
+	if e.count == nil {
+		ttl = kernelCVERetryTTL
+	}
+	if !e.loading && now.Sub(e.fetched) >= ttl {
+		e.loading = true
+		go refreshKernelCVEs(e, feed, kernel)
+	}
+	if e.count == nil {
+		return 0, false
+	}
+
+	return *e.count, true
+}
+
+func refreshKernelCVEs(e *kernelCVEEntry, feed client.CVEFeed, kernel string) {
+	kernelCVEs.fetches <- struct{}{}
+	defer func() { <-kernelCVEs.fetches }()
+
+	cc, err := feed.KernelCVEs(kernel)
+	if err != nil {
+		slog.Debug("Unable to fetch kernel CVEs",
+			slogs.Kernel, kernel,
+			slogs.Error, err,
+		)
+	}
+
+	kernelCVEs.mx.Lock()
+	defer kernelCVEs.mx.Unlock()
+	e.loading, e.fetched = false, time.Now()
+	if err == nil {
+		c := len(cc)
+		e.count = &c
+	}
+}
+
+// nodeKernels returns the nodes kernel versions keyed by node name.
+func nodeKernels(oo []runtime.Object) map[string]string {
+	mm := make(map[string]string, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		mm[u.GetName()], _, _ = unstructured.NestedString(u.Object, "status", "nodeInfo", "kernelVersion")
+	}
+
+	return mm
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCachedKernelCVECounts(t *testing.T) {
+	feed := &testCVEFeed{cves: map[string][]client.CVE{
+		"5.15.0-1034-aws": {{ID: "CVE-1"}, {ID: "CVE-2"}},
+		"6.1.0":           nil,
+	}}
+	kernels := map[string]string{"n1": "5.15.0-1034-aws", "n2": "6.1.0", "n3": "blee", "n4": ""}
+	now := time.Now()
+
+	assert.Empty(t, cachedKernelCVECounts(feed, kernels, now))
+	assert.Eventually(t, func() bool {
+		return len(cachedKernelCVECounts(feed, kernels, now)) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, map[string]int{"n1": 2, "n2": 0}, cachedKernelCVECounts(feed, kernels, now))
+	assert.Equal(t, int32(3), feed.calls.Load())
+
+	assert.Equal(t, map[string]int{"n1": 2, "n2": 0}, cachedKernelCVECounts(feed, kernels, now.Add(time.Minute)))
+	assert.Equal(t, int32(3), feed.calls.Load())
+}
+
+func TestNodeKernels(t *testing.T) {
+	var u unstructured.Unstructured
+	u.SetName("n1")
+	_ = unstructured.SetNestedField(u.Object, "6.1.0", "status", "nodeInfo", "kernelVersion")
+
+	assert.Equal(t, map[string]string{"n1": "6.1.0"}, nodeKernels([]runtime.Object{&u}))
+}
+
+// Helpers...
+
+type testCVEFeed struct {
+	cves  map[string][]client.CVE
+	calls atomic.Int32
+}
+
+func (f *testCVEFeed) KernelCVEs(kernel string) ([]client.CVE, error) {
+	f.calls.Add(1)
+	cc, ok := f.cves[kernel]
+	if !ok {
+		return nil, errors.New("unknown kernel")
+	}
+
+	return cc, nil
+}
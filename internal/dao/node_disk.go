@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Kubelet filesystems as reported by the stats summary.
+const (
+	NodeFs      = "nodefs"
+	ImageFs     = "imagefs"
+	ContainerFs = "containerfs"
+)
+
+// fsStats represents a kubelet stats summary filesystem.
+type fsStats struct {
+	AvailableBytes *uint64 `json:"availableBytes"`
+	CapacityBytes  *uint64 `json:"capacityBytes"`
+	UsedBytes      *uint64 `json:"usedBytes"`
+	InodesFree     *uint64 `json:"inodesFree"`
+	Inodes         *uint64 `json:"inodes"`
+	InodesUsed     *uint64 `json:"inodesUsed"`
+}
+
+// FilesystemUsage tracks a node filesystem usage.
+type FilesystemUsage struct {
+	Name                                string
+	Capacity, Used, Available           uint64
+	Inodes, InodesUsed, InodesAvailable uint64
+}
+
+// UsedPercent returns the filesystem bytes usage percentage.
+func (f FilesystemUsage) UsedPercent() int {
+	return client.ToPercentage(int64(f.Used), int64(f.Capacity))
+}
+
+// InodesPercent returns the filesystem inodes usage percentage.
+func (f FilesystemUsage) InodesPercent() int {
+	return client.ToPercentage(int64(f.InodesUsed), int64(f.Inodes))
+}
+
+// GetDiskPressureDetails returns a node filesystems usage from the kubelet stats summary.
+// Image and container filesystems are included when the kubelet reports them and
+// mirror the node filesystem when the runtime does not use a dedicated one.
+func (n *Node) GetDiskPressureDetails(ctx context.Context, nodeName string) ([]FilesystemUsage, error) {
+	bb, err := fetchStatsSummary(ctx, n.getFactory(), nodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFilesystemSummary(bb)
+}
+
+// parseFilesystemSummary extracts the node filesystems usage from a stats summary.
+func parseFilesystemSummary(bb []byte) ([]FilesystemUsage, error) {
+	var s statsSummary
+	if err := json.Unmarshal(bb, &s); err != nil {
+		return nil, err
+	}
+
+	ff := make([]FilesystemUsage, 0, 3)
+	if s.Node.Fs != nil {
+		ff = append(ff, s.Node.Fs.usage(NodeFs))
+	}
+	if rt := s.Node.Runtime; rt != nil {
+		if rt.ImageFs != nil {
+			ff = append(ff, rt.ImageFs.usage(ImageFs))
+		}
+		if rt.ContainerFs != nil {
+			ff = append(ff, rt.ContainerFs.usage(ContainerFs))
+		}
+	}
+	if len(ff) == 0 {
+		return nil, errors.New("no filesystem stats available")
+	}
+
+	return ff, nil
+}
+
+func (s *fsStats) usage(name string) FilesystemUsage {
+	return FilesystemUsage{
+		Name:            name,
+		Capacity:        derefBytes(s.CapacityBytes),
+		Used:            derefBytes(s.UsedBytes),
+		Available:       derefBytes(s.AvailableBytes),
+		Inodes:          derefBytes(s.Inodes),
+		InodesUsed:      derefBytes(s.InodesUsed),
+		InodesAvailable: derefBytes(s.InodesFree),
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFilesystemSummary(t *testing.T) {
+	uu := map[string]struct {
+		bb  string
+		e   []FilesystemUsage
+		err bool
+	}{
+		"nodefs": {
+			bb: `{"node":{"fs":{"availableBytes":20,"capacityBytes":100,"usedBytes":80,"inodesFree":90,"inodes":100,"inodesUsed":10}}}`,
+			e: []FilesystemUsage{
+				{Name: NodeFs, Capacity: 100, Used: 80, Available: 20, Inodes: 100, InodesUsed: 10, InodesAvailable: 90},
+			},
+		},
+		"split": {
+			bb: `{"node":{"fs":{"capacityBytes":100,"usedBytes":10},"runtime":{"imageFs":{"capacityBytes":50,"usedBytes":45},"containerFs":{"capacityBytes":10,"usedBytes":1}}}}`,
+			e: []FilesystemUsage{
+				{Name: NodeFs, Capacity: 100, Used: 10},
+				{Name: ImageFs, Capacity: 50, Used: 45},
+				{Name: ContainerFs, Capacity: 10, Used: 1},
+			},
+		},
+		"no-fs": {
+			bb:  `{"node":{"nodeName":"n1"}}`,
+			err: true,
+		},
+		"toast": {
+			bb:  `{"node":`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff, err := parseFilesystemSummary([]byte(u.bb))
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, ff)
+		})
+	}
+}
+
+func TestFilesystemUsagePercent(t *testing.T) {
+	f := FilesystemUsage{Capacity: 200, Used: 150, Inodes: 1000, InodesUsed: 100}
+
+	assert.Equal(t, 75, f.UsedPercent())
+	assert.Equal(t, 10, f.InodesPercent())
+	assert.Equal(t, 0, FilesystemUsage{}.UsedPercent())
+}
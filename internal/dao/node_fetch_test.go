@@ -0,0 +1,216 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFetchNodes(t *testing.T) {
+	uu := map[string]struct {
+		nodes []*v1.Node
+		e     map[string]string
+	}{
+		"empty": {
+			e: map[string]string{},
+		},
+		"single-arch": {
+			nodes: []*v1.Node{archNode("n1", "amd64", "amd64"), archNode("n2", "amd64", "amd64")},
+			e:     map[string]string{"n1": "amd64", "n2": "amd64"},
+		},
+		"multi-arch": {
+			nodes: []*v1.Node{
+				archNode("n1", "amd64", "amd64"),
+				archNode("n2", "arm64", "arm64"),
+				archNode("n3", "", "s390x"),
+			},
+			e: map[string]string{"n1": "amd64", "n2": "arm64", "n3": "s390x"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			nn, err := dao.FetchNodes(context.Background(), makeNodeFactory(t, u.nodes...), "")
+			require.NoError(t, err)
+			aa := make(map[string]string, len(nn.Items))
+			for i := range nn.Items {
+				aa[nn.Items[i].Name] = render.NodeArch(&nn.Items[i])
+			}
+			assert.Equal(t, u.e, aa)
+		})
+	}
+}
+
+func TestNodeArchitectures(t *testing.T) {
+	f := makeNodeFactory(t,
+		archNode("n1", "arm64", "arm64"),
+		archNode("n2", "amd64", "amd64"),
+		archNode("n3", "arm64", "arm64"),
+		archNode("n4", "", ""),
+	)
+
+	aa, err := dao.NodeArchitectures(context.Background(), f)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"amd64", "arm64"}, aa)
+}
+
+func TestNodeGetNodesByZone(t *testing.T) {
+	var n dao.Node
+	n.Init(makeNodeFactory(t,
+		zoneNode("n3", "us-east-1a"),
+		zoneNode("n1", "us-east-1a"),
+		zoneNode("n2", "us-east-1b"),
+		zoneNode("n4", ""),
+	), client.NodeGVR)
+
+	zz, err := n.GetNodesByZone(context.Background())
+	require.NoError(t, err)
+
+	nn := make(map[string][]string, len(zz))
+	for z, no := range zz {
+		for _, node := range no {
+			nn[z] = append(nn[z], node.Name)
+		}
+	}
+	assert.Equal(t, map[string][]string{
+		"us-east-1a": {"n1", "n3"},
+		"us-east-1b": {"n2"},
+		dao.NoZone:   {"n4"},
+	}, nn)
+}
+
+func TestNodeGetZoneNodes(t *testing.T) {
+	var n dao.Node
+	n.Init(makeNodeFactory(t,
+		zoneNode("n3", "us-east-1a"),
+		zoneNode("n1", "us-east-1a"),
+		zoneNode("n2", "us-east-1b"),
+		zoneNode("n4", ""),
+	), client.NodeGVR)
+
+	uu := map[string]struct {
+		zone string
+		e    []string
+	}{
+		"zone": {
+			zone: "us-east-1a",
+			e:    []string{"n1", "n3"},
+		},
+		"no-zone": {
+			zone: dao.NoZone,
+			e:    []string{"n4"},
+		},
+		"missing": {
+			zone: "us-west-1a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			nn, err := n.GetZoneNodes(context.Background(), u.zone)
+			require.NoError(t, err)
+			var names []string
+			for _, no := range nn {
+				names = append(names, no.Name)
+			}
+			assert.Equal(t, u.e, names)
+		})
+	}
+}
+
+// Helpers...
+
+type nodeFactory struct {
+	*testFactory
+}
+
+func (nodeFactory) Client() client.Connection {
+	return makeConn()
+}
+
+func makeNodeFactory(t *testing.T, nn ...*v1.Node) dao.Factory {
+	oo := make([]runtime.Object, 0, len(nn))
+	for _, no := range nn {
+		o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(no)
+		require.NoError(t, err)
+		oo = append(oo, &unstructured.Unstructured{Object: o})
+	}
+
+	return nodeFactory{
+		testFactory: &testFactory{
+			inventory: map[string]map[*client.GVR][]runtime.Object{
+				"": {client.NodeGVR: oo},
+			},
+		},
+	}
+}
+
+func archNode(n, label, arch string) *v1.Node {
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Status:     v1.NodeStatus{NodeInfo: v1.NodeSystemInfo{Architecture: arch}},
+	}
+	if label != "" {
+		no.Labels = map[string]string{v1.LabelArchStable: label}
+	}
+
+	return &no
+}
+
+func zoneNode(n, zone string) *v1.Node {
+	no := v1.Node{ObjectMeta: metav1.ObjectMeta{Name: n}}
+	if zone != "" {
+		no.Labels = map[string]string{v1.LabelTopologyZone: zone}
+	}
+
+	return &no
+}
+
+func TestNodeCheckKernelCVEs(t *testing.T) {
+	no := zoneNode("cve-n1", "")
+	no.Status.NodeInfo.KernelVersion = "5.15.0-1034-aws"
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(no)
+	require.NoError(t, err)
+	var n dao.Node
+	n.Init(nodeFactory{
+		testFactory: &testFactory{
+			inventory: map[string]map[*client.GVR][]runtime.Object{
+				client.ClusterScope: {client.NodeGVR: {&unstructured.Unstructured{Object: o}}},
+			},
+		},
+	}, client.NodeGVR)
+
+	_, err = n.CheckKernelCVEs("cve-n1", nil)
+	require.ErrorIs(t, err, client.ErrNoCVEFeed)
+
+	feed := cveFeed{"5.15.0-1034-aws": {{ID: "CVE-2023-0001", Score: 7.8}}}
+	cc, err := n.CheckKernelCVEs("cve-n1", feed)
+	require.NoError(t, err)
+	assert.Equal(t, []client.CVE{{ID: "CVE-2023-0001", Score: 7.8}}, cc)
+}
+
+type cveFeed map[string][]client.CVE
+
+func (f cveFeed) KernelCVEs(v string) ([]client.CVE, error) {
+	cc, ok := f[v]
+	if !ok {
+		return nil, fmt.Errorf("no cves for %q", v)
+	}
+
+	return cc, nil
+}
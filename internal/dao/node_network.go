@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const nodeStatsSummaryPath = "/api/v1/nodes/%s/proxy/stats/summary"
+
+// NodeNetworkMetricsFunc retrieves a node network metrics.
+type NodeNetworkMetricsFunc func(ctx context.Context, nodeName string) (*client.NodeNetworkMetrics, error)
+
+// statsSummary represents the relevant parts of a kubelet stats summary.
+type statsSummary struct {
+	Node struct {
+		Network *struct {
+			RxBytes    *uint64 `json:"rxBytes"`
+			TxBytes    *uint64 `json:"txBytes"`
+			Interfaces []struct {
+				RxBytes *uint64 `json:"rxBytes"`
+				TxBytes *uint64 `json:"txBytes"`
+			} `json:"interfaces"`
+		} `json:"network"`
+		Fs      *fsStats `json:"fs"`
+		Runtime *struct {
+			ImageFs     *fsStats `json:"imageFs"`
+			ContainerFs *fsStats `json:"containerFs"`
+		} `json:"runtime"`
+	} `json:"node"`
+}
+
+// NodeNetworkMetrics returns a function retrieving nodes network metrics
+// from the kubelet stats summary endpoint via the api server proxy.
+func NodeNetworkMetrics(f Factory) NodeNetworkMetricsFunc {
+	return func(ctx context.Context, nodeName string) (*client.NodeNetworkMetrics, error) {
+		bb, err := fetchStatsSummary(ctx, f, nodeName)
+		if err != nil {
+			return nil, err
+		}
+
+		return parseNetworkSummary(bb)
+	}
+}
+
+// fetchStatsSummary returns a node raw kubelet stats summary.
+func fetchStatsSummary(ctx context.Context, f Factory, nodeName string) ([]byte, error) {
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return dial.CoreV1().RESTClient().
+		Get().
+		AbsPath(fmt.Sprintf(nodeStatsSummaryPath, nodeName)).
+		DoRaw(ctx)
+}
+
+// FetchNodesNetworkMetrics retrieves the given nodes network metrics.
+// Nodes whose stats are not available are skipped.
+func FetchNodesNetworkMetrics(ctx context.Context, fn NodeNetworkMetricsFunc, nodes []string) client.NodesNetworkMetricsMap {
+	var mx sync.Mutex
+	mm := make(client.NodesNetworkMetricsMap, len(nodes))
+	pool := internal.NewWorkerPool(ctx, internal.DefaultPoolSize)
+	for _, n := range nodes {
+		pool.Add(func(ctx context.Context) error {
+			m, err := fn(ctx, n)
+			if err != nil {
+				slog.Debug("Unable to fetch node network metrics",
+					slogs.ResName, n,
+					slogs.Error, err,
+				)
+				return nil
+			}
+			mx.Lock()
+			mm[n] = m
+			mx.Unlock()
+
+			return nil
+		})
+	}
+	pool.Drain()
+
+	return mm
+}
+
+// parseNetworkSummary extracts the node network counters from a stats summary.
+// When the default interface totals are missing, all interfaces are summed.
+func parseNetworkSummary(bb []byte) (*client.NodeNetworkMetrics, error) {
+	var s statsSummary
+	if err := json.Unmarshal(bb, &s); err != nil {
+		return nil, err
+	}
+	net := s.Node.Network
+	if net == nil {
+		return nil, errors.New("no network stats available")
+	}
+
+	var mx client.NodeNetworkMetrics
+	if net.RxBytes != nil || net.TxBytes != nil {
+		mx.RxBytes, mx.TxBytes = derefBytes(net.RxBytes), derefBytes(net.TxBytes)
+		return &mx, nil
+	}
+	for _, i := range net.Interfaces {
+		mx.RxBytes += derefBytes(i.RxBytes)
+		mx.TxBytes += derefBytes(i.TxBytes)
+	}
+
+	return &mx, nil
+}
+
+func derefBytes(v *uint64) uint64 {
+	if v == nil {
+		return 0
+	}
+
+	return *v
+}
+
+func nodeNames(oo []runtime.Object) []string {
+	nn := make([]string, 0, len(oo))
+	for _, o := range oo {
+		_, n := client.Namespaced(extractFQN(o))
+		nn = append(nn, n)
+	}
+
+	return nn
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNetworkSummary(t *testing.T) {
+	uu := map[string]struct {
+		bb  string
+		e   *client.NodeNetworkMetrics
+		err bool
+	}{
+		"default-interface": {
+			bb: `{"node":{"network":{"name":"eth0","rxBytes":100,"txBytes":200,"interfaces":[{"name":"eth0","rxBytes":100,"txBytes":200},{"name":"eth1","rxBytes":1,"txBytes":2}]}}}`,
+			e:  &client.NodeNetworkMetrics{RxBytes: 100, TxBytes: 200},
+		},
+		"interfaces": {
+			bb: `{"node":{"network":{"interfaces":[{"name":"eth0","rxBytes":100,"txBytes":200},{"name":"eth1","rxBytes":1,"txBytes":2}]}}}`,
+			e:  &client.NodeNetworkMetrics{RxBytes: 101, TxBytes: 202},
+		},
+		"no-network": {
+			bb:  `{"node":{"nodeName":"n1"}}`,
+			err: true,
+		},
+		"toast": {
+			bb:  `{"node":`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mx, err := parseNetworkSummary([]byte(u.bb))
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, mx)
+		})
+	}
+}
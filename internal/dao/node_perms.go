@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/derailed/k9s/internal/client"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeVerbs tracks the node verbs checked during permissions pre-flight.
+var NodeVerbs = []string{
+	client.GetVerb,
+	client.ListVerb,
+	client.PatchVerb,
+	client.UpdateVerb,
+	client.DeleteVerb,
+}
+
+// NodePermSet tracks the node verbs the current user is allowed to use.
+type NodePermSet map[string]bool
+
+// Can returns true if all the given verbs are allowed.
+func (s NodePermSet) Can(verbs ...string) bool {
+	for _, v := range verbs {
+		if !s[v] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Missing returns the checked node verbs the user is not allowed to use.
+func (s NodePermSet) Missing() []string {
+	mm := make([]string, 0, len(NodeVerbs))
+	for _, v := range NodeVerbs {
+		if !s[v] {
+			mm = append(mm, v)
+		}
+	}
+
+	return mm
+}
+
+var nodePerms = struct {
+	perms map[string]NodePermSet
+	mx    sync.Mutex
+}{
+	perms: make(map[string]NodePermSet),
+}
+
+// NodePermissions returns the node verbs the current user is allowed to use.
+// Permissions are resolved via a single rules review and cached per cluster context
+// for the duration of the session.
+func NodePermissions(ctx context.Context, f Factory) (NodePermSet, error) {
+	if f == nil || f.Client() == nil {
+		return nil, errors.New("no api server connection")
+	}
+	key := f.Client().ActiveContext()
+	nodePerms.mx.Lock()
+	defer nodePerms.mx.Unlock()
+	if s, ok := nodePerms.perms[key]; ok {
+		return s, nil
+	}
+
+	dial, err := f.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	review := authorizationv1.SelfSubjectRulesReview{
+		Spec: authorizationv1.SelfSubjectRulesReviewSpec{
+			Namespace: client.DefaultNamespace,
+		},
+	}
+	resp, err := dial.AuthorizationV1().SelfSubjectRulesReviews().Create(ctx, &review, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	s := nodePermsFromRules(resp.Status.ResourceRules)
+	// Some authorizers can't enumerate rules. Fall back to per verb access checks.
+	if resp.Status.Incomplete {
+		for _, v := range s.Missing() {
+			s[v], _ = f.Client().CanI(client.ClusterScope, client.NodeGVR, "", []string{v})
+		}
+	}
+	nodePerms.perms[key] = s
+
+	return s, nil
+}
+
+// ResetNodePermissions clears the cached node permissions.
+func ResetNodePermissions() {
+	nodePerms.mx.Lock()
+	defer nodePerms.mx.Unlock()
+
+	nodePerms.perms = make(map[string]NodePermSet)
+}
+
+func nodePermsFromRules(rr []authorizationv1.ResourceRule) NodePermSet {
+	s := make(NodePermSet, len(NodeVerbs))
+	for _, r := range rr {
+		// Rules scoped to named resources do not grant access to all nodes.
+		if len(r.ResourceNames) > 0 {
+			continue
+		}
+		if !matchesRule(r.APIGroups, "") || !matchesRule(r.Resources, "nodes") {
+			continue
+		}
+		for _, v := range NodeVerbs {
+			if matchesRule(r.Verbs, v) {
+				s[v] = true
+			}
+		}
+	}
+
+	return s
+}
+
+func matchesRule(ss []string, s string) bool {
+	return slices.Contains(ss, s) || slices.Contains(ss, "*")
+}
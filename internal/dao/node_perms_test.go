@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+func TestNodePermsFromRules(t *testing.T) {
+	uu := map[string]struct {
+		rr      []authorizationv1.ResourceRule
+		can     []string
+		missing []string
+	}{
+		"none": {
+			missing: []string{"get", "list", "patch", "update", "delete"},
+		},
+		"read-only": {
+			rr: []authorizationv1.ResourceRule{
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "list", "watch"}},
+			},
+			can:     []string{"get", "list"},
+			missing: []string{"patch", "update", "delete"},
+		},
+		"wildcards": {
+			rr: []authorizationv1.ResourceRule{
+				{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}},
+			},
+			can:     []string{"get", "list", "patch", "update", "delete"},
+			missing: []string{},
+		},
+		"named": {
+			rr: []authorizationv1.ResourceRule{
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"get", "list"}},
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, ResourceNames: []string{"n1"}, Verbs: []string{"patch"}},
+			},
+			can:     []string{"get", "list"},
+			missing: []string{"patch", "update", "delete"},
+		},
+		"other-group": {
+			rr: []authorizationv1.ResourceRule{
+				{APIGroups: []string{"metrics.k8s.io"}, Resources: []string{"nodes"}, Verbs: []string{"get", "list"}},
+			},
+			missing: []string{"get", "list", "patch", "update", "delete"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := nodePermsFromRules(u.rr)
+			assert.True(t, s.Can(u.can...))
+			assert.Equal(t, u.missing, s.Missing())
+		})
+	}
+}
@@ -0,0 +1,1386 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	"k8s.io/utils/ptr"
+)
+
+func TestNodeCountPodsByPhase(t *testing.T) {
+	uu := map[string]struct {
+		oo   []runtime.Object
+		node string
+		e    map[v1.PodPhase]int
+	}{
+		"empty": {
+			node: "n1",
+			e:    map[v1.PodPhase]int{},
+		},
+		"mixed": {
+			oo: []runtime.Object{
+				makeNodePod("n1", v1.PodRunning),
+				makeNodePod("n1", v1.PodRunning),
+				makeNodePod("n1", v1.PodPending),
+				makeNodePod("n1", v1.PodFailed),
+				makeNodePod("n2", v1.PodRunning),
+			},
+			node: "n1",
+			e: map[v1.PodPhase]int{
+				v1.PodRunning: 2,
+				v1.PodPending: 1,
+				v1.PodFailed:  1,
+			},
+		},
+		"no-match": {
+			oo: []runtime.Object{
+				makeNodePod("n2", v1.PodSucceeded),
+			},
+			node: "n1",
+			e:    map[v1.PodPhase]int{},
+		},
+	}
+
+	var n Node
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := n.CountPodsByPhase(u.oo, u.node)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
+
+func TestBuildPodCountMap(t *testing.T) {
+	oo := []runtime.Object{
+		makeNodePod("n1", v1.PodRunning),
+		makeNodePod("n1", v1.PodPending),
+		makeNodePod("n2", v1.PodRunning),
+		makeNodePod("", v1.PodPending),
+	}
+
+	mm, err := BuildPodCountMap(oo)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"n1": 2, "n2": 1}, mm)
+
+	_, err = BuildPodCountMap([]runtime.Object{&v1.Pod{}})
+	require.Error(t, err)
+}
+
+func TestNodeBuildPodPhasesMap(t *testing.T) {
+	oo := []runtime.Object{
+		makeNodePod("n1", v1.PodRunning),
+		makeNodePod("n1", v1.PodRunning),
+		makeNodePod("n1", v1.PodFailed),
+		makeNodePod("n2", v1.PodPending),
+		makeNodePod("", v1.PodPending),
+	}
+
+	mm, err := buildPodPhasesMap(oo)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]map[v1.PodPhase]int{
+		"n1": {v1.PodRunning: 2, v1.PodFailed: 1},
+		"n2": {v1.PodPending: 1},
+	}, mm)
+}
+
+func BenchmarkBuildPodCountMap(b *testing.B) {
+	oo := make([]runtime.Object, 0, 5_000)
+	for i := range 5_000 {
+		oo = append(oo, makeNodePod(fmt.Sprintf("n%d", i%100), v1.PodRunning))
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		_, _ = BuildPodCountMap(oo)
+	}
+}
+
+func TestNodePDBConflicts(t *testing.T) {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Name: "pdb1", Namespace: "ns1"},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+	uu := map[string]struct {
+		pp []v1.Pod
+		e  int
+	}{
+		"none": {},
+		"within-budget": {
+			pp: []v1.Pod{makeLabeledPod("ns1", "p1", "fred")},
+		},
+		"over-budget": {
+			pp: []v1.Pod{
+				makeLabeledPod("ns1", "p1", "fred"),
+				makeLabeledPod("ns1", "p2", "fred"),
+			},
+			e: 1,
+		},
+		"other-ns": {
+			pp: []v1.Pod{
+				makeLabeledPod("ns2", "p1", "fred"),
+				makeLabeledPod("ns2", "p2", "fred"),
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			errs := pdbConflicts(fake.NewClientset(pdb), u.pp)
+			assert.Len(t, errs, u.e)
+		})
+	}
+}
+
+// Helpers...
+
+func TestCoveringPDBs(t *testing.T) {
+	makePDB := func(ns, n string, sel *metav1.LabelSelector) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: ns},
+			Spec:       policyv1.PodDisruptionBudgetSpec{Selector: sel},
+		}
+	}
+	fred := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}}
+	pdbs := []*policyv1.PodDisruptionBudget{
+		makePDB("ns1", "z-fred", fred),
+		makePDB("ns1", "blee", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "blee"}}),
+		makePDB("ns2", "fred", fred),
+		makePDB("ns1", "all", &metav1.LabelSelector{}),
+		makePDB("ns1", "none", nil),
+	}
+	p1, p2 := makeLabeledPod("ns1", "p1", "fred"), makeLabeledPod("ns3", "p2", "fred")
+
+	uu := map[string]struct {
+		pp []*v1.Pod
+		e  []string
+	}{
+		"no-pods": {
+			e: []string{},
+		},
+		"match": {
+			pp: []*v1.Pod{&p1},
+			e:  []string{"all", "z-fred"},
+		},
+		"other-ns": {
+			pp: []*v1.Pod{&p2},
+			e:  []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc := coveringPDBs(pdbs, u.pp)
+			nn := make([]string, 0, len(cc))
+			for _, c := range cc {
+				nn = append(nn, c.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func makeLabeledPod(ns, n, app string) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      n,
+			Namespace: ns,
+			Labels:    map[string]string{"app": app},
+		},
+	}
+}
+
+func TestNodesRequests(t *testing.T) {
+	oo := []runtime.Object{
+		makeRequestPod("n1", v1.PodRunning, "100m", "64Mi"),
+		makeRequestPod("n1", v1.PodPending, "250m", "128Mi"),
+		makeRequestPod("n1", v1.PodSucceeded, "1", "1Gi"),
+		makeRequestPod("n2", v1.PodRunning, "1", "1Gi"),
+		makeRequestPod("", v1.PodPending, "1", "1Gi"),
+	}
+
+	rr, err := nodesRequests(oo)
+	require.NoError(t, err)
+	assert.Len(t, rr, 2)
+	n1, n2 := rr["n1"], rr["n2"]
+	assert.Equal(t, int64(350), n1.Cpu().MilliValue())
+	assert.Equal(t, int64(192*1024*1024), n1.Memory().Value())
+	assert.Equal(t, int64(1000), n2.Cpu().MilliValue())
+}
+
+func TestNodesRequestsCache(t *testing.T) {
+	po := makeRequestPod("n1", v1.PodRunning, "100m", "64Mi")
+	po.SetUID("u1")
+	po.SetResourceVersion("1")
+
+	rr, err := nodesRequests([]runtime.Object{po})
+	require.NoError(t, err)
+	n1 := rr["n1"]
+	assert.Equal(t, int64(100), n1.Cpu().MilliValue())
+
+	po.Object["spec"].(map[string]any)["nodeName"] = "n2"
+	rr, err = nodesRequests([]runtime.Object{po})
+	require.NoError(t, err)
+	assert.Contains(t, rr, "n1")
+
+	po.SetResourceVersion("2")
+	rr, err = nodesRequests([]runtime.Object{po})
+	require.NoError(t, err)
+	assert.Contains(t, rr, "n2")
+
+	_, err = nodesRequests(nil)
+	require.NoError(t, err)
+	assert.NotContains(t, podRequestsCache.entries, types.UID("u1"))
+}
+
+func TestPodRequests(t *testing.T) {
+	co := func(cpu string) v1.Container {
+		return v1.Container{
+			Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu)}},
+		}
+	}
+	sidecar := co("50m")
+	sidecar.RestartPolicy = ptr.To(v1.ContainerRestartPolicyAlways)
+
+	uu := map[string]struct {
+		inits []v1.Container
+		phase v1.PodPhase
+		e     int64
+	}{
+		"none": {
+			e: 200,
+		},
+		"small-init": {
+			inits: []v1.Container{co("100m")},
+			e:     200,
+		},
+		"large-init": {
+			inits: []v1.Container{co("100m"), co("500m")},
+			e:     500,
+		},
+		"sidecar": {
+			inits: []v1.Container{sidecar, co("100m")},
+			e:     250,
+		},
+		"done": {
+			inits: []v1.Container{co("500m")},
+			phase: v1.PodSucceeded,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var po v1.Pod
+			po.Status.Phase = u.phase
+			po.Spec.InitContainers = u.inits
+			po.Spec.Containers = []v1.Container{co("150m"), co("50m")}
+			rl := podRequests(&po)
+			assert.Equal(t, u.e, rl.Cpu().MilliValue())
+		})
+	}
+}
+
+func TestNodeGPUCapacity(t *testing.T) {
+	gpuPod := func(phase v1.PodPhase, req, lim string) *v1.Pod {
+		var po v1.Pod
+		po.Status.Phase = phase
+		res := v1.ResourceRequirements{Limits: v1.ResourceList{"nvidia.com/gpu": resource.MustParse(lim)}}
+		if req != "" {
+			res.Requests = v1.ResourceList{"nvidia.com/gpu": resource.MustParse(req)}
+		}
+		po.Spec.Containers = []v1.Container{{Name: "c1", Resources: res}}
+		return &po
+	}
+	no := v1.Node{
+		Status: v1.NodeStatus{
+			Capacity:    v1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			Allocatable: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("7")},
+		},
+	}
+	pp := []*v1.Pod{
+		gpuPod(v1.PodRunning, "2", "2"),
+		gpuPod(v1.PodPending, "", "1"),
+		gpuPod(v1.PodSucceeded, "4", "4"),
+	}
+
+	c, err := nodeGPUCapacity(&no, pp)
+	require.NoError(t, err)
+	assert.Equal(t, &GPUCapacity{Vendor: "nvidia", Total: 8, Allocatable: 7, InUse: 3}, c)
+
+	_, err = nodeGPUCapacity(&v1.Node{}, pp)
+	assert.ErrorIs(t, err, ErrNoGPUs)
+}
+
+func TestGPUNodes(t *testing.T) {
+	gpu := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "gpu"},
+		"status":   map[string]any{"capacity": map[string]any{"amd.com/gpu": "2"}},
+	}}
+	cpu := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{"name": "cpu"},
+		"status":   map[string]any{"capacity": map[string]any{"cpu": "4"}},
+	}}
+
+	assert.Equal(t, []runtime.Object{gpu}, gpuNodes([]runtime.Object{cpu, gpu}))
+}
+
+func TestSetIsolation(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	other := v1.Taint{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}
+	evict := v1.Taint{Key: "k9s.io/isolate", Value: "true", Effect: v1.TaintEffectNoExecute}
+	evicted := evict
+	evicted.TimeAdded = &metav1.Time{Time: now}
+	isolated := DefaultIsolateTaint
+	byIsolate := map[string]string{IsolateCordonAnnotation: "2024-03-01T10:00:00Z"}
+	uu := map[string]struct {
+		spec    v1.NodeSpec
+		ann     map[string]string
+		taint   v1.Taint
+		isolate bool
+		changed bool
+		e       v1.NodeSpec
+		eAnn    map[string]string
+	}{
+		"isolate": {
+			spec:    v1.NodeSpec{Taints: []v1.Taint{other}},
+			isolate: true,
+			changed: true,
+			e:       v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{other, isolated}},
+			eAnn:    byIsolate,
+		},
+		"isolate-no-execute": {
+			taint:   evict,
+			isolate: true,
+			changed: true,
+			e:       v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{evicted}},
+			eAnn:    byIsolate,
+		},
+		"isolate-cordoned": {
+			spec:    v1.NodeSpec{Unschedulable: true},
+			isolate: true,
+			changed: true,
+			e:       v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{isolated}},
+		},
+		"already-isolated": {
+			spec:    v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{isolated}},
+			ann:     byIsolate,
+			isolate: true,
+			e:       v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{isolated}},
+			eAnn:    byIsolate,
+		},
+		"deisolate": {
+			spec:    v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{isolated, other}},
+			ann:     byIsolate,
+			changed: true,
+			e:       v1.NodeSpec{Taints: []v1.Taint{other}},
+			eAnn:    map[string]string{},
+		},
+		"deisolate-cordoned": {
+			spec:    v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{isolated}},
+			changed: true,
+			e:       v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{}},
+		},
+		"not-isolated": {
+			spec: v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{other}},
+			e:    v1.NodeSpec{Unschedulable: true, Taints: []v1.Taint{other}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			no := v1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: maps.Clone(u.ann)}, Spec: u.spec}
+			taint := u.taint
+			if taint.Key == "" {
+				taint = DefaultIsolateTaint
+			}
+			assert.Equal(t, u.changed, setIsolation(&no, taint, u.isolate, now))
+			assert.Equal(t, u.e, no.Spec)
+			assert.Equal(t, u.eAnn, no.Annotations)
+		})
+	}
+}
+
+func TestIsolateNode(t *testing.T) {
+	dial := fake.NewClientset(&v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}})
+	ctx := context.Background()
+
+	require.NoError(t, isolateNode(ctx, dial, "n1", DefaultIsolateTaint, true))
+	no, err := dial.CoreV1().Nodes().Get(ctx, "n1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, no.Spec.Unschedulable)
+	assert.Len(t, no.Spec.Taints, 1)
+	assert.True(t, no.Spec.Taints[0].MatchTaint(&DefaultIsolateTaint))
+	require.Error(t, isolateNode(ctx, dial, "n1", DefaultIsolateTaint, true))
+
+	assert.Contains(t, no.Annotations, IsolateCordonAnnotation)
+
+	require.NoError(t, isolateNode(ctx, dial, "n1", DefaultIsolateTaint, false))
+	no, err = dial.CoreV1().Nodes().Get(ctx, "n1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.False(t, no.Spec.Unschedulable)
+	assert.Empty(t, no.Spec.Taints)
+	assert.NotContains(t, no.Annotations, IsolateCordonAnnotation)
+	require.Error(t, isolateNode(ctx, dial, "n1", DefaultIsolateTaint, false))
+}
+
+func TestLeaderElections(t *testing.T) {
+	now := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	lease := func(ns, name, holder string, renew time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(holder),
+				LeaseDurationSeconds: ptr.To(int32(15)),
+				RenewTime:            &metav1.MicroTime{Time: renew},
+			},
+		}
+	}
+	ep := &v1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "legacy",
+			Annotations: map[string]string{
+				leaderAnnotation: `{"holderIdentity":"p2","leaseDurationSeconds":15,"renewTime":"2024-03-01T09:59:55Z"}`,
+			},
+		},
+	}
+	dial := fake.NewClientset(
+		lease("kube-system", "kube-scheduler", "n1_8f4b", now),
+		lease("kube-system", "kube-controller-manager", "n2", now),
+		lease("ns1", "operator", "p1_1234", now),
+		lease("ns1", "stale", "p1", now.Add(-time.Minute)),
+		lease("ns1", "elsewhere", "p9", now),
+		lease(v1.NamespaceNodeLease, "n1", "n1", now),
+		ep,
+	)
+	pp := []*v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p2"}},
+	}
+
+	ll, err := leaderElections(context.Background(), dial, lockHolders(map[string][]*v1.Pod{"n1": pp, "n2": nil}), now)
+	require.NoError(t, err)
+	slices.SortFunc(ll, func(a, b LeaderElection) int {
+		return strings.Compare(a.Namespace+a.Name, b.Namespace+b.Name)
+	})
+	assert.Equal(t, []LeaderElection{
+		{Kind: "Lease", Namespace: "kube-system", Name: "kube-controller-manager", Holder: "n2", Node: "n2"},
+		{Kind: "Lease", Namespace: "kube-system", Name: "kube-scheduler", Holder: "n1_8f4b", Node: "n1"},
+		{Kind: "Endpoints", Namespace: "ns1", Name: "legacy", Holder: "p2", Node: "n1", Pod: "ns1/p2"},
+		{Kind: "Lease", Namespace: "ns1", Name: "operator", Holder: "p1_1234", Node: "n1", Pod: "ns1/p1"},
+	}, ll)
+	assert.Equal(t, "lease ns1/operator held by p1_1234 (ns1/p1)", ll[3].String())
+}
+
+func TestNodeLabelsPatch(t *testing.T) {
+	uu := map[string]struct {
+		old, ll map[string]string
+		e       string
+	}{
+		"add": {
+			ll: map[string]string{"a": "1"},
+			e:  `{"metadata":{"labels":{"a":"1"}}}`,
+		},
+		"update": {
+			old: map[string]string{"a": "1"},
+			ll:  map[string]string{"a": "2"},
+			e:   `{"metadata":{"labels":{"a":"2"}}}`,
+		},
+		"remove": {
+			old: map[string]string{"a": "1", "b": "2"},
+			ll:  map[string]string{"b": "2"},
+			e:   `{"metadata":{"labels":{"a":null,"b":"2"}}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := labelsPatch(u.old, u.ll)
+			require.NoError(t, err)
+			assert.JSONEq(t, u.e, string(bb))
+		})
+	}
+}
+
+func TestNodeWatchReadiness(t *testing.T) {
+	w := watch.NewFake()
+	out := make(chan NodeReadinessEvent, 10)
+	state := make(map[string]bool)
+	go func() {
+		w.Add(makeReadyNode("n1", v1.ConditionTrue))
+		w.Add(makeReadyNode("n2", v1.ConditionFalse))
+		w.Modify(makeReadyNode("n1", v1.ConditionTrue))
+		w.Modify(makeReadyNode("n1", v1.ConditionFalse))
+		w.Modify(makeReadyNode("n2", v1.ConditionTrue))
+		w.Delete(makeReadyNode("n2", v1.ConditionTrue))
+		w.Add(makeReadyNode("n2", v1.ConditionFalse))
+		w.Stop()
+	}()
+
+	require.NoError(t, watchReadiness(context.Background(), w, state, out))
+	close(out)
+
+	ee := make([]NodeReadinessEvent, 0, len(out))
+	for e := range out {
+		ee = append(ee, e)
+	}
+	assert.Len(t, ee, 2)
+	assert.Equal(t, "n1", ee[0].Name)
+	assert.True(t, ee[0].OldReady)
+	assert.False(t, ee[0].NewReady)
+	assert.Equal(t, "n2", ee[1].Name)
+	assert.False(t, ee[1].OldReady)
+	assert.True(t, ee[1].NewReady)
+	assert.Equal(t, map[string]bool{"n1": false, "n2": false}, state)
+}
+
+func makeReadyNode(name string, ready v1.ConditionStatus) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{
+			Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: ready},
+			},
+		},
+	}
+}
+
+func TestNodeFilterSystemPods(t *testing.T) {
+	ctrl := true
+	ds := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "ds",
+		OwnerReferences: []metav1.OwnerReference{
+			{Kind: "DaemonSet", Name: "fluentd", Controller: &ctrl},
+		},
+	}}
+	rs := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Namespace: "default",
+		Name:      "rs",
+		OwnerReferences: []metav1.OwnerReference{
+			{Kind: "ReplicaSet", Name: "nginx", Controller: &ctrl},
+		},
+	}}
+	sys := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "dns"}}
+	bare := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "bare"}}
+
+	assert.Equal(t, []*v1.Pod{ds, sys}, filterSystemPods([]*v1.Pod{ds, rs, sys, bare}))
+	assert.Empty(t, filterSystemPods([]*v1.Pod{rs, bare}))
+}
+
+func TestNodeOrderPods(t *testing.T) {
+	low, high := int32(10), int32(1000)
+	pp := []v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "p1"}, Spec: v1.PodSpec{Priority: &high}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p2"}, Spec: v1.PodSpec{Priority: &low}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "p3"}},
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p4"}, Spec: v1.PodSpec{Priority: &high}},
+	}
+
+	uu := map[string]struct {
+		order EvictionOrder
+		e     [][]string
+	}{
+		"default": {
+			order: EvictionOrderDefault,
+			e:     [][]string{{"p1", "p2", "p3", "p4"}},
+		},
+		"blank": {
+			e: [][]string{{"p1", "p2", "p3", "p4"}},
+		},
+		"priority": {
+			order: EvictionOrderPriority,
+			e:     [][]string{{"p3"}, {"p2"}, {"p1", "p4"}},
+		},
+		"namespace": {
+			order: EvictionOrderNamespace,
+			e:     [][]string{{"p2", "p4"}, {"p1", "p3"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tiers := orderPods(slices.Clone(pp), u.order)
+			nn := make([][]string, 0, len(tiers))
+			for _, tier := range tiers {
+				names := make([]string, 0, len(tier))
+				for _, po := range tier {
+					names = append(names, po.Name)
+				}
+				nn = append(nn, names)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestNodeWithLabelSelector(t *testing.T) {
+	var n Node
+	n.Init(nil, client.NodeGVR)
+	ctx := context.Background()
+	assert.Nil(t, n.labelSelector(ctx))
+
+	sel := labels.SelectorFromSet(labels.Set{"zone": "a"})
+	cp := n.WithOptions(WithLabelSelector(sel))
+	assert.Equal(t, sel, cp.labelSelector(ctx))
+	assert.Equal(t, client.NodeGVR.String(), cp.GVR())
+	assert.Nil(t, n.labelSelector(ctx))
+
+	other := labels.SelectorFromSet(labels.Set{"zone": "b"})
+	ctx = context.WithValue(ctx, internal.KeyNodeSelector, other)
+	assert.Equal(t, other, cp.labelSelector(ctx))
+	assert.Equal(t, other, n.labelSelector(ctx))
+}
+
+func TestNodeMergeSelectors(t *testing.T) {
+	base := labels.SelectorFromSet(labels.Set{"zone": "a"})
+
+	uu := map[string]struct {
+		labels string
+		e      string
+	}{
+		"none": {
+			e: "zone=a",
+		},
+		"merged": {
+			labels: "pool in (p1,p2)",
+			e:      "pool in (p1,p2),zone=a",
+		},
+		"invalid": {
+			labels: "pool in (p1",
+			e:      "zone=a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, mergeSelectors(base, u.labels).String())
+		})
+	}
+}
+
+func TestNodeChunkPods(t *testing.T) {
+	pod := func(n string) v1.Pod {
+		return v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n}}
+	}
+	gg := [][]v1.Pod{
+		{pod("p1"), pod("p2"), pod("p3")},
+		{pod("p4")},
+	}
+
+	uu := map[string]struct {
+		size int
+		e    [][]string
+	}{
+		"unlimited": {
+			e: [][]string{{"p1", "p2", "p3"}, {"p4"}},
+		},
+		"negative": {
+			size: -1,
+			e:    [][]string{{"p1", "p2", "p3"}, {"p4"}},
+		},
+		"chunks": {
+			size: 2,
+			e:    [][]string{{"p1", "p2"}, {"p3"}, {"p4"}},
+		},
+		"singles": {
+			size: 1,
+			e:    [][]string{{"p1"}, {"p2"}, {"p3"}, {"p4"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc := chunkPods(gg, u.size)
+			nn := make([][]string, 0, len(cc))
+			for _, c := range cc {
+				names := make([]string, 0, len(c))
+				for _, po := range c {
+					names = append(names, po.Name)
+				}
+				nn = append(nn, names)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestNodeFilterPods(t *testing.T) {
+	oo := []runtime.Object{
+		makeRequestPod("n1", v1.PodRunning, "100m", "64Mi"),
+		makeRequestPod("n2", v1.PodRunning, "100m", "64Mi"),
+		makeRequestPod("n1", v1.PodPending, "100m", "64Mi"),
+	}
+
+	pp, err := filterNodePods(oo, "n1")
+	require.NoError(t, err)
+	assert.Len(t, pp, 2)
+	for _, po := range pp {
+		assert.Equal(t, "n1", po.Spec.NodeName)
+	}
+}
+
+func TestNodeTopPods(t *testing.T) {
+	pod := func(n string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n}}
+	}
+	podMX := func(n, cpu, mem string) *mv1beta1.PodMetrics {
+		return &mv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n},
+			Containers: []mv1beta1.ContainerMetrics{
+				{Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu), v1.ResourceMemory: resource.MustParse(mem)}},
+				{Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse("10m"), v1.ResourceMemory: resource.MustParse("1Mi")}},
+			},
+		}
+	}
+	pp := []*v1.Pod{pod("p1"), pod("p2"), pod("p3"), pod("p4")}
+	mx := client.PodsMetricsMap{
+		"ns1/p1": podMX("p1", "100m", "300Mi"),
+		"ns1/p2": podMX("p2", "300m", "100Mi"),
+		"ns1/p3": podMX("p3", "200m", "200Mi"),
+	}
+
+	uu := map[string]struct {
+		n      int
+		sortBy ResourceField
+		e      []string
+	}{
+		"cpu": {
+			n:      2,
+			sortBy: ResourceCPU,
+			e:      []string{"p2", "p3"},
+		},
+		"mem": {
+			n:      2,
+			sortBy: ResourceMEM,
+			e:      []string{"p1", "p3"},
+		},
+		"all": {
+			sortBy: ResourceCPU,
+			e:      []string{"p2", "p3", "p1", "p4"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res := topPods(pp, mx, u.n, u.sortBy)
+			nn := make([]string, 0, len(res))
+			for _, r := range res {
+				nn = append(nn, r.Pod.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+
+	res := topPods(pp, mx, 1, ResourceCPU)
+	assert.Equal(t, int64(310), res[0].CPU)
+	assert.Equal(t, int64(101*1024*1024), res[0].MEM)
+}
+
+func TestCachedLargeCluster(t *testing.T) {
+	var calls int
+	large := func() bool {
+		calls++
+		return true
+	}
+
+	assert.True(t, cachedLargeCluster("ct-large", large))
+	assert.True(t, cachedLargeCluster("ct-large", large))
+	assert.Equal(t, 1, calls)
+
+	assert.False(t, cachedLargeCluster("ct-small", func() bool { return false }))
+	assert.True(t, cachedLargeCluster("ct-large", large))
+	assert.Equal(t, 1, calls)
+}
+
+// BenchmarkNodeGetPods compares the cached pods filtering against the field selector
+// list for the same cluster, 50 pods per node. The fake clientset filters in memory,
+// so the select path excludes the api server round trip.
+func BenchmarkNodeGetPods(b *testing.B) {
+	for _, nodes := range []int{10, 50, 100} {
+		uu := make([]runtime.Object, 0, nodes*50)
+		tt := make([]runtime.Object, 0, nodes*50)
+		for i := range nodes * 50 {
+			node := fmt.Sprintf("n%d", i%nodes)
+			uu = append(uu, makeRequestPod(node, v1.PodRunning, "100m", "64Mi"))
+			tt = append(tt, &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: fmt.Sprintf("p%d", i)},
+				Spec:       v1.PodSpec{NodeName: node},
+			})
+		}
+		dial := fake.NewClientset(tt...)
+
+		b.Run(fmt.Sprintf("filter/%d-nodes", nodes), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_, _ = filterNodePods(uu, "n1")
+			}
+		})
+		b.Run(fmt.Sprintf("select/%d-nodes", nodes), func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_, _ = listNodePods(context.Background(), dial, "n1")
+			}
+		})
+	}
+}
+
+func TestNodeAnnotationDiff(t *testing.T) {
+	mk := func(aa map[string]string) *v1.Node {
+		return &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1", Annotations: aa}}
+	}
+
+	uu := map[string]struct {
+		before, after *v1.Node
+		e             []AnnotationChange
+	}{
+		"same": {
+			before: mk(map[string]string{"a": "1"}),
+			after:  mk(map[string]string{"a": "1"}),
+			e:      []AnnotationChange{},
+		},
+		"nil": {
+			after: mk(map[string]string{"a": "1"}),
+			e: []AnnotationChange{
+				{Key: "a", NewValue: "1", Op: AnnotationAdd},
+			},
+		},
+		"changes": {
+			before: mk(map[string]string{"a": "1", "b": "2", "c": "3"}),
+			after:  mk(map[string]string{"a": "1", "c": "4", "d": "5"}),
+			e: []AnnotationChange{
+				{Key: "b", OldValue: "2", Op: AnnotationRemove},
+				{Key: "c", OldValue: "3", NewValue: "4", Op: AnnotationUpdate},
+				{Key: "d", NewValue: "5", Op: AnnotationAdd},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AnnotationDiff(u.before, u.after))
+		})
+	}
+}
+
+func TestNodeEvictionSupported(t *testing.T) {
+	evictions := &metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods/eviction", Kind: "Eviction", Group: "policy", Version: "v1"},
+		},
+	}
+	uu := map[string]struct {
+		resources []*metav1.APIResourceList
+		e         bool
+	}{
+		"supported": {
+			resources: []*metav1.APIResourceList{evictions},
+			e:         true,
+		},
+		"not-discovered": {
+			resources: []*metav1.APIResourceList{{GroupVersion: "v1"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewClientset()
+			dial.Resources = u.resources
+			assert.Equal(t, u.e, evictionSupported(dial))
+		})
+	}
+}
+
+func TestNodeSnapshotYAML(t *testing.T) {
+	no := makeReadyNode("n1", v1.ConditionTrue)
+	no.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubelet"}}
+	ev := v1.Event{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "e1"}, Reason: "NodeReady"}
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"}}
+
+	bb, err := snapshotYAML([]runtime.Object{no, &ev, &po})
+	require.NoError(t, err)
+
+	docs := strings.Split(string(bb), "---\n")
+	assert.Len(t, docs, 3)
+	assert.Contains(t, docs[0], "kind: Node")
+	assert.Contains(t, docs[0], "name: n1")
+	assert.NotContains(t, docs[0], "managedFields")
+	assert.Contains(t, docs[1], "kind: Event")
+	assert.Contains(t, docs[1], "reason: NodeReady")
+	assert.Contains(t, docs[2], "kind: Pod")
+	assert.Contains(t, docs[2], "apiVersion: v1")
+	assert.Len(t, no.ManagedFields, 1)
+	assert.Empty(t, po.Kind)
+}
+
+func makeRequestPod(node string, phase v1.PodPhase, cpu, mem string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"nodeName": node,
+				"containers": []any{
+					map[string]any{
+						"name": "c1",
+						"resources": map[string]any{
+							"requests": map[string]any{
+								"cpu":    cpu,
+								"memory": mem,
+							},
+						},
+					},
+				},
+			},
+			"status": map[string]any{
+				"phase": string(phase),
+			},
+		},
+	}
+}
+
+func makeNodePod(node string, phase v1.PodPhase) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": map[string]any{
+				"nodeName": node,
+			},
+			"status": map[string]any{
+				"phase": string(phase),
+			},
+		},
+	}
+}
+
+func TestNodeEditPatch(t *testing.T) {
+	orig := `apiVersion: v1
+kind: Node
+metadata:
+  name: n1
+  labels:
+    a: b
+spec:
+  podCIDR: 10.0.0.0/24
+  unschedulable: false
+`
+	uu := map[string]struct {
+		edited, e string
+		err       bool
+	}{
+		"unchanged": {
+			edited: orig,
+			e:      `{}`,
+		},
+		"labels": {
+			edited: strings.Replace(orig, "a: b", "a: c", 1),
+			e:      `{"metadata":{"labels":{"a":"c"}}}`,
+		},
+		"spec": {
+			edited: strings.Replace(orig, "unschedulable: false", "unschedulable: true", 1),
+			e:      `{"spec":{"unschedulable":true}}`,
+		},
+		"toast": {
+			edited: "metadata: [",
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			patch, err := NodeEditPatch([]byte(orig), []byte(u.edited))
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.JSONEq(t, u.e, string(patch))
+		})
+	}
+}
+
+func TestEditNode(t *testing.T) {
+	orig := `apiVersion: v1
+kind: Node
+metadata:
+  name: n1
+  resourceVersion: "1"
+  labels:
+    a: b
+spec:
+  unschedulable: false
+`
+	edited := strings.Replace(orig, "a: b", "a: c", 1)
+
+	uu := map[string]struct {
+		latest    map[string]string
+		conflicts int
+		rvs       []string
+		e         map[string]string
+		err       string
+		diff      bool
+	}{
+		"no-conflict": {
+			latest: map[string]string{"a": "b"},
+			rvs:    []string{"1"},
+			e:      map[string]string{"a": "c"},
+		},
+		"rebased": {
+			latest:    map[string]string{"a": "b", "z": "y"},
+			conflicts: 1,
+			rvs:       []string{"1", "2"},
+			e:         map[string]string{"a": "c", "z": "y"},
+		},
+		"same-change": {
+			latest:    map[string]string{"a": "c"},
+			conflicts: 1,
+			rvs:       []string{"1", "2"},
+			e:         map[string]string{"a": "c"},
+		},
+		"clash": {
+			latest:    map[string]string{"a": "d"},
+			conflicts: 1,
+			rvs:       []string{"1"},
+			e:         map[string]string{"a": "d"},
+			err:       "node n1 was modified concurrently with conflicting changes. Resolve manually",
+			diff:      true,
+		},
+		"exhausted": {
+			latest:    map[string]string{"a": "b"},
+			conflicts: nodeEditRetries + 1,
+			rvs:       []string{"1", "2", "2", "2"},
+			e:         map[string]string{"a": "b"},
+			err:       `Operation cannot be fulfilled on nodes "n1": conflict`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cs := fake.NewClientset(&v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1", ResourceVersion: "2", Labels: u.latest},
+			})
+			var rvs []string
+			conflicts := u.conflicts
+			cs.PrependReactor("patch", "nodes", func(a k8stesting.Action) (bool, runtime.Object, error) {
+				var p struct {
+					Metadata metav1.ObjectMeta `json:"metadata"`
+				}
+				require.NoError(t, json.Unmarshal(a.(k8stesting.PatchAction).GetPatch(), &p))
+				rvs = append(rvs, p.Metadata.ResourceVersion)
+				if conflicts > 0 {
+					conflicts--
+					return true, nil, kerrors.NewConflict(v1.Resource("nodes"), "n1", errors.New("conflict"))
+				}
+				return false, nil, nil
+			})
+
+			patch, err := NodeEditPatch([]byte(orig), []byte(edited))
+			require.NoError(t, err)
+			err = editNode(context.Background(), cs.CoreV1().Nodes(), "n1", []byte(orig), []byte(edited), patch)
+			assert.Equal(t, u.rvs, rvs)
+			if u.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, u.err, err.Error())
+				var ce *NodeEditConflictError
+				assert.Equal(t, u.diff, errors.As(err, &ce))
+				if u.diff {
+					assert.Contains(t, ce.Diff, "-    a: d")
+					assert.Contains(t, ce.Diff, "+    a: c")
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			no, err := cs.CoreV1().Nodes().Get(context.Background(), "n1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, u.e, no.Labels)
+		})
+	}
+}
+
+func TestValidateNodePatch(t *testing.T) {
+	uu := map[string]struct {
+		patch string
+		err   string
+	}{
+		"empty": {
+			patch: `{}`,
+		},
+		"mutable": {
+			patch: `{"metadata":{"labels":{"a":"c"}},"spec":{"unschedulable":true,"taints":null}}`,
+		},
+		"name": {
+			patch: `{"metadata":{"name":"n2"}}`,
+			err:   "metadata.name is immutable",
+		},
+		"cidr": {
+			patch: `{"spec":{"podCIDR":"10.0.1.0/24","providerID":"aws://blee"}}`,
+			err:   "spec.podCIDR is immutable\nspec.providerID is immutable",
+		},
+		"status": {
+			patch: `{"status":{"phase":"Running"}}`,
+			err:   "status is not editable",
+		},
+		"removed-spec": {
+			patch: `{"spec":null}`,
+			err:   "spec can not be removed",
+		},
+		"toast": {
+			patch: `{`,
+			err:   "invalid node patch: unexpected end of JSON input",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := ValidateNodePatch([]byte(u.patch))
+			if u.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
+
+func TestConditionEvents(t *testing.T) {
+	at := func(m int) metav1.Time {
+		return metav1.NewTime(time.Date(2024, 1, 1, 10, m, 0, 0, time.UTC))
+	}
+	ee := []*v1.Event{
+		{Reason: "NodeNotReady", Message: "Node n1 status is now: NodeNotReady", LastTimestamp: at(1)},
+		{Reason: "NodeReady", Message: "Node n1 status is now: NodeReady", LastTimestamp: at(5)},
+		{Reason: "NodeHasDiskPressure", Message: "Node n1 status is now: NodeHasDiskPressure", LastTimestamp: at(3)},
+		{Reason: "NodeNotReady", Message: "Node is not ready", LastTimestamp: at(2)},
+		{Reason: "Starting", Message: "Starting kubelet.", LastTimestamp: at(0)},
+	}
+
+	uu := map[string]struct {
+		cond    v1.NodeConditionType
+		reasons []string
+		status  []v1.ConditionStatus
+	}{
+		"ready": {
+			cond:    v1.NodeReady,
+			reasons: []string{"NodeReady", "NodeNotReady", "NodeNotReady"},
+			status:  []v1.ConditionStatus{v1.ConditionTrue, v1.ConditionFalse, v1.ConditionFalse},
+		},
+		"disk": {
+			cond:    v1.NodeDiskPressure,
+			reasons: []string{"NodeHasDiskPressure"},
+			status:  []v1.ConditionStatus{v1.ConditionTrue},
+		},
+		"memory": {
+			cond: v1.NodeMemoryPressure,
+		},
+		"all": {
+			reasons: []string{"NodeReady", "NodeHasDiskPressure", "NodeNotReady", "NodeNotReady"},
+			status:  []v1.ConditionStatus{v1.ConditionTrue, v1.ConditionTrue, v1.ConditionFalse, v1.ConditionFalse},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc := conditionEvents(ee, u.cond)
+			require.Len(t, cc, len(u.reasons))
+			for i, c := range cc {
+				assert.Equal(t, u.reasons[i], c.Reason)
+				assert.Equal(t, u.status[i], c.Status)
+				if i > 0 {
+					assert.False(t, c.Timestamp.After(cc[i-1].Timestamp))
+				}
+			}
+		})
+	}
+}
+
+func TestWaitForBudgets(t *testing.T) {
+	pdb := func(current, desired int32) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pdb1"},
+			Status:     policyv1.PodDisruptionBudgetStatus{CurrentHealthy: current, DesiredHealthy: desired},
+		}
+	}
+
+	var calls int
+	list := func() ([]*policyv1.PodDisruptionBudget, error) {
+		calls++
+		if calls < 3 {
+			return []*policyv1.PodDisruptionBudget{pdb(1, 2)}, nil
+		}
+		return []*policyv1.PodDisruptionBudget{pdb(2, 2)}, nil
+	}
+	require.NoError(t, waitForBudgets(context.Background(), list, time.Second, time.Millisecond))
+	assert.Equal(t, 3, calls)
+
+	stuck := func() ([]*policyv1.PodDisruptionBudget, error) {
+		return []*policyv1.PodDisruptionBudget{pdb(1, 2)}, nil
+	}
+	err := waitForBudgets(context.Background(), stuck, 10*time.Millisecond, time.Millisecond)
+	require.EqualError(t, err, "disruption budgets ns1/pdb1 did not recover within 10ms")
+
+	failed := func() ([]*policyv1.PodDisruptionBudget, error) {
+		return nil, errors.New("boom")
+	}
+	require.EqualError(t, waitForBudgets(context.Background(), failed, time.Second, time.Millisecond), "boom")
+}
+
+func TestCoveredBudgets(t *testing.T) {
+	pdb := func(n, app string, current, desired int32) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{CurrentHealthy: current, DesiredHealthy: desired},
+		}
+	}
+	list := func() ([]*policyv1.PodDisruptionBudget, error) {
+		return []*policyv1.PodDisruptionBudget{pdb("pdb1", "web", 2, 2), pdb("pdb2", "db", 0, 1)}, nil
+	}
+	pp := []*v1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1", Labels: map[string]string{"app": "web"}}},
+	}
+
+	pdbs, err := coveredBudgets(list, pp)()
+	require.NoError(t, err)
+	require.Len(t, pdbs, 1)
+	assert.Equal(t, "pdb1", pdbs[0].Name)
+	require.NoError(t, waitForBudgets(context.Background(), coveredBudgets(list, pp), 10*time.Millisecond, time.Millisecond))
+}
+
+func TestSimulateDrain(t *testing.T) {
+	makePDB := func(n, app string, expected, healthy, desired int32) *policyv1.PodDisruptionBudget {
+		return &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "ns1"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{
+				ExpectedPods:   expected,
+				CurrentHealthy: healthy,
+				DesiredHealthy: desired,
+			},
+		}
+	}
+	makePod := func(n, app string) *v1.Pod {
+		po := makeLabeledPod("ns1", n, app)
+		return &po
+	}
+	ds := makePod("ds", "fred")
+	ds.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds", Controller: ptr.To(true)}}
+	done := makePod("done", "fred")
+	done.Status.Phase = v1.PodSucceeded
+
+	uu := map[string]struct {
+		pp   []*v1.Pod
+		pdbs []*policyv1.PodDisruptionBudget
+		e    DrainSimulation
+	}{
+		"no-pdbs": {
+			pp: []*v1.Pod{makePod("p2", "fred"), makePod("p1", "fred")},
+			e: DrainSimulation{
+				BlockedPods: []BlockedPod{},
+				SafePods:    []string{"ns1/p1", "ns1/p2"},
+			},
+		},
+		"budget-exhausted": {
+			pp:   []*v1.Pod{makePod("p1", "fred"), makePod("p2", "fred"), makePod("p3", "blee")},
+			pdbs: []*policyv1.PodDisruptionBudget{makePDB("fred", "fred", 3, 3, 2)},
+			e: DrainSimulation{
+				BlockedPods: []BlockedPod{
+					{Pod: "ns1/p2", PDBName: "ns1/fred", CurrentDisruptions: 1, MaxDisruptions: 1},
+				},
+				SafePods: []string{"ns1/p1", "ns1/p3"},
+			},
+		},
+		"already-disrupted": {
+			pp:   []*v1.Pod{makePod("p1", "fred")},
+			pdbs: []*policyv1.PodDisruptionBudget{makePDB("fred", "fred", 3, 2, 2)},
+			e: DrainSimulation{
+				BlockedPods: []BlockedPod{
+					{Pod: "ns1/p1", PDBName: "ns1/fred", CurrentDisruptions: 1, MaxDisruptions: 1},
+				},
+				SafePods: []string{},
+			},
+		},
+		"skipped": {
+			pp:   []*v1.Pod{ds, done},
+			pdbs: []*policyv1.PodDisruptionBudget{makePDB("fred", "fred", 1, 1, 1)},
+			e: DrainSimulation{
+				BlockedPods: []BlockedPod{},
+				SafePods:    []string{},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, &u.e, simulateDrain(u.pp, u.pdbs))
+		})
+	}
+}
+
+func TestReportNodesMetrics(t *testing.T) {
+	var buff bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buff, &slog.HandlerOptions{Level: slog.LevelWarn})))
+	nodesMetricsDown.Store(false)
+
+	reportNodesMetrics(errors.New("boom"))
+	reportNodesMetrics(errors.New("boom"))
+	assert.Equal(t, 1, strings.Count(buff.String(), "level=WARN"))
+
+	reportNodesMetrics(nil)
+	reportNodesMetrics(errors.New("boom"))
+	assert.Equal(t, 2, strings.Count(buff.String(), "level=WARN"))
+	nodesMetricsDown.Store(false)
+}
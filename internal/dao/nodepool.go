@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*NodePool)(nil)
+
+// NodePool represents nodes aggregated by a pool label.
+type NodePool struct {
+	NonResource
+}
+
+// List returns a collection of node pools.
+func (p *NodePool) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	label, _ := ctx.Value(internal.KeyNodePoolLabel).(string)
+	if label == "" {
+		label = config.DefaultNodePoolLabel
+	}
+	nn, err := FetchNodes(ctx, p.Factory, "")
+	if err != nil {
+		return nil, err
+	}
+
+	pp := nodePools(nn.Items, label)
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, p)
+	}
+
+	return oo, nil
+}
+
+// nodePools groups nodes by the value of the given label, skipping unlabeled nodes.
+func nodePools(nn []v1.Node, label string) []render.NodePoolRes {
+	pools := make(map[string]*render.NodePoolRes)
+	for i := range nn {
+		no := &nn[i]
+		name, ok := no.Labels[label]
+		if !ok {
+			continue
+		}
+		p, ok := pools[name]
+		if !ok {
+			p = &render.NodePoolRes{Name: name, Label: label}
+			pools[name] = p
+		}
+		p.Nodes++
+		if isNodeReady(no) {
+			p.Ready++
+		}
+		p.CPU += no.Status.Capacity.Cpu().MilliValue()
+		p.AllocatableCPU += no.Status.Allocatable.Cpu().MilliValue()
+		p.MEM += no.Status.Capacity.Memory().Value()
+		p.AllocatableMEM += no.Status.Allocatable.Memory().Value()
+	}
+
+	pp := make([]render.NodePoolRes, 0, len(pools))
+	for _, p := range pools {
+		pp = append(pp, *p)
+	}
+	slices.SortFunc(pp, func(a, b render.NodePoolRes) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return pp
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodePools(t *testing.T) {
+	nn := []v1.Node{
+		makePoolNode("n1", "b", true),
+		makePoolNode("n2", "a", true),
+		makePoolNode("n3", "b", false),
+		makePoolNode("n4", "", true),
+	}
+	nn[3].Labels = nil
+
+	pp := nodePools(nn, "pool")
+	assert.Equal(t, []render.NodePoolRes{
+		{Name: "a", Label: "pool", Nodes: 1, Ready: 1, CPU: 2000, AllocatableCPU: 1500, MEM: 4 << 30, AllocatableMEM: 3 << 30},
+		{Name: "b", Label: "pool", Nodes: 2, Ready: 1, CPU: 4000, AllocatableCPU: 3000, MEM: 8 << 30, AllocatableMEM: 6 << 30},
+	}, pp)
+}
+
+// Helpers...
+
+func makePoolNode(name, pool string, ready bool) v1.Node {
+	st := v1.ConditionFalse
+	if ready {
+		st = v1.ConditionTrue
+	}
+
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"pool": pool},
+		},
+		Status: v1.NodeStatus{
+			Capacity: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("2"),
+				v1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("1500m"),
+				v1.ResourceMemory: resource.MustParse("3Gi"),
+			},
+			Conditions: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: st},
+			},
+		},
+	}
+}
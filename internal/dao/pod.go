@@ -4,12 +4,18 @@
 package dao
 
 import (
-	"bufio"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,10 +28,17 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/kubectl/pkg/scheme"
+	"k8s.io/kubectl/pkg/util/term"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
@@ -41,8 +54,88 @@ var (
 const (
 	logRetryCount = 20
 	logRetryWait  = 1 * time.Second
+
+	debugContainerPrefix = "debugger-"
+
+	crashLogLines    = 50
+	crashLogsCount   = 10
+	crashEventsCount = 5
+
+	// SafeToEvictAnnotation tracks whether the cluster autoscaler may evict a pod.
+	SafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
 )
 
+var (
+	// DebugContainerInterval tracks how often to check for the debug container readiness.
+	DebugContainerInterval = time.Second
+
+	// DebugContainerTimeout tracks how long to wait for a debug container to start.
+	DebugContainerTimeout = 2 * time.Minute
+)
+
+// DefaultRestartWindow tracks the period over which recent pod restarts are reported.
+const DefaultRestartWindow = 10 * time.Minute
+
+type restartSample struct {
+	at    time.Time
+	count int32
+}
+
+// PodRestartTracker tracks per pod restart counts to report recent restarts.
+// Each pod view owns its tracker and hands it to the pod listings via the context.
+type PodRestartTracker struct {
+	window  time.Duration
+	samples map[string][]restartSample
+	mx      sync.Mutex
+}
+
+// NewPodRestartTracker returns a new instance reporting restarts over the given window.
+func NewPodRestartTracker(window time.Duration) *PodRestartTracker {
+	return &PodRestartTracker{
+		window:  window,
+		samples: make(map[string][]restartSample),
+	}
+}
+
+// Delta records the pod current restart count and returns the restarts that occurred
+// within the tracking window. Unknown pods or reset counts report no restarts.
+func (t *PodRestartTracker) Delta(podFQN string, count int32, now time.Time) int32 {
+	if t == nil {
+		return 0
+	}
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	ss := t.samples[podFQN]
+	if n := len(ss); n == 0 || count < ss[n-1].count {
+		ss = []restartSample{{at: now, count: count}}
+	} else if count > ss[n-1].count {
+		ss = append(ss, restartSample{at: now, count: count})
+	}
+	cutoff := now.Add(-t.window)
+	for len(ss) > 1 && !ss[1].at.After(cutoff) {
+		ss = ss[1:]
+	}
+	t.samples[podFQN] = ss
+
+	return count - ss[0].count
+}
+
+// Retain evicts the tracked pods not present in the given set.
+func (t *PodRestartTracker) Retain(fqns map[string]struct{}) {
+	if t == nil {
+		return
+	}
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	for fqn := range t.samples {
+		if _, ok := fqns[fqn]; !ok {
+			delete(t.samples, fqn)
+		}
+	}
+}
+
 // Pod represents a pod resource.
 type Pod struct {
 	Resource
@@ -95,16 +188,29 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return nil, err
 	}
 	nodeName := fsel["spec.nodeName"]
+	phases, _ := ctx.Value(internal.KeyPodPhases).([]v1.PodPhase)
+	restarts, _ := ctx.Value(internal.KeyPodRestarts).(*PodRestartTracker)
+	var ooms map[string]int
+	if pc := activePrometheus(p.Client()); pc != nil {
+		ooms = pc.CachedPodsOOMKills(ns)
+	}
 
 	res := make([]runtime.Object, 0, len(oo))
+	seen, now := make(map[string]struct{}, len(oo)), time.Now()
+	defer restarts.Retain(seen)
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
 		if !ok {
 			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
 		}
 		fqn := extractFQN(o)
+		seen[fqn] = struct{}{}
+		if !matchPodPhase(u, phases) {
+			continue
+		}
+		delta := restarts.Delta(fqn, podRestartCount(u), now)
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			res = append(res, podWithMetrics(u, pmx[fqn], ooms, fqn, delta))
 			continue
 		}
 
@@ -113,13 +219,23 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			res = append(res, podWithMetrics(u, pmx[fqn], ooms, fqn, delta))
 		}
 	}
 
 	return res, nil
 }
 
+// podWithMetrics decorates a pod with its metrics and restarts delta.
+func podWithMetrics(u *unstructured.Unstructured, mx *mv1beta1.PodMetrics, ooms map[string]int, fqn string, delta int32) *render.PodWithMetrics {
+	pwm := render.PodWithMetrics{Raw: u, MX: mx, RestartDelta: delta}
+	if c, ok := ooms[fqn]; ok {
+		pwm.OOMKills = &c
+	}
+
+	return &pwm
+}
+
 // Logs fetch container logs for a given pod and container.
 func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, n := client.Namespaced(path)
@@ -139,6 +255,13 @@ func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, er
 	return dial.CoreV1().Pods(ns).GetLogs(n, opts), nil
 }
 
+// StreamLogs streams a pod container parsed log lines. Snapshots end once all
+// matching lines are read while followed streams end when the context is canceled.
+// A stream failure is sent as the last line.
+func (p *Pod) StreamLogs(ctx context.Context, fqn string, opts LogStreamOptions) (<-chan LogLine, error) {
+	return streamLogs(ctx, p, fqn, opts.ToPodLogOptions())
+}
+
 // Containers returns all container names on pod.
 func (p *Pod) Containers(path string, includeInit bool) ([]string, error) {
 	pod, err := p.GetInstance(path)
@@ -337,33 +460,23 @@ func tailLogs(ctx context.Context, logger Logger, opts *LogOptions) LogChan {
 		defer wg.Done()
 		podOpts := opts.ToPodLogOptions()
 		for range logRetryCount {
-			req, err := logger.Logs(opts.Path, podOpts)
+			// This call will block if nothing is in the stream!!
+			lines, err := streamLogs(ctx, logger, opts.Path, podOpts)
 			if err == nil {
-				// This call will block if nothing is in the stream!!
-				stream, e := req.Stream(ctx)
-				if e == nil {
-					wg.Add(1)
-					go readLogs(ctx, &wg, stream, out, opts)
-					return
-				}
-				slog.Error("Stream logs failed",
-					slogs.Error, e,
-					slogs.Container, opts.Info(),
-				)
-			} else {
-				slog.Error("Log request failed",
-					slogs.Container, opts.Info(),
-					slogs.Error, err,
-				)
+				wg.Add(1)
+				go readLogs(ctx, &wg, lines, out, opts)
+				return
 			}
+			slog.Error("Stream logs failed",
+				slogs.Container, opts.Info(),
+				slogs.Error, err,
+			)
 
 			select {
 			case <-ctx.Done():
 				return
 			default:
-				if err != nil {
-					out <- opts.ToErrLogItem(err)
-				}
+				out <- opts.ToErrLogItem(err)
 				time.Sleep(logRetryWait)
 			}
 		}
@@ -376,35 +489,33 @@ func tailLogs(ctx context.Context, logger Logger, opts *LogOptions) LogChan {
 	return out
 }
 
-func readLogs(ctx context.Context, wg *sync.WaitGroup, stream io.ReadCloser, out chan<- *LogItem, opts *LogOptions) {
-	defer func() {
-		if err := stream.Close(); err != nil {
-			slog.Error("Fail to close stream",
-				slogs.Container, opts.Info(),
-				slogs.Error, err,
-			)
-		}
-		wg.Done()
-	}()
+func readLogs(ctx context.Context, wg *sync.WaitGroup, lines <-chan LogLine, out chan<- *LogItem, opts *LogOptions) {
+	defer wg.Done()
 
 	slog.Debug("Processing logs", slogs.Options, opts.Info())
-	r := bufio.NewReader(stream)
 	for {
 		var item *LogItem
-		if bytes, err := r.ReadBytes('\n'); err == nil {
-			item = opts.ToLogItem(tview.EscapeBytes(bytes))
-		} else {
-			if errors.Is(err, io.EOF) {
-				e := fmt.Errorf("stream closed %w for %s", err, opts.Info())
+		select {
+		case <-ctx.Done():
+			return
+		case l, ok := <-lines:
+			switch {
+			case !ok:
+				e := fmt.Errorf("stream closed %w for %s", io.EOF, opts.Info())
 				item = opts.ToErrLogItem(e)
 				slog.Warn("Log reader EOF",
 					slogs.Container, opts.Info(),
 					slogs.Error, e,
 				)
-			} else {
-				e := fmt.Errorf("stream canceled %w for %s", err, opts.Info())
+			case l.Err != nil:
+				e := fmt.Errorf("stream canceled %w for %s", l.Err, opts.Info())
 				item = opts.ToErrLogItem(e)
-				slog.Warn("Log stream canceled")
+				slog.Warn("Log stream canceled",
+					slogs.Container, opts.Info(),
+					slogs.Error, e,
+				)
+			default:
+				item = opts.ToLogItem(tview.EscapeBytes([]byte(l.raw + "\n")))
 			}
 		}
 		select {
@@ -439,7 +550,14 @@ func (p *Pod) GetPodSpec(path string) (*v1.PodSpec, error) {
 }
 
 // SetImages sets container images.
-func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(p.getFactory(), p.gvr, path, "set image", map[string]any{"images": imageSpecs}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := p.Client().CanI(ns, p.gvr, n, client.PatchAccess)
 	if err != nil {
@@ -474,6 +592,256 @@ func (p *Pod) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs)
 	return err
 }
 
+// AnnotateSafeToEvict sets the pod cluster autoscaler safe-to-evict annotation.
+func (p *Pod) AnnotateSafeToEvict(ctx context.Context, namespace, podName string, safe bool) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	path := client.FQN(namespace, podName)
+	defer func() {
+		auditAction(p.getFactory(), p.gvr, path, "annotate", map[string]any{SafeToEvictAnnotation: safe}, err)
+	}()
+
+	auth, err := p.Client().CanI(namespace, p.gvr, podName, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch pod %s", path)
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	return annotateSafeToEvict(ctx, dial, namespace, podName, safe)
+}
+
+func annotateSafeToEvict(ctx context.Context, dial kubernetes.Interface, ns, n string, safe bool) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{SafeToEvictAnnotation: strconv.FormatBool(safe)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().Pods(ns).Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
+
+// UnsafeToEvict returns the fully qualified names of the pods annotated as not safe to evict.
+func UnsafeToEvict(pp []*v1.Pod) []string {
+	fqns := make([]string, 0, len(pp))
+	for _, po := range pp {
+		if isUnsafeToEvict(po) {
+			fqns = append(fqns, client.FQN(po.Namespace, po.Name))
+		}
+	}
+	slices.Sort(fqns)
+
+	return fqns
+}
+
+func isUnsafeToEvict(po *v1.Pod) bool {
+	return po.Annotations[SafeToEvictAnnotation] == "false"
+}
+
+// AttachDebugSidecar adds an ephemeral debug container to a running pod and waits for it
+// to reach a running state. It returns the debug container name to exec into.
+func (p *Pod) AttachDebugSidecar(ctx context.Context, namespace, podName, debugImage string) (co string, err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return "", err
+	}
+	path := client.FQN(namespace, podName)
+	defer func() {
+		auditAction(p.getFactory(), p.gvr, path, "debug", map[string]any{"image": debugImage, "container": co}, err)
+	}()
+
+	if debugImage == "" {
+		return "", errors.New("no debug image specified")
+	}
+	auth, err := p.Client().CanI(namespace, client.PodGVR.WithSubResource("ephemeralcontainers"), podName, []string{client.UpdateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to add ephemeral containers to pod %s", path)
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return "", err
+	}
+	co, err = addDebugContainer(ctx, dial, namespace, podName, debugImage)
+	if err != nil {
+		return "", err
+	}
+
+	return co, waitForDebugContainer(ctx, dial, namespace, podName, co, DebugContainerInterval, DebugContainerTimeout)
+}
+
+// addDebugContainer patches a pod with a new ephemeral container targeting the pod
+// default container.
+func addDebugContainer(ctx context.Context, dial kubernetes.Interface, ns, n, image string) (string, error) {
+	pod, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	if pod.Status.Phase != v1.PodRunning {
+		return "", fmt.Errorf("pod %s is not running", client.FQN(ns, n))
+	}
+
+	co := debugContainerPrefix + rand.String(5)
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, v1.EphemeralContainer{
+		EphemeralContainerCommon: v1.EphemeralContainerCommon{
+			Name:                     co,
+			Image:                    image,
+			ImagePullPolicy:          v1.PullIfNotPresent,
+			Stdin:                    true,
+			TTY:                      true,
+			TerminationMessagePolicy: v1.TerminationMessageReadFile,
+		},
+		TargetContainerName: debugTargetContainer(pod),
+	})
+	if _, err := dial.CoreV1().Pods(ns).UpdateEphemeralContainers(ctx, n, pod, metav1.UpdateOptions{}); err != nil {
+		return "", err
+	}
+
+	return co, nil
+}
+
+// debugTargetContainer returns the container a debug sidecar shares its process namespace with.
+func debugTargetContainer(pod *v1.Pod) string {
+	if co, ok := GetDefaultContainer(&pod.ObjectMeta, &pod.Spec); ok {
+		return co
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+
+	return ""
+}
+
+// waitForDebugContainer waits for an ephemeral container to be running.
+func waitForDebugContainer(ctx context.Context, dial kubernetes.Interface, ns, n, co string, interval, timeout time.Duration) error {
+	return wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		pod, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for i := range pod.Status.EphemeralContainerStatuses {
+			st := pod.Status.EphemeralContainerStatuses[i]
+			if st.Name != co {
+				continue
+			}
+			switch {
+			case st.State.Running != nil:
+				return true, nil
+			case st.State.Terminated != nil:
+				return false, fmt.Errorf("debug container %s terminated: %s", co, st.State.Terminated.Reason)
+			case st.State.Waiting != nil && isImagePullFailure(st.State.Waiting.Reason):
+				return false, fmt.Errorf("debug container %s failed to start: %s", co, st.State.Waiting.Message)
+			}
+		}
+
+		return false, nil
+	})
+}
+
+func isImagePullFailure(reason string) bool {
+	switch reason {
+	case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+		return true
+	default:
+		return false
+	}
+}
+
+// RecordExec runs a command in a pod container attached to the current terminal
+// and records the session output to the given JSON lines file. The session input
+// is only recorded when recordInput is set as it may contain typed secrets.
+func (p *Pod) RecordExec(ctx context.Context, fqn, co string, command []string, recordPath string, recordInput bool) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(p.getFactory(), p.gvr, fqn, "exec", map[string]any{
+			"container":   co,
+			"command":     command,
+			"recording":   recordPath,
+			"recordInput": recordInput,
+		}, err)
+	}()
+
+	ns, n := client.Namespaced(fqn)
+	auth, err := p.Client().CanI(ns, client.PodGVR.WithSubResource("exec"), n, []string{client.CreateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to exec into pod %s", fqn)
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+	cfg, err := p.Client().RestConfig()
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(recordPath), 0o700); err != nil {
+		return fmt.Errorf("unable to create exec recording dir: %w", err)
+	}
+	f, err := os.OpenFile(recordPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("unable to create exec recording: %w", err)
+	}
+	defer func() {
+		if e := f.Close(); e != nil {
+			slog.Error("Exec recording close failed", slogs.Error, e)
+		}
+	}()
+
+	req := dial.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(ns).
+		Name(n).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: co,
+			Command:   command,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(cfg, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	rec := newExecRecorder(f)
+	tty := term.TTY{In: os.Stdin, Out: os.Stdout, Raw: true}
+	in := tty.In
+	if recordInput {
+		in = rec.Reader(ExecInput, in)
+	}
+	err = tty.Safe(func() error {
+		return exec.StreamWithContext(ctx, remotecommand.StreamOptions{
+			Stdin:             in,
+			Stdout:            rec.Writer(ExecOutput, tty.Out),
+			Tty:               true,
+			TerminalSizeQueue: tty.MonitorSize(tty.GetSize()),
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	return rec.Err()
+}
+
 func (p *Pod) isControlled(path string) (fqn string, ok bool, err error) {
 	pod, err := p.GetInstance(path)
 	if err != nil {
@@ -488,6 +856,9 @@ func (p *Pod) isControlled(path string) (fqn string, ok bool, err error) {
 }
 
 func (p *Pod) Sanitize(ctx context.Context, ns string) (int, error) {
+	if err := ReadonlyGuard(); err != nil {
+		return 0, err
+	}
 	oo, err := p.Resource.List(ctx, ns)
 	if err != nil {
 		return 0, err
@@ -538,3 +909,477 @@ func (p *Pod) Sanitize(ctx context.Context, ns string) (int, error) {
 
 	return count, nil
 }
+
+// podRestartCount returns the pod init and app containers total restart count.
+func podRestartCount(u *unstructured.Unstructured) int32 {
+	m, ok := u.Object["status"].(map[string]any)
+	if !ok {
+		return 0
+	}
+	var st v1.PodStatus
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &st); err != nil {
+		return 0
+	}
+	var count int32
+	for _, cc := range [][]v1.ContainerStatus{st.InitContainerStatuses, st.ContainerStatuses} {
+		for i := range cc {
+			count += cc[i].RestartCount
+		}
+	}
+
+	return count
+}
+
+// LogMatch tracks a log search match position.
+type LogMatch struct {
+	// Line tracks the matching line index.
+	Line int
+	// Start and End track the match byte offsets within the line.
+	Start, End int
+}
+
+// SearchLogs returns all matches of the given pattern in the log lines.
+// Empty matches are skipped.
+func SearchLogs(pattern *regexp.Regexp, lines []string) []LogMatch {
+	if pattern == nil {
+		return nil
+	}
+	var mm []LogMatch
+	for i, l := range lines {
+		for _, loc := range pattern.FindAllStringIndex(l, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			mm = append(mm, LogMatch{Line: i, Start: loc[0], End: loc[1]})
+		}
+	}
+
+	return mm
+}
+
+// ContainerRecommendation tracks a container VPA recommendation along with its current requests.
+type ContainerRecommendation struct {
+	Container  string
+	Requests   v1.ResourceList
+	Target     v1.ResourceList
+	LowerBound v1.ResourceList
+	UpperBound v1.ResourceList
+}
+
+// VPARecommendation tracks the VPA recommendations for a pod controller.
+type VPARecommendation struct {
+	// VPA tracks the recommender fully qualified name.
+	VPA string
+	// Namespace, Kind and Name track the controller the VPA targets.
+	Namespace, Kind, Name string
+	Containers            []ContainerRecommendation
+}
+
+// GetVPARecommendation returns the recommendations of the VPA targeting a pod controller.
+func (p *Pod) GetVPARecommendation(ctx context.Context, namespace, podName string) (*VPARecommendation, error) {
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	po, err := dial.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	kind, name, err := podWorkload(ctx, dial, po)
+	if err != nil {
+		return nil, err
+	}
+	if kind == "" {
+		return nil, fmt.Errorf("pod %s is not managed by a controller", client.FQN(namespace, podName))
+	}
+
+	dyn, err := p.Client().DynDial()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dyn.Resource(client.VpaGVR.GVR()).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to list vertical pod autoscalers: %w", err)
+	}
+
+	return vpaRecommendation(po, kind, name, ll.Items)
+}
+
+// vpaRecommendation extracts the recommendations of the VPA targeting the given controller.
+func vpaRecommendation(po *v1.Pod, kind, name string, vv []unstructured.Unstructured) (*VPARecommendation, error) {
+	for i := range vv {
+		var vpa struct {
+			Spec struct {
+				TargetRef struct {
+					Kind string `json:"kind"`
+					Name string `json:"name"`
+				} `json:"targetRef"`
+			} `json:"spec"`
+			Status struct {
+				Recommendation struct {
+					ContainerRecommendations []struct {
+						ContainerName string          `json:"containerName"`
+						Target        v1.ResourceList `json:"target"`
+						LowerBound    v1.ResourceList `json:"lowerBound"`
+						UpperBound    v1.ResourceList `json:"upperBound"`
+					} `json:"containerRecommendations"`
+				} `json:"recommendation"`
+			} `json:"status"`
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(vv[i].Object, &vpa); err != nil {
+			return nil, err
+		}
+		if vpa.Spec.TargetRef.Kind != kind || vpa.Spec.TargetRef.Name != name {
+			continue
+		}
+		fqn := client.FQN(vv[i].GetNamespace(), vv[i].GetName())
+		rr := vpa.Status.Recommendation.ContainerRecommendations
+		if len(rr) == 0 {
+			return nil, fmt.Errorf("vertical pod autoscaler %s has no recommendations yet", fqn)
+		}
+		rec := VPARecommendation{
+			VPA:        fqn,
+			Namespace:  po.Namespace,
+			Kind:       kind,
+			Name:       name,
+			Containers: make([]ContainerRecommendation, 0, len(rr)),
+		}
+		for _, r := range rr {
+			cr := ContainerRecommendation{
+				Container:  r.ContainerName,
+				Target:     r.Target,
+				LowerBound: r.LowerBound,
+				UpperBound: r.UpperBound,
+			}
+			for _, co := range po.Spec.Containers {
+				if co.Name == r.ContainerName {
+					cr.Requests = co.Resources.Requests
+					break
+				}
+			}
+			rec.Containers = append(rec.Containers, cr)
+		}
+
+		return &rec, nil
+	}
+
+	return nil, fmt.Errorf("no vertical pod autoscaler targets %s %s", kind, client.FQN(po.Namespace, name))
+}
+
+// ApplyVPARecommendation sets the controller containers requests to the VPA target recommendations.
+func (p *Pod) ApplyVPARecommendation(ctx context.Context, rec *VPARecommendation) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	gvr, path := workloadGVR(rec.Kind), client.FQN(rec.Namespace, rec.Name)
+	defer func() {
+		auditAction(p.getFactory(), gvr, path, "apply vpa recommendation", map[string]any{"vpa": rec.VPA}, err)
+	}()
+	if gvr == nil {
+		return fmt.Errorf("unsupported workload kind %q", rec.Kind)
+	}
+
+	auth, err := p.Client().CanI(rec.Namespace, gvr, rec.Name, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s %s", rec.Kind, path)
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	return applyVPARecommendation(ctx, dial, rec)
+}
+
+func applyVPARecommendation(ctx context.Context, dial kubernetes.Interface, rec *VPARecommendation) error {
+	cc := make([]map[string]any, 0, len(rec.Containers))
+	for _, c := range rec.Containers {
+		if len(c.Target) == 0 {
+			continue
+		}
+		cc = append(cc, map[string]any{
+			"name":      c.Container,
+			"resources": map[string]any{"requests": c.Target},
+		})
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{"containers": cc},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch rec.Kind {
+	case "Deployment":
+		_, err = dial.AppsV1().Deployments(rec.Namespace).Patch(ctx, rec.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "StatefulSet":
+		_, err = dial.AppsV1().StatefulSets(rec.Namespace).Patch(ctx, rec.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "DaemonSet":
+		_, err = dial.AppsV1().DaemonSets(rec.Namespace).Patch(ctx, rec.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "ReplicaSet":
+		_, err = dial.AppsV1().ReplicaSets(rec.Namespace).Patch(ctx, rec.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("unsupported workload kind %q", rec.Kind)
+	}
+
+	return err
+}
+
+// workloadGVR returns the resource of a pod controller kind.
+func workloadGVR(kind string) *client.GVR {
+	switch kind {
+	case "Deployment":
+		return client.DpGVR
+	case "StatefulSet":
+		return client.StsGVR
+	case "DaemonSet":
+		return client.DsGVR
+	case "ReplicaSet":
+		return client.RsGVR
+	default:
+		return nil
+	}
+}
+
+// CrashAnalysis tracks the likely causes of a container crash loop.
+type CrashAnalysis struct {
+	Container   string   `json:"container"`
+	Restarts    int32    `json:"restarts"`
+	ExitCode    int32    `json:"exitCode"`
+	Reason      string   `json:"reason,omitempty"`
+	Causes      []string `json:"causes"`
+	Suggestions []string `json:"suggestions"`
+	// Events tracks the pod most recent warning event messages.
+	Events []string `json:"events,omitempty"`
+	// Logs tracks the previous container trailing log lines.
+	Logs []string `json:"logs,omitempty"`
+}
+
+func (a *CrashAnalysis) add(cause, suggestion string) {
+	if !slices.Contains(a.Causes, cause) {
+		a.Causes = append(a.Causes, cause)
+	}
+	if !slices.Contains(a.Suggestions, suggestion) {
+		a.Suggestions = append(a.Suggestions, suggestion)
+	}
+}
+
+// AnalyzeCrashLoop inspects a crashing container previous logs, termination state and
+// the pod events to surface the likely crash causes. When no container is given, the
+// first crashing container is analyzed.
+func (p *Pod) AnalyzeCrashLoop(ctx context.Context, namespace, podName, container string) (*CrashAnalysis, error) {
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return analyzeCrashLoop(ctx, dial, namespace, podName, container)
+}
+
+func analyzeCrashLoop(ctx context.Context, dial kubernetes.Interface, ns, n, co string) (*CrashAnalysis, error) {
+	po, err := dial.CoreV1().Pods(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	cs, err := crashedContainer(po, co)
+	if err != nil {
+		return nil, err
+	}
+
+	var ll []string
+	lines := int64(crashLogLines)
+	bb, err := dial.CoreV1().Pods(ns).GetLogs(n, &v1.PodLogOptions{
+		Container: cs.Name,
+		Previous:  true,
+		TailLines: &lines,
+	}).DoRaw(ctx)
+	if err != nil {
+		slog.Debug("Unable to fetch previous container logs",
+			slogs.FQN, client.FQN(ns, n),
+			slogs.Container, cs.Name,
+			slogs.Error, err,
+		)
+	} else {
+		ll = strings.Split(strings.TrimRight(string(bb), "\n"), "\n")
+	}
+
+	var ee []v1.Event
+	sel := fields.SelectorFromSet(fields.Set{
+		"involvedObject.name": n,
+		"involvedObject.kind": "Pod",
+		"involvedObject.uid":  string(po.UID),
+	})
+	el, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{FieldSelector: sel.String()})
+	if err != nil {
+		slog.Debug("Unable to fetch pod events",
+			slogs.FQN, client.FQN(ns, n),
+			slogs.Error, err,
+		)
+	} else {
+		ee = el.Items
+	}
+
+	return diagnoseCrash(podContainer(po, cs.Name), cs, ll, ee), nil
+}
+
+// crashedContainer returns the named container status or the first crashing one.
+func crashedContainer(po *v1.Pod, co string) (*v1.ContainerStatus, error) {
+	ss := slices.Concat(po.Status.InitContainerStatuses, po.Status.ContainerStatuses)
+	if co != "" {
+		for i := range ss {
+			if ss[i].Name == co {
+				return &ss[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no container %q found in pod %s", co, MetaFQN(&po.ObjectMeta))
+	}
+
+	var restarted *v1.ContainerStatus
+	for i := range ss {
+		if w := ss[i].State.Waiting; w != nil && w.Reason == render.PhaseCrashLoop {
+			return &ss[i], nil
+		}
+		if restarted == nil && ss[i].RestartCount > 0 {
+			restarted = &ss[i]
+		}
+	}
+	if restarted == nil {
+		return nil, fmt.Errorf("pod %s has no crashing containers", MetaFQN(&po.ObjectMeta))
+	}
+
+	return restarted, nil
+}
+
+func podContainer(po *v1.Pod, co string) *v1.Container {
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for i := range cc {
+			if cc[i].Name == co {
+				return &cc[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// crashLogHints tracks log patterns hinting at a crash cause.
+var crashLogHints = []struct {
+	rx                *regexp.Regexp
+	cause, suggestion string
+}{
+	{
+		rx:         regexp.MustCompile(`(?i)permission denied`),
+		cause:      "logs report a permission denied error",
+		suggestion: "Check the container securityContext user and the mounted files permissions",
+	},
+	{
+		rx:         regexp.MustCompile(`(?i)no such file or directory`),
+		cause:      "logs report a missing file",
+		suggestion: "Check the container volume mounts and the configmaps or secrets keys it reads",
+	},
+	{
+		rx:         regexp.MustCompile(`(?i)connection refused|no such host|i/o timeout`),
+		cause:      "logs report an unreachable dependency",
+		suggestion: "Check the services the application depends on and the network policies",
+	},
+	{
+		rx:         regexp.MustCompile(`(?i)panic:|fatal error:|exception`),
+		cause:      "logs report an application crash",
+		suggestion: "Inspect the previous container logs stack trace",
+	},
+}
+
+// diagnoseCrash runs simple heuristics on a container termination state, previous
+// logs and pod events.
+func diagnoseCrash(co *v1.Container, cs *v1.ContainerStatus, logs []string, ee []v1.Event) *CrashAnalysis {
+	a := CrashAnalysis{
+		Container: cs.Name,
+		Restarts:  cs.RestartCount,
+	}
+
+	t := cs.LastTerminationState.Terminated
+	if t == nil {
+		t = cs.State.Terminated
+	}
+	if t != nil {
+		a.ExitCode, a.Reason = t.ExitCode, t.Reason
+		switch {
+		case t.Reason == render.PhaseOOMKilled:
+			a.add("container was OOM killed", oomSuggestion(co))
+		case t.Reason == "StartError" || t.Reason == "ContainerCannotRun":
+			a.add("container failed to start: "+t.Message, "Check the container command, args, volume mounts and image")
+		case t.ExitCode == 0:
+			a.add("container exited successfully and was restarted",
+				"Make sure the container runs a long lived process or use a Job for run to completion tasks")
+		case t.ExitCode == 126:
+			a.add("container command is not executable", "Check the command file permissions and the image entrypoint")
+		case t.ExitCode == 127:
+			a.add("container command was not found", "Check the container command, args and the image entrypoint")
+		case t.ExitCode == 137:
+			a.add("container was killed (SIGKILL)", "Check the liveness probe settings and the node memory pressure")
+		case t.ExitCode == 143:
+			a.add("container was terminated (SIGTERM)", "Check the liveness probe settings and the application shutdown handling")
+		default:
+			a.add(fmt.Sprintf("application error (exit code %d)", t.ExitCode), "Inspect the previous container logs for the application error")
+		}
+	}
+
+	slices.SortFunc(ee, func(e1, e2 v1.Event) int {
+		return e2.LastTimestamp.Compare(e1.LastTimestamp.Time)
+	})
+	for i := range ee {
+		e := &ee[i]
+		if e.Type != v1.EventTypeWarning {
+			continue
+		}
+		if len(a.Events) < crashEventsCount {
+			a.Events = append(a.Events, e.Message)
+		}
+		switch {
+		case strings.HasPrefix(e.Message, "Liveness probe failed"):
+			a.add("liveness probe is failing",
+				"Check the liveness probe endpoint or raise its initialDelaySeconds and failureThreshold")
+		case strings.HasPrefix(e.Message, "Startup probe failed"):
+			a.add("startup probe is failing",
+				"Raise the startup probe failureThreshold to allow for the application startup time")
+		}
+	}
+
+	for _, l := range logs {
+		if l = strings.TrimSpace(l); l == "" {
+			continue
+		}
+		a.Logs = append(a.Logs, l)
+		for _, h := range crashLogHints {
+			if h.rx.MatchString(l) {
+				a.add(h.cause, h.suggestion)
+			}
+		}
+	}
+	if len(a.Logs) > crashLogsCount {
+		a.Logs = a.Logs[len(a.Logs)-crashLogsCount:]
+	}
+
+	if len(a.Causes) == 0 {
+		a.add("unable to determine the crash cause", "Inspect the previous container logs and the pod events")
+	}
+
+	return &a
+}
+
+func oomSuggestion(co *v1.Container) string {
+	if co != nil {
+		if q, ok := co.Resources.Limits[v1.ResourceMemory]; ok {
+			return fmt.Sprintf("Raise the container memory limit (currently %s) or reduce the application memory usage", q.String())
+		}
+	}
+
+	return "Set the container memory requests to match the application usage as the node ran out of memory"
+}
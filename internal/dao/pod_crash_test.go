@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestCrashedContainer(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1"},
+				{Name: "c2", RestartCount: 2},
+				{
+					Name:         "c3",
+					RestartCount: 1,
+					State: v1.ContainerState{
+						Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+					},
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		pod  v1.Pod
+		co   string
+		e    string
+		fail bool
+	}{
+		"named": {
+			pod: po,
+			co:  "c1",
+			e:   "c1",
+		},
+		"crash-loop": {
+			pod: po,
+			e:   "c3",
+		},
+		"restarted": {
+			pod: v1.Pod{Status: v1.PodStatus{ContainerStatuses: po.Status.ContainerStatuses[:2]}},
+			e:   "c2",
+		},
+		"no-crash": {
+			pod:  v1.Pod{Status: v1.PodStatus{ContainerStatuses: po.Status.ContainerStatuses[:1]}},
+			fail: true,
+		},
+		"unknown": {
+			pod:  po,
+			co:   "c4",
+			fail: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cs, err := crashedContainer(&u.pod, u.co)
+			if u.fail {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, cs.Name)
+		})
+	}
+}
+
+func TestDiagnoseCrash(t *testing.T) {
+	co := v1.Container{
+		Name: "c1",
+		Resources: v1.ResourceRequirements{
+			Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")},
+		},
+	}
+	terminated := func(code int32, reason string) *v1.ContainerStatus {
+		return &v1.ContainerStatus{
+			Name:         "c1",
+			RestartCount: 3,
+			LastTerminationState: v1.ContainerState{
+				Terminated: &v1.ContainerStateTerminated{ExitCode: code, Reason: reason},
+			},
+		}
+	}
+
+	uu := map[string]struct {
+		cs          *v1.ContainerStatus
+		logs        []string
+		ee          []v1.Event
+		causes      []string
+		suggestions []string
+	}{
+		"oom": {
+			cs:          terminated(137, "OOMKilled"),
+			causes:      []string{"container was OOM killed"},
+			suggestions: []string{"Raise the container memory limit (currently 128Mi) or reduce the application memory usage"},
+		},
+		"app-error": {
+			cs:     terminated(1, "Error"),
+			logs:   []string{"starting...", "open /etc/app/config.yaml: no such file or directory"},
+			causes: []string{"application error (exit code 1)", "logs report a missing file"},
+			suggestions: []string{
+				"Inspect the previous container logs for the application error",
+				"Check the container volume mounts and the configmaps or secrets keys it reads",
+			},
+		},
+		"liveness": {
+			cs: terminated(137, "Error"),
+			ee: []v1.Event{
+				{Type: v1.EventTypeWarning, Message: "Liveness probe failed: HTTP probe failed with statuscode: 500"},
+				{Type: v1.EventTypeNormal, Message: "Killing container"},
+			},
+			causes: []string{"container was killed (SIGKILL)", "liveness probe is failing"},
+			suggestions: []string{
+				"Check the liveness probe settings and the node memory pressure",
+				"Check the liveness probe endpoint or raise its initialDelaySeconds and failureThreshold",
+			},
+		},
+		"unknown": {
+			cs:          &v1.ContainerStatus{Name: "c1"},
+			causes:      []string{"unable to determine the crash cause"},
+			suggestions: []string{"Inspect the previous container logs and the pod events"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := diagnoseCrash(&co, u.cs, u.logs, u.ee)
+			assert.Equal(t, u.causes, a.Causes)
+			assert.Equal(t, u.suggestions, a.Suggestions)
+		})
+	}
+}
+
+func TestAnalyzeCrashLoop(t *testing.T) {
+	now := time.Now()
+	dial := fake.NewClientset(
+		&v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1", UID: "u1"},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1"}},
+			},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:         "c1",
+						RestartCount: 5,
+						State: v1.ContainerState{
+							Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+						},
+						LastTerminationState: v1.ContainerState{
+							Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"},
+						},
+					},
+				},
+			},
+		},
+		&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "ns1", Name: "e1"},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1", UID: "u1"},
+			Type:           v1.EventTypeWarning,
+			Message:        "Back-off restarting failed container",
+			LastTimestamp:  metav1.NewTime(now.Add(-time.Minute)),
+		},
+		&v1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "ns1", Name: "e2"},
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "p1", UID: "u1"},
+			Type:           v1.EventTypeWarning,
+			Message:        "Startup probe failed: connection refused",
+			LastTimestamp:  metav1.NewTime(now),
+		},
+	)
+
+	var sel string
+	dial.PrependReactor("list", "events", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		sel = a.(k8stesting.ListAction).GetListRestrictions().Fields.String()
+		return false, nil, nil
+	})
+
+	a, err := analyzeCrashLoop(context.Background(), dial, "ns1", "p1", "")
+	require.NoError(t, err)
+	assert.Contains(t, sel, "involvedObject.uid=u1")
+	assert.Equal(t, "c1", a.Container)
+	assert.Equal(t, int32(5), a.Restarts)
+	assert.Equal(t, int32(1), a.ExitCode)
+	assert.Equal(t, []string{"application error (exit code 1)", "startup probe is failing"}, a.Causes)
+	assert.Equal(t, []string{
+		"Startup probe failed: connection refused",
+		"Back-off restarting failed container",
+	}, a.Events)
+	assert.Equal(t, []string{"fake logs"}, a.Logs)
+
+	_, err = analyzeCrashLoop(context.Background(), dial, "ns1", "p2", "")
+	require.Error(t, err)
+
+	dial.PrependReactor("list", "events", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("boom")
+	})
+	a, err = analyzeCrashLoop(context.Background(), dial, "ns1", "p1", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"application error (exit code 1)"}, a.Causes)
+	assert.Empty(t, a.Events)
+}
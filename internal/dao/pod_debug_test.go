@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAddDebugContainer(t *testing.T) {
+	uu := map[string]struct {
+		pod    *v1.Pod
+		target string
+		err    string
+	}{
+		"first-container": {
+			pod:    debugPod(v1.PodRunning, nil),
+			target: "c1",
+		},
+		"default-container": {
+			pod:    debugPod(v1.PodRunning, map[string]string{DefaultContainerAnnotation: "c2"}),
+			target: "c2",
+		},
+		"not-running": {
+			pod: debugPod(v1.PodPending, nil),
+			err: "pod default/p1 is not running",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewClientset(u.pod)
+			co, err := addDebugContainer(context.Background(), dial, "default", "p1", "busybox:1.36")
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(co, debugContainerPrefix))
+
+			po, err := dial.CoreV1().Pods("default").Get(context.Background(), "p1", metav1.GetOptions{})
+			require.NoError(t, err)
+			require.Len(t, po.Spec.EphemeralContainers, 1)
+			ec := po.Spec.EphemeralContainers[0]
+			assert.Equal(t, co, ec.Name)
+			assert.Equal(t, "busybox:1.36", ec.Image)
+			assert.Equal(t, u.target, ec.TargetContainerName)
+			assert.True(t, ec.Stdin)
+			assert.True(t, ec.TTY)
+		})
+	}
+}
+
+func TestWaitForDebugContainer(t *testing.T) {
+	uu := map[string]struct {
+		state v1.ContainerState
+		err   string
+	}{
+		"running": {
+			state: v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+		},
+		"terminated": {
+			state: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error"}},
+			err:   "debug container dbg terminated: Error",
+		},
+		"pull-failure": {
+			state: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "bozo"}},
+			err:   "debug container dbg failed to start: bozo",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := debugPod(v1.PodRunning, nil)
+			po.Status.EphemeralContainerStatuses = []v1.ContainerStatus{{Name: "dbg", State: u.state}}
+			dial := fake.NewClientset(po)
+
+			err := waitForDebugContainer(context.Background(), dial, "default", "p1", "dbg", time.Millisecond, time.Second)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// Helpers...
+
+func debugPod(phase v1.PodPhase, ann map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default", Annotations: ann},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: "c1"}, {Name: "c2"}},
+		},
+		Status: v1.PodStatus{Phase: phase},
+	}
+}
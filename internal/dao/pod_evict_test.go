@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAnnotateSafeToEvict(t *testing.T) {
+	uu := map[string]struct {
+		pod  *v1.Pod
+		safe bool
+		e    map[string]string
+		err  string
+	}{
+		"safe": {
+			pod:  evictPod("p1", map[string]string{SafeToEvictAnnotation: "false", "a": "b"}),
+			safe: true,
+			e:    map[string]string{SafeToEvictAnnotation: "true", "a": "b"},
+		},
+		"unsafe": {
+			pod: evictPod("p1", nil),
+			e:   map[string]string{SafeToEvictAnnotation: "false"},
+		},
+		"missing": {
+			pod: evictPod("p2", nil),
+			err: `pods "p1" not found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewClientset(u.pod)
+			err := annotateSafeToEvict(context.Background(), dial, "ns1", "p1", u.safe)
+			if u.err != "" {
+				require.Error(t, err)
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			po, err := dial.CoreV1().Pods("ns1").Get(context.Background(), "p1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, u.e, po.Annotations)
+		})
+	}
+}
+
+func TestUnsafeToEvict(t *testing.T) {
+	pp := []*v1.Pod{
+		evictPod("p3", map[string]string{SafeToEvictAnnotation: "false"}),
+		evictPod("p1", map[string]string{SafeToEvictAnnotation: "true"}),
+		evictPod("p2", nil),
+		evictPod("p0", map[string]string{SafeToEvictAnnotation: "false"}),
+	}
+
+	assert.Equal(t, []string{"ns1/p0", "ns1/p3"}, UnsafeToEvict(pp))
+	assert.Empty(t, UnsafeToEvict(nil))
+}
+
+func TestSafeToEvictFilter(t *testing.T) {
+	unsafe := evictPod("p1", map[string]string{SafeToEvictAnnotation: "false"})
+
+	st := DrainOptions{}.safeToEvictFilter(*unsafe)
+	assert.False(t, st.Delete)
+	assert.Equal(t, "pod ns1/p1 is annotated cluster-autoscaler.kubernetes.io/safe-to-evict=false", st.Message)
+	assert.True(t, DrainOptions{MarkSafeToEvict: true}.safeToEvictFilter(*unsafe).Delete)
+	assert.True(t, DrainOptions{}.safeToEvictFilter(*evictPod("p2", nil)).Delete)
+}
+
+func TestMarkSafeToEvict(t *testing.T) {
+	pp := []*v1.Pod{
+		evictPod("p1", map[string]string{SafeToEvictAnnotation: "false"}),
+		evictPod("p2", nil),
+		evictPod("p3", map[string]string{SafeToEvictAnnotation: "false"}),
+	}
+	dial := fake.NewClientset(pp[0], pp[1], pp[2])
+	var aa []string
+	audit := func(_ *client.GVR, path, _ string, params map[string]any, err error) {
+		aa = append(aa, fmt.Sprintf("%s=%v %t", path, params[SafeToEvictAnnotation], err == nil))
+	}
+
+	ctx := context.Background()
+	restore, err := markSafeToEvict(ctx, dial, pp, audit)
+	require.NoError(t, err)
+	for _, n := range []string{"p1", "p3"} {
+		po, err := dial.CoreV1().Pods("ns1").Get(ctx, n, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "true", po.Annotations[SafeToEvictAnnotation])
+	}
+
+	require.NoError(t, dial.CoreV1().Pods("ns1").Delete(ctx, "p1", metav1.DeleteOptions{}))
+	restore()
+	po, err := dial.CoreV1().Pods("ns1").Get(ctx, "p3", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "false", po.Annotations[SafeToEvictAnnotation])
+	po, err = dial.CoreV1().Pods("ns1").Get(ctx, "p2", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, po.Annotations)
+	assert.Equal(t, []string{"ns1/p1=true true", "ns1/p3=true true", "ns1/p3=false true"}, aa)
+}
+
+// Helpers...
+
+func evictPod(n string, aa map[string]string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "ns1", Annotations: aa},
+	}
+}
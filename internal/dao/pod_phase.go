@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PodPhaseFilter tracks a pods phase filter.
+type PodPhaseFilter int
+
+const (
+	// PodPhaseAll shows pods in all phases.
+	PodPhaseAll PodPhaseFilter = iota
+
+	// PodPhaseRunning only shows running pods.
+	PodPhaseRunning
+
+	// PodPhaseNonRunning only shows pods that are not running.
+	PodPhaseNonRunning
+)
+
+var nonRunningPhases = []v1.PodPhase{v1.PodPending, v1.PodSucceeded, v1.PodFailed, v1.PodUnknown}
+
+// Next returns the next filter in the All/Running/NonRunning cycle.
+func (f PodPhaseFilter) Next() PodPhaseFilter {
+	return (f + 1) % (PodPhaseNonRunning + 1)
+}
+
+// String returns the filter name.
+func (f PodPhaseFilter) String() string {
+	switch f {
+	case PodPhaseRunning:
+		return "Running"
+	case PodPhaseNonRunning:
+		return "NonRunning"
+	default:
+		return "All"
+	}
+}
+
+// Phases returns the pod phases matching the filter. All phases match when empty.
+func (f PodPhaseFilter) Phases() []v1.PodPhase {
+	switch f {
+	case PodPhaseRunning:
+		return []v1.PodPhase{v1.PodRunning}
+	case PodPhaseNonRunning:
+		return nonRunningPhases
+	default:
+		return nil
+	}
+}
+
+func filterPodsByPhase(pp []*v1.Pod, phases []v1.PodPhase) []*v1.Pod {
+	if len(phases) == 0 {
+		return pp
+	}
+
+	return slices.DeleteFunc(pp, func(po *v1.Pod) bool {
+		return !slices.Contains(phases, po.Status.Phase)
+	})
+}
+
+func matchPodPhase(u *unstructured.Unstructured, phases []v1.PodPhase) bool {
+	if len(phases) == 0 {
+		return true
+	}
+	phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+
+	return slices.Contains(phases, v1.PodPhase(phase))
+}
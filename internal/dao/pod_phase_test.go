@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodPhaseFilterNext(t *testing.T) {
+	f := PodPhaseAll
+	var ss []string
+	for range 4 {
+		f = f.Next()
+		ss = append(ss, f.String())
+	}
+
+	assert.Equal(t, []string{"Running", "NonRunning", "All", "Running"}, ss)
+}
+
+func TestFilterPodsByPhase(t *testing.T) {
+	uu := map[string]struct {
+		f PodPhaseFilter
+		e []string
+	}{
+		"all": {
+			f: PodPhaseAll,
+			e: []string{"p1", "p2", "p3", "p4"},
+		},
+		"running": {
+			f: PodPhaseRunning,
+			e: []string{"p1"},
+		},
+		"non-running": {
+			f: PodPhaseNonRunning,
+			e: []string{"p2", "p3", "p4"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pp := []*v1.Pod{
+				makePhasePod("p1", v1.PodRunning),
+				makePhasePod("p2", v1.PodSucceeded),
+				makePhasePod("p3", v1.PodFailed),
+				makePhasePod("p4", v1.PodPending),
+			}
+			var nn []string
+			for _, po := range filterPodsByPhase(pp, u.f.Phases()) {
+				nn = append(nn, po.Name)
+			}
+			assert.Equal(t, u.e, nn)
+		})
+	}
+}
+
+func TestMatchPodPhase(t *testing.T) {
+	u := makeNodePod("n1", v1.PodSucceeded)
+
+	assert.True(t, matchPodPhase(u, nil))
+	assert.True(t, matchPodPhase(u, PodPhaseNonRunning.Phases()))
+	assert.False(t, matchPodPhase(u, PodPhaseRunning.Phases()))
+}
+
+// Helpers...
+
+func makePhasePod(name string, phase v1.PodPhase) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     v1.PodStatus{Phase: phase},
+	}
+}
@@ -4,11 +4,16 @@
 package dao
 
 import (
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestGetDefaultContainer(t *testing.T) {
@@ -57,3 +62,84 @@ func TestGetDefaultContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestPodRestartTrackerDelta(t *testing.T) {
+	tr := NewPodRestartTracker(10 * time.Minute)
+	t0 := time.Now()
+
+	assert.Equal(t, int32(0), tr.Delta("ns1/p1", 39, t0))
+	assert.Equal(t, int32(3), tr.Delta("ns1/p1", 42, t0.Add(time.Minute)))
+	assert.Equal(t, int32(3), tr.Delta("ns1/p1", 42, t0.Add(2*time.Minute)))
+	assert.Equal(t, int32(4), tr.Delta("ns1/p1", 43, t0.Add(5*time.Minute)))
+	assert.Equal(t, int32(1), tr.Delta("ns1/p1", 43, t0.Add(12*time.Minute)))
+	assert.Equal(t, int32(0), tr.Delta("ns1/p1", 43, t0.Add(16*time.Minute)))
+	assert.Equal(t, int32(0), tr.Delta("ns1/p2", 1, t0))
+	assert.Equal(t, int32(0), tr.Delta("ns1/p1", 0, t0.Add(17*time.Minute)))
+	assert.Equal(t, int32(2), tr.Delta("ns1/p1", 2, t0.Add(18*time.Minute)))
+
+	var none *PodRestartTracker
+	assert.Equal(t, int32(0), none.Delta("ns1/p1", 2, t0))
+}
+
+func TestPodRestartTrackerRetain(t *testing.T) {
+	tr := NewPodRestartTracker(10 * time.Minute)
+	t0 := time.Now()
+	tr.Delta("ns1/p1", 1, t0)
+	tr.Delta("ns1/p2", 1, t0)
+
+	tr.Retain(map[string]struct{}{"ns1/p2": {}})
+	assert.Equal(t, int32(0), tr.Delta("ns1/p1", 3, t0.Add(time.Minute)))
+	assert.Equal(t, int32(2), tr.Delta("ns1/p2", 3, t0.Add(time.Minute)))
+}
+
+func TestPodRestartCount(t *testing.T) {
+	po := v1.Pod{
+		Status: v1.PodStatus{
+			InitContainerStatuses: []v1.ContainerStatus{{Name: "i1", RestartCount: 1}},
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", RestartCount: 2},
+				{Name: "c2", RestartCount: 3},
+			},
+		},
+	}
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(6), podRestartCount(&unstructured.Unstructured{Object: o}))
+	assert.Equal(t, int32(0), podRestartCount(&unstructured.Unstructured{Object: map[string]any{}}))
+}
+
+func TestSearchLogs(t *testing.T) {
+	lines := []string{"starting up", "ERROR boom", "ok", "error again and Error"}
+	uu := map[string]struct {
+		rx *regexp.Regexp
+		e  []LogMatch
+	}{
+		"none": {},
+		"no-match": {
+			rx: regexp.MustCompile(`fred`),
+		},
+		"case-sensitive": {
+			rx: regexp.MustCompile(`ERROR`),
+			e:  []LogMatch{{Line: 1, Start: 0, End: 5}},
+		},
+		"multi": {
+			rx: regexp.MustCompile(`(?i)error`),
+			e: []LogMatch{
+				{Line: 1, Start: 0, End: 5},
+				{Line: 3, Start: 0, End: 5},
+				{Line: 3, Start: 16, End: 21},
+			},
+		},
+		"empty-matches": {
+			rx: regexp.MustCompile(`x*`),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, SearchLogs(u.rx, lines))
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestVPARecommendation(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "c1", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")}}},
+				{Name: "c2"},
+			},
+		},
+	}
+	vv := []unstructured.Unstructured{
+		makeVPA("v1", "Deployment", "other", nil),
+		makeVPA("v2", "Deployment", "dp1", []any{
+			map[string]any{
+				"containerName": "c1",
+				"target":        map[string]any{"cpu": "250m", "memory": "128Mi"},
+				"lowerBound":    map[string]any{"cpu": "200m"},
+				"upperBound":    map[string]any{"cpu": "1"},
+			},
+		}),
+		makeVPA("v3", "StatefulSet", "sts1", nil),
+	}
+
+	uu := map[string]struct {
+		kind, name string
+		e          *VPARecommendation
+		err        string
+	}{
+		"happy": {
+			kind: "Deployment",
+			name: "dp1",
+			e: &VPARecommendation{
+				VPA:       "ns1/v2",
+				Namespace: "ns1",
+				Kind:      "Deployment",
+				Name:      "dp1",
+				Containers: []ContainerRecommendation{
+					{
+						Container:  "c1",
+						Requests:   v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")},
+						Target:     v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m"), v1.ResourceMemory: resource.MustParse("128Mi")},
+						LowerBound: v1.ResourceList{v1.ResourceCPU: resource.MustParse("200m")},
+						UpperBound: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+					},
+				},
+			},
+		},
+		"no-recommendations": {
+			kind: "StatefulSet",
+			name: "sts1",
+			err:  "vertical pod autoscaler ns1/v3 has no recommendations yet",
+		},
+		"no-vpa": {
+			kind: "DaemonSet",
+			name: "ds1",
+			err:  "no vertical pod autoscaler targets DaemonSet ns1/ds1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rec, err := vpaRecommendation(&po, u.kind, u.name, vv)
+			if u.err != "" {
+				require.EqualError(t, err, u.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, rec)
+		})
+	}
+}
+
+func TestApplyVPARecommendation(t *testing.T) {
+	dp := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "dp1"},
+		Spec: appsv1.DeploymentSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					Containers: []v1.Container{
+						{Name: "c1", Image: "fred", Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m")}}},
+						{Name: "c2", Image: "blee"},
+					},
+				},
+			},
+		},
+	}
+	dial := fake.NewClientset(&dp)
+	rec := VPARecommendation{
+		Namespace: "ns1",
+		Kind:      "Deployment",
+		Name:      "dp1",
+		Containers: []ContainerRecommendation{
+			{Container: "c1", Target: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")}},
+			{Container: "c2"},
+		},
+	}
+	require.NoError(t, applyVPARecommendation(context.Background(), dial, &rec))
+
+	o, err := dial.AppsV1().Deployments("ns1").Get(context.Background(), "dp1", metav1.GetOptions{})
+	require.NoError(t, err)
+	cc := o.Spec.Template.Spec.Containers
+	require.Len(t, cc, 2)
+	assert.Equal(t, "250m", cc[0].Resources.Requests.Cpu().String())
+	assert.Equal(t, "fred", cc[0].Image)
+	assert.Empty(t, cc[1].Resources.Requests)
+
+	rec.Kind = "Job"
+	require.EqualError(t, applyVPARecommendation(context.Background(), dial, &rec), `unsupported workload kind "Job"`)
+}
+
+func makeVPA(n, kind, target string, rr []any) unstructured.Unstructured {
+	o := map[string]any{
+		"apiVersion": "autoscaling.k8s.io/v1",
+		"kind":       "VerticalPodAutoscaler",
+		"metadata":   map[string]any{"namespace": "ns1", "name": n},
+		"spec": map[string]any{
+			"targetRef": map[string]any{"apiVersion": "apps/v1", "kind": kind, "name": target},
+		},
+	}
+	if rr != nil {
+		o["status"] = map[string]any{
+			"recommendation": map[string]any{"containerRecommendations": rr},
+		}
+	}
+
+	return unstructured.Unstructured{Object: o}
+}
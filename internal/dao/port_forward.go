@@ -4,11 +4,14 @@
 package dao
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log/slog"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -17,6 +20,7 @@ import (
 	"github.com/derailed/k9s/internal/slogs"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/portforward"
 )
 
 var (
@@ -24,6 +28,9 @@ var (
 	_ Nuker    = (*PortForward)(nil)
 )
 
+// PortForwards tracks the port-forwards started during this session.
+var PortForwards = NewPortForwardRegistry()
+
 // PortForward represents a port forward dao.
 type PortForward struct {
 	NonResource
@@ -32,6 +39,7 @@ type PortForward struct {
 // Delete deletes a portforward.
 func (p *PortForward) Delete(_ context.Context, path string, _ *metav1.DeletionPropagation, _ Grace) error {
 	p.getFactory().DeleteForwarder(path)
+	PortForwards.Remove(path)
 
 	return nil
 }
@@ -49,9 +57,10 @@ func (p *PortForward) List(ctx context.Context, _ string) ([]runtime.Object, err
 		slog.Debug("No custom benchmark config file found", slogs.FileName, benchFile)
 	}
 
-	ff, cc := p.getFactory().Forwarders(), config.Benchmarks.Containers
-	oo := make([]runtime.Object, 0, len(ff))
-	for k, f := range ff {
+	ee, cc := PortForwards.Entries(), config.Benchmarks.Containers
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		k := e.Tunnel.ID()
 		if !strings.HasPrefix(k, path) {
 			continue
 		}
@@ -64,14 +73,129 @@ func (p *PortForward) List(ctx context.Context, _ string) ([]runtime.Object, err
 			cfg.Host, cfg.Path = cust.HTTP.Host, cust.HTTP.Path
 		}
 		oo = append(oo, render.ForwardRes{
-			Forwarder: f,
-			Config:    cfg,
+			Forwarder:   e.Tunnel,
+			Config:      cfg,
+			Status:      e.Status,
+			Transferred: e.Tunnel.Transferred(),
 		})
 	}
 
 	return oo, nil
 }
 
+// PortForwardKey identifies a port-forward.
+type PortForwardKey struct {
+	Namespace, Pod, LocalPort, RemotePort string
+}
+
+// PortForwardEntry represents a registered port-forward.
+type PortForwardEntry struct {
+	Key       PortForwardKey
+	Tunnel    *PortForwarder
+	Forwarder *portforward.PortForwarder
+	Status    string
+	Err       error
+}
+
+// PortForwardRegistry tracks port-forwards by pod and ports. Unlike the
+// factory forwarders, failed port-forwards are retained until removed.
+type PortForwardRegistry struct {
+	entries map[PortForwardKey]*PortForwardEntry
+	mx      sync.RWMutex
+}
+
+// NewPortForwardRegistry returns a new registry.
+func NewPortForwardRegistry() *PortForwardRegistry {
+	return &PortForwardRegistry{
+		entries: make(map[PortForwardKey]*PortForwardEntry),
+	}
+}
+
+// Register tracks a starting port-forward.
+func (r *PortForwardRegistry) Register(pf *PortForwarder, fwd *portforward.PortForwarder) PortForwardKey {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	k := pf.Key()
+	r.entries[k] = &PortForwardEntry{
+		Key:       k,
+		Tunnel:    pf,
+		Forwarder: fwd,
+		Status:    render.PortForwardStarting,
+	}
+
+	return k
+}
+
+// SetStatus updates a port-forward status.
+func (r *PortForwardRegistry) SetStatus(k PortForwardKey, status string, err error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if e, ok := r.entries[k]; ok {
+		e.Status, e.Err = status, err
+	}
+}
+
+// Unregister drops a port-forward.
+func (r *PortForwardRegistry) Unregister(k PortForwardKey) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	delete(r.entries, k)
+}
+
+// Remove stops and drops the port-forwards matching a given port-forward id.
+func (r *PortForwardRegistry) Remove(id string) int {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	var count int
+	for k, e := range r.entries {
+		if pid := e.Tunnel.ID(); pid == id || strings.HasPrefix(pid, id+"|") {
+			e.Tunnel.Stop()
+			delete(r.entries, k)
+			count++
+		}
+	}
+
+	return count
+}
+
+// Get returns a port-forward if registered.
+func (r *PortForwardRegistry) Get(k PortForwardKey) (PortForwardEntry, bool) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	e, ok := r.entries[k]
+	if !ok {
+		return PortForwardEntry{}, false
+	}
+
+	return *e, true
+}
+
+// Entries returns all registered port-forwards ordered by key.
+func (r *PortForwardRegistry) Entries() []PortForwardEntry {
+	r.mx.RLock()
+	ee := make([]PortForwardEntry, 0, len(r.entries))
+	for _, e := range r.entries {
+		ee = append(ee, *e)
+	}
+	r.mx.RUnlock()
+
+	slices.SortFunc(ee, func(a, b PortForwardEntry) int {
+		return cmp.Or(
+			strings.Compare(a.Key.Namespace, b.Key.Namespace),
+			strings.Compare(a.Key.Pod, b.Key.Pod),
+			strings.Compare(a.Key.LocalPort, b.Key.LocalPort),
+			strings.Compare(a.Key.RemotePort, b.Key.RemotePort),
+		)
+	})
+
+	return ee
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
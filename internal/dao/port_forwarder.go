@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
@@ -37,6 +38,7 @@ type PortForwarder struct {
 	path                string
 	tunnel              port.PortTunnel
 	age                 time.Time
+	transferred         atomic.Uint64
 }
 
 // NewPortForwarder returns a new port forward streamer.
@@ -93,6 +95,28 @@ func (p *PortForwarder) ID() string {
 	return PortForwardID(p.path, p.tunnel.Container, p.tunnel.PortMap())
 }
 
+// Key returns the port-forward registry key.
+func (p *PortForwarder) Key() PortForwardKey {
+	ns, n := client.Namespaced(p.path)
+	po, _, _ := strings.Cut(n, "|")
+	lp := p.tunnel.LocalPort
+	if lp == "" {
+		lp = p.tunnel.ContainerPort
+	}
+
+	return PortForwardKey{
+		Namespace:  ns,
+		Pod:        po,
+		LocalPort:  lp,
+		RemotePort: p.tunnel.ContainerPort,
+	}
+}
+
+// Transferred returns the number of bytes sent and received through the port-forward.
+func (p *PortForwarder) Transferred() uint64 {
+	return p.transferred.Load()
+}
+
 // Container returns the target's container.
 func (p *PortForwarder) Container() string {
 	return p.tunnel.Container
@@ -193,9 +217,59 @@ func (p *PortForwarder) forwardPorts(method string, url *url.URL, addr, portMap
 		})
 	}
 
+	dialer = &countingDialer{Dialer: dialer, count: &p.transferred}
+
 	return portforward.NewOnAddresses(dialer, []string{addr}, []string{portMap}, p.stopChan, p.readyChan, p.Out, p.ErrOut)
 }
 
+// countingDialer tallies the bytes flowing through the dialed connections streams.
+type countingDialer struct {
+	httpstream.Dialer
+	count *atomic.Uint64
+}
+
+func (d *countingDialer) Dial(protocols ...string) (httpstream.Connection, string, error) {
+	conn, proto, err := d.Dialer.Dial(protocols...)
+	if err != nil {
+		return nil, proto, err
+	}
+
+	return &countingConn{Connection: conn, count: d.count}, proto, nil
+}
+
+type countingConn struct {
+	httpstream.Connection
+	count *atomic.Uint64
+}
+
+func (c *countingConn) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	s, err := c.Connection.CreateStream(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &countingStream{Stream: s, count: c.count}, nil
+}
+
+type countingStream struct {
+	httpstream.Stream
+	count *atomic.Uint64
+}
+
+func (s *countingStream) Read(p []byte) (int, error) {
+	n, err := s.Stream.Read(p)
+	s.count.Add(uint64(n))
+
+	return n, err
+}
+
+func (s *countingStream) Write(p []byte) (int, error) {
+	n, err := s.Stream.Write(p)
+	s.count.Add(uint64(n))
+
+	return n, err
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+)
+
+func TestPortForwarderKey(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		tt   port.PortTunnel
+		e    PortForwardKey
+	}{
+		"plain": {
+			path: "ns1/p1",
+			tt:   port.PortTunnel{Container: "c1", LocalPort: "8080", ContainerPort: "80"},
+			e:    PortForwardKey{Namespace: "ns1", Pod: "p1", LocalPort: "8080", RemotePort: "80"},
+		},
+		"container": {
+			path: "ns1/p1|c1",
+			tt:   port.PortTunnel{Container: "c1", ContainerPort: "80"},
+			e:    PortForwardKey{Namespace: "ns1", Pod: "p1", LocalPort: "80", RemotePort: "80"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pf := PortForwarder{path: u.path, tunnel: u.tt}
+			assert.Equal(t, u.e, pf.Key())
+		})
+	}
+}
+
+func TestPortForwardRegistry(t *testing.T) {
+	r := NewPortForwardRegistry()
+	pf1 := &PortForwarder{path: "ns1/p2", tunnel: port.PortTunnel{Container: "c1", LocalPort: "9090", ContainerPort: "90"}}
+	pf2 := &PortForwarder{path: "ns1/p1", tunnel: port.PortTunnel{Container: "c1", LocalPort: "8080", ContainerPort: "80"}}
+
+	k1, k2 := r.Register(pf1, nil), r.Register(pf2, nil)
+	e, ok := r.Get(k1)
+	require.True(t, ok)
+	assert.Equal(t, render.PortForwardStarting, e.Status)
+
+	r.SetStatus(k1, render.PortForwardFailed, errors.New("boom"))
+	e, _ = r.Get(k1)
+	assert.Equal(t, render.PortForwardFailed, e.Status)
+	require.Error(t, e.Err)
+
+	ee := r.Entries()
+	require.Len(t, ee, 2)
+	assert.Equal(t, k2, ee[0].Key)
+	assert.Equal(t, k1, ee[1].Key)
+
+	assert.Equal(t, 1, r.Remove("ns1/p2|c1|9090:90"))
+	_, ok = r.Get(k1)
+	assert.False(t, ok)
+
+	r.Unregister(k2)
+	assert.Empty(t, r.Entries())
+}
+
+func TestCountingStream(t *testing.T) {
+	var count atomic.Uint64
+	s := countingStream{Stream: &rwStream{buff: bytes.NewBufferString("hello")}, count: &count}
+
+	bb, err := io.ReadAll(&s)
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(bb))
+	_, err = s.Write([]byte("fred"))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(9), count.Load())
+}
+
+// Helpers...
+
+type rwStream struct {
+	httpstream.Stream
+	buff *bytes.Buffer
+}
+
+func (s *rwStream) Read(p []byte) (int, error)  { return s.buff.Read(p) }
+func (s *rwStream) Write(p []byte) (int, error) { return s.buff.Write(p) }
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// PowerCycleInterval tracks how often to check for a node re-registration.
+var PowerCycleInterval = 5 * time.Second
+
+// PowerCycle drains and deletes a node, then waits for the cloud provider
+// to register a replacement node with the same hostname.
+func (n *Node) PowerCycle(ctx context.Context, nodeName string, opts DrainOptions, timeout time.Duration) error {
+	return n.PowerCycleWithProgress(ctx, nodeName, opts, timeout, io.Discard)
+}
+
+// PowerCycleWithProgress power cycles a node and writes progress to the given writer.
+func (n *Node) PowerCycleWithProgress(ctx context.Context, nodeName string, opts DrainOptions, timeout time.Duration, w io.Writer) (err error) {
+	if err := ReadonlyGuard(); err != nil {
+		return err
+	}
+	if nodeName == "" {
+		return errors.New("power cycle requires a node name")
+	}
+	if timeout <= 0 {
+		return fmt.Errorf("invalid power cycle timeout %s", timeout)
+	}
+	defer func() {
+		auditAction(n.getFactory(), client.NodeGVR, nodeName, "power-cycle", map[string]any{"timeout": timeout.String()}, err)
+	}()
+	auth, err := n.getFactory().Client().CanI(client.ClusterScope, client.NodeGVR, nodeName, []string{client.DeleteVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to delete node %s", nodeName)
+	}
+	dial, err := n.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+	no, err := dial.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	hostname := nodeHostname(no)
+
+	_, _ = fmt.Fprintf(w, "[%s] Draining node...\n", nodeName)
+	if err := n.Drain(nodeName, opts, w); err != nil {
+		return fmt.Errorf("drain failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(w, "[%s] Deleting node...\n", nodeName)
+	err = dial.CoreV1().Nodes().Delete(ctx, nodeName, metav1.DeleteOptions{})
+	auditAction(n.getFactory(), client.NodeGVR, nodeName, "delete", map[string]any{"reason": "power cycle"}, err)
+	if err != nil {
+		return fmt.Errorf("delete failed: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(w, "[%s] Waiting up to %s for a node with hostname %s to register...\n", nodeName, timeout, hostname)
+	replacement, err := waitForNodeRegistration(ctx, dial, hostname, no.UID, PowerCycleInterval, timeout)
+	if err != nil {
+		return fmt.Errorf("node %s was not replaced: %w", nodeName, err)
+	}
+	_, _ = fmt.Fprintf(w, "[%s] Node %s registered!\n", nodeName, replacement)
+
+	return nil
+}
+
+// nodeHostname returns a node hostname label, falling back to the node name.
+func nodeHostname(no *v1.Node) string {
+	if h, ok := no.Labels[v1.LabelHostname]; ok && h != "" {
+		return h
+	}
+
+	return no.Name
+}
+
+// waitForNodeRegistration polls for a node with the given hostname other than the deleted one.
+func waitForNodeRegistration(ctx context.Context, dial kubernetes.Interface, hostname string, oldUID types.UID, interval, timeout time.Duration) (string, error) {
+	sel := labels.SelectorFromSet(labels.Set{v1.LabelHostname: hostname}).String()
+
+	var name string
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, false, func(ctx context.Context) (bool, error) {
+		ll, err := dial.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: sel})
+		if err != nil {
+			slog.Warn("Node registration check failed", slogs.Error, err)
+			return false, nil
+		}
+		for i := range ll.Items {
+			if ll.Items[i].UID != oldUID {
+				name = ll.Items[i].Name
+				return true, nil
+			}
+		}
+
+		return false, nil
+	})
+
+	return name, err
+}
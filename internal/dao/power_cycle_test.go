@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeHostname(t *testing.T) {
+	no := v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n1"}}
+	assert.Equal(t, "n1", nodeHostname(&no))
+
+	no.Labels = map[string]string{v1.LabelHostname: "host-1"}
+	assert.Equal(t, "host-1", nodeHostname(&no))
+}
+
+func TestWaitForNodeRegistration(t *testing.T) {
+	old := makeHostNode("n1", "host-1", "uid-1")
+
+	uu := map[string]struct {
+		nodes []v1.Node
+		e     string
+		err   bool
+	}{
+		"replaced": {
+			nodes: []v1.Node{old, makeHostNode("n1-new", "host-1", "uid-2")},
+			e:     "n1-new",
+		},
+		"same-uid": {
+			nodes: []v1.Node{old},
+			err:   true,
+		},
+		"other-host": {
+			nodes: []v1.Node{makeHostNode("n2", "host-2", "uid-3")},
+			err:   true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			dial := fake.NewClientset()
+			for i := range u.nodes {
+				_, err := dial.CoreV1().Nodes().Create(context.Background(), &u.nodes[i], metav1.CreateOptions{})
+				require.NoError(t, err)
+			}
+			name, err := waitForNodeRegistration(context.Background(), dial, "host-1", old.UID, time.Millisecond, 20*time.Millisecond)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, name)
+		})
+	}
+}
+
+// Helpers...
+
+func makeHostNode(name, host, uid string) v1.Node {
+	return v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			UID:    types.UID(uid),
+			Labels: map[string]string{v1.LabelHostname: host},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+var _ Accessor = (*PersistentVolume)(nil)
+
+// PersistentVolume represents a persistent volume resource.
+type PersistentVolume struct {
+	Resource
+}
+
+// SetReclaimPolicy updates a persistent volume reclaim policy.
+func (p *PersistentVolume) SetReclaimPolicy(ctx context.Context, pvName string, policy v1.PersistentVolumeReclaimPolicy) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(p.getFactory(), client.PvGVR, pvName, "set reclaim policy", map[string]any{"policy": policy}, err)
+	}()
+
+	switch policy {
+	case v1.PersistentVolumeReclaimRetain, v1.PersistentVolumeReclaimDelete, v1.PersistentVolumeReclaimRecycle:
+	default:
+		return fmt.Errorf("invalid reclaim policy %q", policy)
+	}
+	auth, err := p.Client().CanI(client.ClusterScope, client.PvGVR, pvName, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch persistent volume %q", pvName)
+	}
+	dial, err := p.Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	return patchReclaimPolicy(ctx, dial, pvName, policy)
+}
+
+// patchReclaimPolicy sets a persistent volume reclaim policy.
+func patchReclaimPolicy(ctx context.Context, dial kubernetes.Interface, n string, policy v1.PersistentVolumeReclaimPolicy) error {
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"persistentVolumeReclaimPolicy": policy,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = dial.CoreV1().PersistentVolumes().Patch(ctx, n, types.MergePatchType, patch, metav1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPatchReclaimPolicy(t *testing.T) {
+	pv := v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv1"},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: v1.PersistentVolumeReclaimDelete,
+			StorageClassName:              "standard",
+		},
+	}
+	dial := fake.NewClientset(&pv)
+	ctx := context.Background()
+
+	require.NoError(t, patchReclaimPolicy(ctx, dial, "pv1", v1.PersistentVolumeReclaimRetain))
+	o, err := dial.CoreV1().PersistentVolumes().Get(ctx, "pv1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1.PersistentVolumeReclaimRetain, o.Spec.PersistentVolumeReclaimPolicy)
+	assert.Equal(t, "standard", o.Spec.StorageClassName)
+
+	assert.Error(t, patchReclaimPolicy(ctx, dial, "pv2", v1.PersistentVolumeReclaimRetain))
+}
+
+func TestSetReclaimPolicyInvalid(t *testing.T) {
+	var pv PersistentVolume
+
+	assert.EqualError(t, pv.SetReclaimPolicy(context.Background(), "pv1", "Blee"), `invalid reclaim policy "Blee"`)
+}
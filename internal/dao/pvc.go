@@ -0,0 +1,526 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/slogs"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultMigrationImage tracks the image used to copy a claim data.
+	DefaultMigrationImage = "busybox:1.36"
+
+	migrationPodPrefix = "k9s-pvc-migrate-"
+	migrationContainer = "copy"
+	migrationSrcMount  = "/src"
+	migrationDstMount  = "/dst"
+)
+
+var (
+	// PVCMigrationInterval tracks how often to check for the migration pod completion.
+	PVCMigrationInterval = 2 * time.Second
+
+	// PVCMigrationTimeout tracks how long to wait for a claim data to be copied.
+	PVCMigrationTimeout = 30 * time.Minute
+
+	// PVCReleaseTimeout tracks how long to wait for the claim pods to terminate.
+	PVCReleaseTimeout = 5 * time.Minute
+)
+
+var _ Accessor = (*PersistentVolumeClaim)(nil)
+
+// PersistentVolumeClaim represents a persistent volume claim resource.
+type PersistentVolumeClaim struct {
+	Resource
+}
+
+// MigrateStorageClass copies a claim data to a new claim in the target storage class
+// and rolls the workloads mounting the claim over to the new claim.
+// The workloads are scaled down for the duration of the copy so no writes are lost.
+// The new claim is removed should any step fail. The original claim is kept.
+func (p *PersistentVolumeClaim) MigrateStorageClass(ctx context.Context, pvcFQN, targetStorageClass string, w io.Writer) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(p.getFactory(), p.gvr, pvcFQN, "migrate storage class", map[string]any{"storageClass": targetStorageClass}, err)
+	}()
+
+	dial, err := p.getFactory().Client().Dial()
+	if err != nil {
+		return err
+	}
+
+	return migrateStorageClass(ctx, dial, pvcFQN, targetStorageClass, w, auditorFor(p.getFactory()))
+}
+
+// StorageClassNames returns the cluster storage class names.
+func (p *PersistentVolumeClaim) StorageClassNames(ctx context.Context) ([]string, error) {
+	dial, err := p.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dial.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	nn := make([]string, 0, len(ll.Items))
+	for i := range ll.Items {
+		nn = append(nn, ll.Items[i].Name)
+	}
+
+	return nn, nil
+}
+
+// claimWorkload tracks a workload mounting a migrated claim.
+type claimWorkload struct {
+	kind, name, vol string
+	replicas        int32
+}
+
+func (c claimWorkload) String() string {
+	return c.kind + "/" + c.name
+}
+
+func (c claimWorkload) gvr() *client.GVR {
+	switch c.kind {
+	case "StatefulSet":
+		return client.StsGVR
+	case "ReplicaSet":
+		return client.RsGVR
+	default:
+		return client.DpGVR
+	}
+}
+
+func migrateStorageClass(ctx context.Context, dial kubernetes.Interface, fqn, sc string, w io.Writer, audit auditFn) (err error) {
+	ns, n := client.Namespaced(fqn)
+	pvc, err := dial.CoreV1().PersistentVolumeClaims(ns).Get(ctx, n, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if cur := pvc.Spec.StorageClassName; cur != nil && *cur == sc {
+		return fmt.Errorf("claim %s already uses storage class %q", fqn, sc)
+	}
+	if _, err := dial.StorageV1().StorageClasses().Get(ctx, sc, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("invalid target storage class: %w", err)
+	}
+	users, err := claimPods(ctx, dial, ns, n)
+	if err != nil {
+		return err
+	}
+	ww, err := claimWorkloads(ctx, dial, users, n)
+	if err != nil {
+		return err
+	}
+	node := claimNode(users)
+
+	for _, wl := range ww {
+		_, _ = fmt.Fprintf(w, "[%s] Scaling %s down...\n", n, wl)
+		err := scaleWorkload(ctx, dial, ns, wl.kind, wl.name, 0)
+		audit(wl.gvr(), client.FQN(ns, wl.name), "scale", map[string]any{"replicas": 0}, err)
+		if err != nil {
+			return fmt.Errorf("%s scale down failed: %w", wl, err)
+		}
+		defer func() {
+			_, _ = fmt.Fprintf(w, "[%s] Scaling %s back to %d...\n", n, wl, wl.replicas)
+			e := scaleWorkload(context.WithoutCancel(ctx), dial, ns, wl.kind, wl.name, wl.replicas)
+			audit(wl.gvr(), client.FQN(ns, wl.name), "scale", map[string]any{"replicas": wl.replicas}, e)
+			if e != nil {
+				err = errors.Join(err, fmt.Errorf("%s scale up failed: %w", wl, e))
+			}
+		}()
+	}
+	if len(ww) > 0 {
+		_, _ = fmt.Fprintf(w, "[%s] Waiting for claim writers to terminate...\n", n)
+		if err := waitForClaimRelease(ctx, dial, ns, n, PVCMigrationInterval, PVCReleaseTimeout); err != nil {
+			return err
+		}
+	}
+
+	dst := migrationClaim(pvc, sc)
+	_, _ = fmt.Fprintf(w, "[%s] Creating claim %s in storage class %s...\n", n, dst.Name, sc)
+	dstFQN := client.FQN(ns, dst.Name)
+	dst, err = dial.CoreV1().PersistentVolumeClaims(ns).Create(ctx, dst, metav1.CreateOptions{})
+	audit(client.PvcGVR, dstFQN, "create", map[string]any{"storageClass": sc}, err)
+	if err != nil {
+		return fmt.Errorf("claim creation failed: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_, _ = fmt.Fprintf(w, "[%s] Migration failed. Removing claim %s...\n", n, dst.Name)
+			deleteMigrationClaim(dial, dst, audit)
+		}
+	}()
+
+	_, _ = fmt.Fprintf(w, "[%s] Copying data to %s...\n", n, dst.Name)
+	po, err := dial.CoreV1().Pods(ns).Create(ctx, migrationPod(ns, n, dst.Name, node), metav1.CreateOptions{})
+	if err != nil {
+		audit(client.PodGVR, client.FQN(ns, migrationPodPrefix), "create", nil, err)
+		return fmt.Errorf("migration pod creation failed: %w", err)
+	}
+	audit(client.PodGVR, client.FQN(ns, po.Name), "create", nil, nil)
+	err = waitForPodCompletion(ctx, dial, po, PVCMigrationInterval, PVCMigrationTimeout)
+	deleteMigrationPod(dial, po, audit)
+	if err != nil {
+		return fmt.Errorf("data copy failed: %w", err)
+	}
+
+	if err := switchClaim(ctx, dial, ns, ww, n, dst.Name, w, audit); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "[%s] Migrated to %s! The original claim was kept.\n", n, dst.Name)
+
+	return nil
+}
+
+// migrationClaim returns a copy of the given claim in the target storage class.
+func migrationClaim(pvc *v1.PersistentVolumeClaim, sc string) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvc.Name + "-" + sc,
+			Namespace: pvc.Namespace,
+			Labels:    pvc.Labels,
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      pvc.Spec.AccessModes,
+			Resources:        pvc.Spec.Resources,
+			VolumeMode:       pvc.Spec.VolumeMode,
+			StorageClassName: &sc,
+		},
+	}
+}
+
+// migrationPod returns a pod copying a claim data over to another claim.
+// The pod runs on the node already mounting the source claim if any.
+func migrationPod(ns, src, dst, node string) *v1.Pod {
+	var grace int64
+
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: migrationPodPrefix,
+			Namespace:    ns,
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": "k9s"},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			TerminationGracePeriodSeconds: &grace,
+			Containers: []v1.Container{
+				{
+					Name:    migrationContainer,
+					Image:   DefaultMigrationImage,
+					Command: []string{"sh", "-c", "cp -a " + migrationSrcMount + "/. " + migrationDstMount + "/"},
+					VolumeMounts: []v1.VolumeMount{
+						{Name: "src", MountPath: migrationSrcMount, ReadOnly: true},
+						{Name: "dst", MountPath: migrationDstMount},
+					},
+				},
+			},
+			Volumes: []v1.Volume{
+				claimVolume("src", src, true),
+				claimVolume("dst", dst, false),
+			},
+		},
+	}
+}
+
+func claimVolume(name, claim string, ro bool) v1.Volume {
+	return v1.Volume{
+		Name: name,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: claim, ReadOnly: ro},
+		},
+	}
+}
+
+// claimPods returns all active pods in a namespace mounting the given claim.
+func claimPods(ctx context.Context, dial kubernetes.Interface, ns, claim string) ([]v1.Pod, error) {
+	ll, err := dial.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pp := make([]v1.Pod, 0, len(ll.Items))
+	for i := range ll.Items {
+		if ph := ll.Items[i].Status.Phase; ph == v1.PodSucceeded || ph == v1.PodFailed {
+			continue
+		}
+		if claimVolumeName(&ll.Items[i].Spec, claim) != "" {
+			pp = append(pp, ll.Items[i])
+		}
+	}
+
+	return pp, nil
+}
+
+// claimVolumeName returns the name of the pod volume backed by the given claim if any.
+func claimVolumeName(spec *v1.PodSpec, claim string) string {
+	for _, v := range spec.Volumes {
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == claim {
+			return v.Name
+		}
+	}
+
+	return ""
+}
+
+func claimNode(pp []v1.Pod) string {
+	for i := range pp {
+		if pp[i].Spec.NodeName != "" {
+			return pp[i].Spec.NodeName
+		}
+	}
+
+	return ""
+}
+
+func waitForPodCompletion(ctx context.Context, dial kubernetes.Interface, po *v1.Pod, interval, timeout time.Duration) error {
+	return wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		p, err := dial.CoreV1().Pods(po.Namespace).Get(ctx, po.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch p.Status.Phase {
+		case v1.PodFailed:
+			return false, fmt.Errorf("migration pod %s failed", client.FQN(p.Namespace, p.Name))
+		case v1.PodSucceeded:
+			return true, nil
+		default:
+			return false, nil
+		}
+	})
+}
+
+func deleteMigrationPod(dial kubernetes.Interface, po *v1.Pod, audit auditFn) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := dial.CoreV1().Pods(po.Namespace).Delete(ctx, po.Name, metav1.DeleteOptions{})
+	audit(client.PodGVR, client.FQN(po.Namespace, po.Name), "delete", nil, err)
+	if err != nil {
+		slog.Warn("Unable to delete migration pod",
+			slogs.FQN, client.FQN(po.Namespace, po.Name),
+			slogs.Error, err,
+		)
+	}
+}
+
+// claimWorkloads returns the workloads owning the given pods. Pods not managed by a
+// scalable workload prevent the migration as their writes can not be stopped.
+func claimWorkloads(ctx context.Context, dial kubernetes.Interface, pp []v1.Pod, claim string) ([]claimWorkload, error) {
+	ww := make([]claimWorkload, 0, len(pp))
+	done := make(map[string]struct{}, len(pp))
+	for i := range pp {
+		po := &pp[i]
+		kind, name, err := podWorkload(ctx, dial, po)
+		if err != nil {
+			return nil, err
+		}
+		switch kind {
+		case "":
+			return nil, fmt.Errorf("pod %s is not managed by a controller and must be stopped first", client.FQN(po.Namespace, po.Name))
+		case "Deployment", "StatefulSet", "ReplicaSet":
+		default:
+			return nil, fmt.Errorf("%s %s can not be scaled down and must be migrated manually", kind, name)
+		}
+		wl := claimWorkload{kind: kind, name: name, vol: claimVolumeName(&po.Spec, claim)}
+		if _, ok := done[wl.String()]; ok {
+			continue
+		}
+		done[wl.String()] = struct{}{}
+		if kind == "StatefulSet" {
+			if err := checkClaimTemplates(ctx, dial, po.Namespace, name, wl.vol); err != nil {
+				return nil, err
+			}
+		}
+		if wl.replicas, err = workloadReplicas(ctx, dial, po.Namespace, kind, name); err != nil {
+			return nil, err
+		}
+		ww = append(ww, wl)
+	}
+
+	return ww, nil
+}
+
+func workloadReplicas(ctx context.Context, dial kubernetes.Interface, ns, kind, name string) (int32, error) {
+	var replicas *int32
+	switch kind {
+	case "Deployment":
+		dp, err := dial.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return 0, err
+		}
+		replicas = dp.Spec.Replicas
+	case "StatefulSet":
+		sts, err := dial.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return 0, err
+		}
+		replicas = sts.Spec.Replicas
+	case "ReplicaSet":
+		rs, err := dial.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return 0, err
+		}
+		replicas = rs.Spec.Replicas
+	default:
+		return 0, fmt.Errorf("unsupported workload kind %q", kind)
+	}
+	if replicas == nil {
+		return 1, nil
+	}
+
+	return *replicas, nil
+}
+
+func scaleWorkload(ctx context.Context, dial kubernetes.Interface, ns, kind, name string, replicas int32) error {
+	patch := fmt.Appendf(nil, `{"spec":{"replicas":%d}}`, replicas)
+
+	var err error
+	switch kind {
+	case "Deployment":
+		_, err = dial.AppsV1().Deployments(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	case "StatefulSet":
+		_, err = dial.AppsV1().StatefulSets(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	case "ReplicaSet":
+		_, err = dial.AppsV1().ReplicaSets(ns).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("unsupported workload kind %q", kind)
+	}
+
+	return err
+}
+
+// waitForClaimRelease waits until no pods mount the given claim.
+func waitForClaimRelease(ctx context.Context, dial kubernetes.Interface, ns, claim string, interval, timeout time.Duration) error {
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, true, func(ctx context.Context) (bool, error) {
+		pp, err := claimPods(ctx, dial, ns, claim)
+		if err != nil {
+			return false, err
+		}
+		return len(pp) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("claim %s is still in use: %w", client.FQN(ns, claim), err)
+	}
+
+	return nil
+}
+
+func deleteMigrationClaim(dial kubernetes.Interface, pvc *v1.PersistentVolumeClaim, audit auditFn) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := dial.CoreV1().PersistentVolumeClaims(pvc.Namespace).Delete(ctx, pvc.Name, metav1.DeleteOptions{})
+	audit(client.PvcGVR, client.FQN(pvc.Namespace, pvc.Name), "delete", nil, err)
+	if err != nil {
+		slog.Warn("Unable to delete migration claim",
+			slogs.FQN, client.FQN(pvc.Namespace, pvc.Name),
+			slogs.Error, err,
+		)
+	}
+}
+
+// switchClaim points the given workloads pod templates to a new claim. Workloads already
+// switched are reverted should any update fail.
+func switchClaim(ctx context.Context, dial kubernetes.Interface, ns string, ww []claimWorkload, old, claim string, w io.Writer, audit auditFn) error {
+	for i, wl := range ww {
+		_, _ = fmt.Fprintf(w, "[%s] Pointing %s to %s...\n", old, wl, claim)
+		err := patchClaim(ctx, dial, ns, wl.kind, wl.name, wl.vol, claim)
+		audit(wl.gvr(), client.FQN(ns, wl.name), "switch claim", map[string]any{"volume": wl.vol, "claim": claim}, err)
+		if err != nil {
+			err = fmt.Errorf("%s update failed: %w", wl, err)
+			for _, done := range ww[:i] {
+				e := patchClaim(context.WithoutCancel(ctx), dial, ns, done.kind, done.name, done.vol, old)
+				audit(done.gvr(), client.FQN(ns, done.name), "switch claim", map[string]any{"volume": done.vol, "claim": old}, e)
+				if e != nil {
+					err = errors.Join(err, fmt.Errorf("%s revert failed: %w", done, e))
+				}
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// podWorkload returns the kind and name of the workload managing a pod if any.
+func podWorkload(ctx context.Context, dial kubernetes.Interface, po *v1.Pod) (kind, name string, err error) {
+	ref := metav1.GetControllerOf(po)
+	if ref == nil {
+		return "", "", nil
+	}
+	if ref.Kind != "ReplicaSet" {
+		return ref.Kind, ref.Name, nil
+	}
+	rs, err := dial.AppsV1().ReplicaSets(po.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", err
+	}
+	if ref = metav1.GetControllerOf(rs); ref == nil {
+		return "ReplicaSet", rs.Name, nil
+	}
+
+	return ref.Kind, ref.Name, nil
+}
+
+// checkClaimTemplates ensures a statefulset volume is not provisioned from a claim template
+// as those can not be swapped in place.
+func checkClaimTemplates(ctx context.Context, dial kubernetes.Interface, ns, name, vol string) error {
+	sts, err := dial.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	for _, t := range sts.Spec.VolumeClaimTemplates {
+		if t.Name == vol {
+			return fmt.Errorf("volume %q is provisioned from a claim template and must be migrated manually", vol)
+		}
+	}
+
+	return nil
+}
+
+// patchClaim swaps a workload pod template volume claim. The workload must be scaled
+// down beforehand for its pods to pick up the new claim once scaled back up.
+func patchClaim(ctx context.Context, dial kubernetes.Interface, ns, kind, name, vol, claim string) error {
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"volumes": []v1.Volume{claimVolume(vol, claim, false)},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	switch kind {
+	case "Deployment":
+		_, err = dial.AppsV1().Deployments(ns).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "StatefulSet":
+		_, err = dial.AppsV1().StatefulSets(ns).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	case "ReplicaSet":
+		_, err = dial.AppsV1().ReplicaSets(ns).Patch(ctx, name, types.StrategicMergePatchType, patch, metav1.PatchOptions{})
+	default:
+		err = fmt.Errorf("unsupported workload kind %q", kind)
+	}
+
+	return err
+}
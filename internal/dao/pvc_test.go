@@ -0,0 +1,237 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestMigrationClaim(t *testing.T) {
+	pvc := makeClaim("data", "standard")
+	c := migrationClaim(&pvc, "fast")
+
+	assert.Equal(t, "data-fast", c.Name)
+	assert.Equal(t, "ns1", c.Namespace)
+	assert.Equal(t, "fast", *c.Spec.StorageClassName)
+	assert.Equal(t, pvc.Spec.AccessModes, c.Spec.AccessModes)
+	assert.Equal(t, pvc.Spec.Resources, c.Spec.Resources)
+}
+
+func TestMigrateStorageClass(t *testing.T) {
+	dial, copied := makeMigrationClientset(v1.PodSucceeded)
+
+	var (
+		w  bytes.Buffer
+		aa auditRecorder
+	)
+	require.NoError(t, migrateStorageClass(context.Background(), dial, "ns1/data", "fast", &w, aa.audit))
+
+	c, err := dial.CoreV1().PersistentVolumeClaims("ns1").Get(context.Background(), "data-fast", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "fast", *c.Spec.StorageClassName)
+
+	require.NotNil(t, *copied)
+	assert.Equal(t, "n1", (*copied).Spec.NodeName)
+	_, err = dial.CoreV1().Pods("ns1").Get(context.Background(), (*copied).Name, metav1.GetOptions{})
+	require.Error(t, err)
+
+	d, err := dial.AppsV1().Deployments("ns1").Get(context.Background(), "dp1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "data-fast", d.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, int32(2), *d.Spec.Replicas)
+
+	assert.Contains(t, w.String(), "Scaling Deployment/dp1 down")
+	assert.Equal(t, 1, bytes.Count(w.Bytes(), []byte("Pointing")))
+	assert.Contains(t, w.String(), "Scaling Deployment/dp1 back to 2")
+	assert.Equal(t, auditRecorder{
+		"scale deployments ns1/dp1",
+		"create persistentvolumeclaims ns1/data-fast",
+		"create pods ns1/" + (*copied).Name,
+		"delete pods ns1/" + (*copied).Name,
+		"switch claim deployments ns1/dp1",
+		"scale deployments ns1/dp1",
+	}, aa)
+}
+
+func TestMigrateStorageClassRollback(t *testing.T) {
+	dial, _ := makeMigrationClientset(v1.PodFailed)
+
+	var w bytes.Buffer
+	require.Error(t, migrateStorageClass(context.Background(), dial, "ns1/data", "fast", &w, noAudit))
+
+	_, err := dial.CoreV1().PersistentVolumeClaims("ns1").Get(context.Background(), "data-fast", metav1.GetOptions{})
+	require.Error(t, err)
+	d, err := dial.AppsV1().Deployments("ns1").Get(context.Background(), "dp1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "data", d.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName)
+	assert.Equal(t, int32(2), *d.Spec.Replicas)
+	assert.Contains(t, w.String(), "Removing claim data-fast")
+}
+
+func TestMigrateStorageClassUnmanaged(t *testing.T) {
+	pvc := makeClaim("data", "standard")
+	dial := fake.NewClientset(
+		&pvc,
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "fast"}},
+		makeClaimPod("p1", "data", nil, ""),
+	)
+
+	err := migrateStorageClass(context.Background(), dial, "ns1/data", "fast", &bytes.Buffer{}, noAudit)
+	require.ErrorContains(t, err, "must be stopped first")
+	_, err = dial.CoreV1().PersistentVolumeClaims("ns1").Get(context.Background(), "data-fast", metav1.GetOptions{})
+	require.Error(t, err)
+}
+
+func TestMigrateStorageClassInvalid(t *testing.T) {
+	pvc := makeClaim("data", "standard")
+	dial := fake.NewClientset(&pvc)
+
+	uu := map[string]struct {
+		fqn, sc string
+	}{
+		"no-claim":   {fqn: "ns1/fred", sc: "fast"},
+		"same-class": {fqn: "ns1/data", sc: "standard"},
+		"no-class":   {fqn: "ns1/data", sc: "fast"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			require.Error(t, migrateStorageClass(context.Background(), dial, u.fqn, u.sc, &bytes.Buffer{}, noAudit))
+		})
+	}
+}
+
+func TestWaitForPodCompletion(t *testing.T) {
+	uu := map[string]struct {
+		phase v1.PodPhase
+		err   bool
+	}{
+		"succeeded": {phase: v1.PodSucceeded},
+		"failed":    {phase: v1.PodFailed, err: true},
+		"running":   {phase: v1.PodRunning, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := makeClaimPod("p1", "data", nil, "")
+			po.Status.Phase = u.phase
+			dial := fake.NewClientset(po)
+			err := waitForPodCompletion(context.Background(), dial, po, time.Millisecond, 20*time.Millisecond)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// Helpers...
+
+func noAudit(*client.GVR, string, string, map[string]any, error) {}
+
+type auditRecorder []string
+
+func (r *auditRecorder) audit(gvr *client.GVR, path, action string, _ map[string]any, _ error) {
+	*r = append(*r, action+" "+gvr.R()+" "+path)
+}
+
+// makeMigrationClientset returns a clientset deleting the claim pods on scale down
+// and completing the copy pod in the given phase.
+func makeMigrationClientset(phase v1.PodPhase) (*fake.Clientset, **v1.Pod) {
+	pvc, dp, rs := makeClaim("data", "standard"), makeClaimDeployment(), makeClaimReplicaSet()
+	dial := fake.NewClientset(
+		&pvc,
+		&storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "fast"}},
+		&dp,
+		&rs,
+		makeClaimPod("p1", "data", &rs.ObjectMeta, "ReplicaSet"),
+		makeClaimPod("p2", "data", &rs.ObjectMeta, "ReplicaSet"),
+		makeClaimPod("p3", "other", nil, ""),
+	)
+	dial.PrependReactor("patch", "deployments", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		if string(a.(k8stesting.PatchAction).GetPatch()) == `{"spec":{"replicas":0}}` {
+			for _, n := range []string{"p1", "p2"} {
+				_ = dial.Tracker().Delete(v1.SchemeGroupVersion.WithResource("pods"), "ns1", n)
+			}
+		}
+		return false, nil, nil
+	})
+	copied := new(*v1.Pod)
+	dial.PrependReactor("create", "pods", func(a k8stesting.Action) (bool, runtime.Object, error) {
+		po := a.(k8stesting.CreateAction).GetObject().(*v1.Pod)
+		po.Name, po.Status.Phase = po.GenerateName+"x", phase
+		*copied = po
+		return false, nil, nil
+	})
+
+	return dial, copied
+}
+
+func makeClaim(name, sc string) v1.PersistentVolumeClaim {
+	return v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+			StorageClassName: &sc,
+		},
+	}
+}
+
+func makeClaimDeployment() appsv1.Deployment {
+	return appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "dp1", Namespace: "ns1", UID: "dp1"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(2)),
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Volumes: []v1.Volume{claimVolume("vol", "data", false)}},
+			},
+		},
+	}
+}
+
+func makeClaimReplicaSet() appsv1.ReplicaSet {
+	ctrl := true
+	return appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "rs1",
+			Namespace:       "ns1",
+			UID:             "rs1",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "dp1", UID: "dp1", Controller: &ctrl}},
+		},
+	}
+}
+
+func makeClaimPod(name, claim string, owner *metav1.ObjectMeta, kind string) *v1.Pod {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns1"},
+		Spec: v1.PodSpec{
+			NodeName: "n1",
+			Volumes:  []v1.Volume{claimVolume("vol", claim, false)},
+		},
+	}
+	if owner != nil {
+		ctrl := true
+		po.OwnerReferences = []metav1.OwnerReference{{Kind: kind, Name: owner.Name, UID: owner.UID, Controller: &ctrl}}
+	}
+
+	return &po
+}
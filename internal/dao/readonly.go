@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrReadonlyMode indicates a mutating action was attempted in read-only mode.
+var ErrReadonlyMode = errors.New("action is disabled in read-only mode")
+
+// ReadonlyFunc reports whether the session is read-only.
+type ReadonlyFunc func() bool
+
+var readonlyFn atomic.Pointer[ReadonlyFunc]
+
+// SetReadonlyFn registers the read-only mode check used to guard all mutating actions.
+func SetReadonlyFn(f ReadonlyFunc) {
+	if f == nil {
+		readonlyFn.Store(nil)
+		return
+	}
+	readonlyFn.Store(&f)
+}
+
+// ReadonlyGuard returns ErrReadonlyMode when the session is read-only.
+func ReadonlyGuard() error {
+	if f := readonlyFn.Load(); f != nil && (*f)() {
+		return ErrReadonlyMode
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadonlyGuard(t *testing.T) {
+	require.NoError(t, ReadonlyGuard())
+
+	ro := true
+	SetReadonlyFn(func() bool { return ro })
+	t.Cleanup(func() { SetReadonlyFn(nil) })
+	require.ErrorIs(t, ReadonlyGuard(), ErrReadonlyMode)
+
+	ro = false
+	require.NoError(t, ReadonlyGuard())
+}
+
+func TestReadonlyMutations(t *testing.T) {
+	SetReadonlyFn(func() bool { return true })
+	t.Cleanup(func() { SetReadonlyFn(nil) })
+
+	uu := map[string]func() error{
+		"scale": func() error {
+			var s Scaler
+			return s.Scale(context.Background(), "fred/blee", 2)
+		},
+		"cordon": func() error {
+			var n Node
+			return n.Cordon("n1")
+		},
+		"drain": func() error {
+			var n Node
+			return n.Drain("n1", DrainOptions{}, nil)
+		},
+		"benchmark": func() error {
+			var n Node
+			_, err := n.Benchmark("n1", BenchmarkOptions{})
+			return err
+		},
+		"delete": func() error {
+			var g Generic
+			return g.Delete(context.Background(), "fred/blee", nil, NowGrace)
+		},
+		"sanitize": func() error {
+			var p Pod
+			_, err := p.Sanitize(context.Background(), "fred")
+			return err
+		},
+	}
+
+	for k := range uu {
+		f := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.ErrorIs(t, f(), ErrReadonlyMode)
+		})
+	}
+}
@@ -229,6 +229,38 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{k9sCat},
 	}
+	m[client.MwGVR] = &metav1.APIResource{
+		Name:         "maintenance",
+		Kind:         "Maintenance",
+		SingularName: "maintenance",
+		ShortNames:   []string{"mw"},
+		Verbs:        []string{"delete"},
+		Categories:   []string{k9sCat},
+	}
+	m[client.NplGVR] = &metav1.APIResource{
+		Name:         "nodepools",
+		Kind:         "NodePools",
+		SingularName: "nodepool",
+		ShortNames:   []string{"pool"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.QuoGVR] = &metav1.APIResource{
+		Name:         "quotausages",
+		Namespaced:   true,
+		Kind:         "QuotaUsages",
+		SingularName: "quotausage",
+		ShortNames:   []string{"qu"},
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
+	m[client.SrcGVR] = &metav1.APIResource{
+		Name:         "search",
+		Kind:         "Search",
+		SingularName: "search",
+		Verbs:        []string{},
+		Categories:   []string{k9sCat},
+	}
 	m[client.BeGVR] = &metav1.APIResource{
 		Name:         "benchmarks",
 		Kind:         "Benchmarks",
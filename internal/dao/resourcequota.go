@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ResourceQuota)(nil)
+
+// ResourceQuota represents resource quotas usage per quota resource.
+type ResourceQuota struct {
+	NonResource
+}
+
+// List returns a namespace resource quotas usage, one entry per quota resource.
+func (q *ResourceQuota) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	dial, err := q.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := dial.CoreV1().ResourceQuotas(client.CleanseNamespace(ns)).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	uu := quotaUsages(ll.Items)
+	oo := make([]runtime.Object, 0, len(uu))
+	for _, u := range uu {
+		oo = append(oo, u)
+	}
+
+	return oo, nil
+}
+
+// Get returns the resource quota backing a given quota usage.
+func (q *ResourceQuota) Get(ctx context.Context, path string) (runtime.Object, error) {
+	dial, err := q.getFactory().Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+	fqn, _, _ := strings.Cut(path, render.QuotaResourceSep)
+	ns, n := client.Namespaced(fqn)
+
+	return dial.CoreV1().ResourceQuotas(ns).Get(ctx, n, metav1.GetOptions{})
+}
+
+// quotaUsages flattens resource quotas into usages sorted by resource name.
+func quotaUsages(qq []v1.ResourceQuota) []render.QuotaUsageRes {
+	uu := make([]render.QuotaUsageRes, 0, len(qq))
+	for i := range qq {
+		q := &qq[i]
+		start := len(uu)
+		for res, hard := range q.Status.Hard {
+			uu = append(uu, render.QuotaUsageRes{
+				Namespace: q.Namespace,
+				Name:      q.Name,
+				Resource:  string(res),
+				Used:      q.Status.Used[res],
+				Hard:      hard,
+				Created:   q.CreationTimestamp,
+			})
+		}
+		slices.SortFunc(uu[start:], func(a, b render.QuotaUsageRes) int {
+			return strings.Compare(a.Resource, b.Resource)
+		})
+	}
+
+	return uu
+}
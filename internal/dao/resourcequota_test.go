@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestQuotaUsages(t *testing.T) {
+	qq := []v1.ResourceQuota{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "q1"},
+			Status: v1.ResourceQuotaStatus{
+				Hard: v1.ResourceList{
+					v1.ResourceRequestsMemory: resource.MustParse("1Gi"),
+					v1.ResourceRequestsCPU:    resource.MustParse("2"),
+					v1.ResourcePods:           resource.MustParse("10"),
+				},
+				Used: v1.ResourceList{
+					v1.ResourceRequestsCPU: resource.MustParse("500m"),
+					v1.ResourcePods:        resource.MustParse("3"),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "q2"},
+		},
+	}
+
+	uu := quotaUsages(qq)
+	assert.Len(t, uu, 3)
+
+	rr := make([]string, 0, len(uu))
+	for _, u := range uu {
+		rr = append(rr, u.Resource+"="+u.Used.String()+"/"+u.Hard.String())
+	}
+	assert.Equal(t, []string{"pods=3/10", "requests.cpu=500m/2", "requests.memory=0/1Gi"}, rr)
+}
@@ -10,18 +10,23 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
 var (
+	_ Accessor    = (*ReplicaSet)(nil)
 	_ ImageLister = (*ReplicaSet)(nil)
 )
 
@@ -30,6 +35,73 @@ type ReplicaSet struct {
 	Resource
 }
 
+// List returns a collection of replicasets. Only orphaned replicasets are listed
+// when requested via the context.
+func (r *ReplicaSet) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if orphaned, _ := ctx.Value(internal.KeyOrphaned).(bool); !orphaned {
+		return r.Resource.List(ctx, ns)
+	}
+	rr, err := r.ListOrphanedReplicaSets(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, rs := range rr {
+		o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(rs)
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, &unstructured.Unstructured{Object: o})
+	}
+
+	return oo, nil
+}
+
+// ListOrphanedReplicaSets returns the replicasets in a namespace that have no controller
+// or whose deployment is gone.
+func (r *ReplicaSet) ListOrphanedReplicaSets(_ context.Context, ns string) ([]*appsv1.ReplicaSet, error) {
+	oo, err := r.getFactory().List(client.RsGVR, ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	rr := make([]*appsv1.ReplicaSet, 0, len(oo))
+	for _, o := range oo {
+		var rs appsv1.ReplicaSet
+		if err := fromUnstructured(o, &rs); err != nil {
+			return nil, err
+		}
+		rr = append(rr, &rs)
+	}
+
+	oo, err = r.getFactory().List(client.DpGVR, ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	uids := make(sets.Set[types.UID], len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			uids.Insert(u.GetUID())
+		}
+	}
+
+	return orphanedReplicaSets(rr, uids), nil
+}
+
+// orphanedReplicaSets returns the replicasets without a controller or whose controlling
+// deployment no longer exists. Replicasets managed by other controllers are left alone.
+func orphanedReplicaSets(rr []*appsv1.ReplicaSet, dps sets.Set[types.UID]) []*appsv1.ReplicaSet {
+	oo := make([]*appsv1.ReplicaSet, 0, len(rr))
+	for _, rs := range rr {
+		ref := metav1.GetControllerOf(rs)
+		if ref != nil && (ref.Kind != "Deployment" || dps.Has(ref.UID)) {
+			continue
+		}
+		oo = append(oo, rs)
+	}
+
+	return oo
+}
+
 // ListImages lists container images.
 func (r *ReplicaSet) ListImages(_ context.Context, fqn string) ([]string, error) {
 	rs, err := r.Load(r.Factory, fqn)
@@ -57,7 +129,7 @@ func (*ReplicaSet) Load(f Factory, path string) (*appsv1.ReplicaSet, error) {
 }
 
 func getRSRevision(rs *appsv1.ReplicaSet) (int64, error) {
-	revision := rs.Annotations["deployment.kubernetes.io/revision"]
+	revision := rs.Annotations[rsRevisionAnnotation]
 	if rs.Status.Replicas != 0 {
 		return 0, errors.New("can not rollback current replica")
 	}
@@ -85,7 +157,14 @@ func controllerInfo(rs *appsv1.ReplicaSet) (name, kind, group string, err error)
 }
 
 // Rollback reverses the last deployment.
-func (r *ReplicaSet) Rollback(fqn string) error {
+func (r *ReplicaSet) Rollback(fqn string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(r.getFactory(), r.gvr, fqn, "rollback", nil, err)
+	}()
+
 	rs, err := r.Load(r.Factory, fqn)
 	if err != nil {
 		return err
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestListOrphanedReplicaSets(t *testing.T) {
+	uu := map[string]struct {
+		rr  []runtime.Object
+		dps []runtime.Object
+		e   []string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"owned": {
+			rr:  []runtime.Object{toUnstructured(t, orphanRS("rs1", "Deployment", "dp1"))},
+			dps: []runtime.Object{toUnstructured(t, orphanDP("dp1"))},
+			e:   []string{},
+		},
+		"deleted-owner": {
+			rr:  []runtime.Object{toUnstructured(t, orphanRS("rs1", "Deployment", "dp1"))},
+			dps: []runtime.Object{toUnstructured(t, orphanDP("dp2"))},
+			e:   []string{"rs1"},
+		},
+		"other-controller": {
+			rr: []runtime.Object{toUnstructured(t, orphanRS("rs1", "Rollout", "ro1"))},
+			e:  []string{},
+		},
+		"mixed": {
+			rr: []runtime.Object{
+				toUnstructured(t, orphanRS("rs1", "Deployment", "dp1")),
+				toUnstructured(t, orphanRS("rs2", "", "")),
+				toUnstructured(t, orphanRS("rs3", "Rollout", "dp1")),
+				toUnstructured(t, orphanRS("rs4", "Deployment", "dp4")),
+			},
+			dps: []runtime.Object{toUnstructured(t, orphanDP("dp1"))},
+			e:   []string{"rs2", "rs4"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var rs dao.ReplicaSet
+			rs.Init(&testFactory{
+				inventory: map[string]map[*client.GVR][]runtime.Object{
+					"ns1": {client.RsGVR: u.rr, client.DpGVR: u.dps},
+				},
+			}, client.RsGVR)
+
+			rr, err := rs.ListOrphanedReplicaSets(context.Background(), "ns1")
+			require.NoError(t, err)
+			nn := make([]string, 0, len(rr))
+			for _, r := range rr {
+				nn = append(nn, r.Name)
+			}
+			assert.Equal(t, u.e, nn)
+
+			oo, err := rs.List(context.WithValue(context.Background(), internal.KeyOrphaned, true), "ns1")
+			require.NoError(t, err)
+			assert.Len(t, oo, len(u.e))
+
+			oo, err = rs.List(context.Background(), "ns1")
+			require.NoError(t, err)
+			assert.Len(t, oo, len(u.rr))
+		})
+	}
+}
+
+// Helpers...
+
+func orphanRS(n, kind, owner string) *appsv1.ReplicaSet {
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "ns1"},
+	}
+	if kind != "" {
+		rs.OwnerReferences = []metav1.OwnerReference{{
+			Kind:       kind,
+			Name:       owner,
+			UID:        types.UID(owner),
+			Controller: ptr.To(true),
+		}}
+	}
+
+	return &rs
+}
+
+func orphanDP(n string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "ns1", UID: types.UID(n)},
+	}
+}
+
+func toUnstructured(t *testing.T, o any) *unstructured.Unstructured {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	require.NoError(t, err)
+
+	return &unstructured.Unstructured{Object: m}
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/slogs"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/scale"
@@ -42,7 +44,14 @@ func (s *Scaler) Replicas(ctx context.Context, path string) (int32, error) {
 }
 
 // Scale modifies the number of replicas for a given resource specified by the path.
-func (s *Scaler) Scale(ctx context.Context, path string, replicas int32) error {
+func (s *Scaler) Scale(ctx context.Context, path string, replicas int32) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(s.getFactory(), s.gvr, path, "scale", map[string]any{"replicas": replicas}, err)
+	}()
+
 	ns, name := client.Namespaced(path)
 
 	scaleClient, err := s.scaleClient()
@@ -50,24 +59,38 @@ func (s *Scaler) Scale(ctx context.Context, path string, replicas int32) error {
 		return err
 	}
 
-	currentScale, err := scaleClient.Scales(ns).Get(ctx, *s.gvr.GR(), name, metav1.GetOptions{})
+	return scaleTo(ctx, scaleClient, *s.gvr.GR(), ns, name, replicas)
+}
+
+// scaleTo updates the scale subresource of the given resource to the given replicas.
+func scaleTo(ctx context.Context, sc scale.ScalesGetter, gr schema.GroupResource, ns, name string, replicas int32) error {
+	currentScale, err := sc.Scales(ns).Get(ctx, gr, name, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
 
 	currentScale.Spec.Replicas = replicas
-	updatedScale, err := scaleClient.Scales(ns).Update(ctx, *s.gvr.GR(), currentScale, metav1.UpdateOptions{})
+	updatedScale, err := sc.Scales(ns).Update(ctx, gr, currentScale, metav1.UpdateOptions{})
 	if err != nil {
 		return err
 	}
 
 	slog.Debug("Scaled resource",
-		slogs.FQN, path,
+		slogs.FQN, client.FQN(ns, name),
 		slogs.Replicas, updatedScale.Spec.Replicas,
 	)
 	return nil
 }
 
+func (s *Scaler) restMapper() (meta.RESTMapper, error) {
+	discoveryClient, err := s.Client().CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+
+	return restmapper.NewDeferredDiscoveryRESTMapper(discoveryClient), nil
+}
+
 func (s *Scaler) scaleClient() (scale.ScalesGetter, error) {
 	cfg, err := s.Client().RestConfig()
 	if err != nil {
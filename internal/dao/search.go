@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	searchScoreLabel = iota + 1
+	searchScoreNamespace
+	searchScoreName
+	searchScorePrefix
+	searchScoreExact
+)
+
+var _ Accessor = (*Search)(nil)
+
+// DefaultSearchKinds tracks the resources searched when none are specified.
+var DefaultSearchKinds = []string{
+	client.PodGVR.String(),
+	client.DpGVR.String(),
+	client.StsGVR.String(),
+	client.DsGVR.String(),
+	client.JobGVR.String(),
+	client.CjGVR.String(),
+	client.SvcGVR.String(),
+	client.CmGVR.String(),
+	client.SecGVR.String(),
+	client.NodeGVR.String(),
+	client.NsGVR.String(),
+}
+
+// SearchResult tracks a resource matching a global search term.
+type SearchResult struct {
+	GVR       *client.GVR
+	Namespace string
+	Name      string
+	Match     string
+	Score     int
+}
+
+// Search represents a global search across resource kinds.
+type Search struct {
+	NonResource
+}
+
+// List returns the resources matching the search term and kinds set in the context.
+func (s *Search) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	term, _ := ctx.Value(internal.KeySearchTerm).(string)
+	kinds, _ := ctx.Value(internal.KeySearchKinds).([]string)
+	rr, err := s.GlobalSearch(ctx, term, kinds)
+	if err != nil && len(rr) == 0 {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(rr))
+	for _, r := range rr {
+		oo = append(oo, render.SearchRes{
+			GVR:       r.GVR.String(),
+			Namespace: r.Namespace,
+			Name:      r.Name,
+			Match:     r.Match,
+		})
+	}
+
+	return oo, nil
+}
+
+// GlobalSearch concurrently lists the given resource kinds and returns the resources
+// whose name, namespace or labels contain the term, best matches first.
+// Kinds that cannot be listed are reported but do not fail the search.
+func (s *Search) GlobalSearch(ctx context.Context, term string, kinds []string) ([]SearchResult, error) {
+	term = strings.ToLower(strings.TrimSpace(term))
+	if term == "" {
+		return nil, errors.New("search term must not be blank")
+	}
+	if len(kinds) == 0 {
+		kinds = DefaultSearchKinds
+	}
+
+	var (
+		rr   []SearchResult
+		errs error
+		wg   sync.WaitGroup
+		mx   sync.Mutex
+	)
+	for _, kind := range kinds {
+		wg.Add(1)
+		go func(gvr *client.GVR) {
+			defer wg.Done()
+			oo, err := s.getFactory().List(gvr, client.BlankNamespace, true, labels.Everything())
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("%s: %w", gvr, err))
+				return
+			}
+			rr = append(rr, searchMatches(ctx, gvr, oo, term)...)
+		}(client.NewGVR(kind))
+	}
+	wg.Wait()
+	rankSearchResults(rr)
+
+	return rr, errs
+}
+
+func searchMatches(ctx context.Context, gvr *client.GVR, oo []runtime.Object, term string) []SearchResult {
+	var rr []SearchResult
+	for _, o := range oo {
+		if ctx.Err() != nil {
+			return rr
+		}
+		m, ok := o.(metav1.Object)
+		if !ok {
+			continue
+		}
+		if score, match := searchScore(term, m.GetNamespace(), m.GetName(), m.GetLabels()); score > 0 {
+			rr = append(rr, SearchResult{
+				GVR:       gvr,
+				Namespace: m.GetNamespace(),
+				Name:      m.GetName(),
+				Match:     match,
+				Score:     score,
+			})
+		}
+	}
+
+	return rr
+}
+
+// searchScore returns how well a resource matches the given lower cased term
+// along with the matching field. A zero score indicates no match.
+func searchScore(term, ns, name string, ll map[string]string) (int, string) {
+	n := strings.ToLower(name)
+	switch {
+	case n == term:
+		return searchScoreExact, "name"
+	case strings.HasPrefix(n, term):
+		return searchScorePrefix, "name"
+	case strings.Contains(n, term):
+		return searchScoreName, "name"
+	case strings.Contains(strings.ToLower(ns), term):
+		return searchScoreNamespace, "namespace"
+	}
+	for k, v := range ll {
+		if strings.Contains(strings.ToLower(k), term) || strings.Contains(strings.ToLower(v), term) {
+			return searchScoreLabel, "label " + k + "=" + v
+		}
+	}
+
+	return 0, ""
+}
+
+// rankSearchResults orders results by score then kind, namespace and name.
+func rankSearchResults(rr []SearchResult) {
+	slices.SortFunc(rr, func(a, b SearchResult) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			strings.Compare(a.GVR.String(), b.GVR.String()),
+			strings.Compare(a.Namespace, b.Namespace),
+			strings.Compare(a.Name, b.Name),
+		)
+	})
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchScore(t *testing.T) {
+	uu := map[string]struct {
+		ns, name string
+		ll       map[string]string
+		score    int
+		match    string
+	}{
+		"exact": {
+			name:  "Fred",
+			score: searchScoreExact,
+			match: "name",
+		},
+		"prefix": {
+			name:  "fred-1",
+			score: searchScorePrefix,
+			match: "name",
+		},
+		"contains": {
+			name:  "blee-fred",
+			score: searchScoreName,
+			match: "name",
+		},
+		"namespace": {
+			ns:    "fred-ns",
+			name:  "blee",
+			score: searchScoreNamespace,
+			match: "namespace",
+		},
+		"label": {
+			name:  "blee",
+			ll:    map[string]string{"app": "fred"},
+			score: searchScoreLabel,
+			match: "label app=fred",
+		},
+		"none": {
+			ns:   "default",
+			name: "blee",
+			ll:   map[string]string{"app": "zorg"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			score, match := searchScore("fred", u.ns, u.name, u.ll)
+			assert.Equal(t, u.score, score)
+			assert.Equal(t, u.match, match)
+		})
+	}
+}
+
+func TestRankSearchResults(t *testing.T) {
+	rr := []SearchResult{
+		{GVR: client.SvcGVR, Namespace: "ns1", Name: "blee-fred", Score: searchScoreName},
+		{GVR: client.PodGVR, Namespace: "ns2", Name: "fred", Score: searchScoreExact},
+		{GVR: client.DpGVR, Namespace: "ns1", Name: "fred", Score: searchScoreExact},
+		{GVR: client.PodGVR, Namespace: "fred", Name: "zorg", Score: searchScoreNamespace},
+	}
+	rankSearchResults(rr)
+
+	ee := []string{
+		"apps/v1/deployments ns1/fred",
+		"v1/pods ns2/fred",
+		"v1/services ns1/blee-fred",
+		"v1/pods fred/zorg",
+	}
+	for i, r := range rr {
+		assert.Equal(t, ee[i], r.GVR.String()+" "+client.FQN(r.Namespace, r.Name))
+	}
+}
@@ -14,6 +14,8 @@ import (
 )
 
 // Secret represents a secret K8s resource.
+//
+// Decoded values are for display only and must never be logged.
 type Secret struct {
 	Resource
 	decodeData bool
@@ -37,6 +39,28 @@ func (s *Secret) SetDecodeData(b bool) {
 	s.decodeData = b
 }
 
+// ToYAML returns a secret manifest with its data values decoded when in decode mode.
+func (s *Secret) ToYAML(path string, showManaged bool) (string, error) {
+	if !s.decodeData {
+		return s.Resource.ToYAML(path, showManaged)
+	}
+
+	o, err := s.getFactory().Get(s.gvr, path, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, err := decodeSecretData(o)
+	if err != nil {
+		return "", err
+	}
+	raw, err := ToYAML(u, showManaged)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal resource %w", err)
+	}
+
+	return raw, nil
+}
+
 // Decode removes the encoded part from the secret's description and appends the
 // secret's decoded data.
 func (s *Secret) Decode(encodedDescription, path string) (string, error) {
@@ -73,6 +97,27 @@ func (s *Secret) Decode(encodedDescription, path string) (string, error) {
 	return body + "\n" + strings.Join(decodedSecrets, "\n"), nil
 }
 
+// decodeSecretData returns a copy of the given secret with its data values decoded.
+func decodeSecretData(o runtime.Object) (*unstructured.Unstructured, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+	}
+	mm, err := ExtractSecrets(u)
+	if err != nil {
+		return nil, err
+	}
+	u = u.DeepCopy()
+	if len(mm) == 0 {
+		return u, nil
+	}
+	if err := unstructured.SetNestedStringMap(u.Object, mm, "data"); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
 // ExtractSecrets takes an unstructured object and attempts to convert it into a
 // Kubernetes Secret.
 // It returns a map where the keys are the secret data keys and the values are
@@ -43,3 +43,31 @@ token-secret:  24 bytes`
 	require.NoError(t, err)
 	assert.Equal(t, expected, decodedDescription)
 }
+
+func TestSecretToYAML(t *testing.T) {
+	uu := map[string]struct {
+		decode bool
+		e      string
+	}{
+		"encoded": {
+			e: "token-secret: MDEyMzQ1Njc4OWFiY2RlZg==",
+		},
+		"decoded": {
+			decode: true,
+			e:      "token-secret: 0123456789abcdef",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var s dao.Secret
+			s.Init(makeFactory(), client.SecGVR)
+			s.SetDecodeData(u.decode)
+
+			raw, err := s.ToYAML("kube-system/bootstrap-token-abcdef", false)
+			require.NoError(t, err)
+			assert.Contains(t, raw, u.e)
+		})
+	}
+}
@@ -19,7 +19,11 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
 var (
@@ -58,6 +62,50 @@ func (s *StatefulSet) Restart(ctx context.Context, path string) error {
 	return restartRes[*appsv1.StatefulSet](ctx, s.getFactory(), client.StsGVR, path)
 }
 
+// RollbackRestart rolls a StatefulSet back to its previous revision, undoing its last restart.
+func (s *StatefulSet) RollbackRestart(ctx context.Context, namespace, name string) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(s.getFactory(), client.StsGVR, client.FQN(namespace, name), "rollback", nil, err)
+	}()
+
+	auth, err := s.Client().CanI(namespace, client.StsGVR, name, client.PatchAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to rollback statefulset %q", client.FQN(namespace, name))
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return err
+	}
+	sts, err := dial.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	return rollbackStatefulSet(dial, sts)
+}
+
+// rollbackStatefulSet reverts a StatefulSet pod template to its previous controller revision.
+func rollbackStatefulSet(dial kubernetes.Interface, sts *appsv1.StatefulSet) error {
+	rb, err := polymorphichelpers.RollbackerFor(schema.GroupKind{
+		Group: appsv1.GroupName,
+		Kind:  "StatefulSet",
+	},
+		dial,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = rb.Rollback(sts, map[string]string{}, 0, cmdutil.DryRunNone)
+
+	return err
+}
+
 // GetInstance returns a statefulset instance.
 func (*StatefulSet) GetInstance(f Factory, fqn string) (*appsv1.StatefulSet, error) {
 	o, err := f.Get(client.StsGVR, fqn, true, labels.Everything())
@@ -220,7 +268,14 @@ func (s *StatefulSet) GetPodSpec(path string) (*v1.PodSpec, error) {
 }
 
 // SetImages sets container images.
-func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) error {
+func (s *StatefulSet) SetImages(ctx context.Context, path string, imageSpecs ImageSpecs) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
+	defer func() {
+		auditAction(s.getFactory(), client.StsGVR, path, "set image", map[string]any{"images": imageSpecs}, err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := s.Client().CanI(ns, client.StsGVR, n, client.PatchAccess)
 	if err != nil {
@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+func makeStsRevision(sts *appsv1.StatefulSet, n string, rev int64, restartedAt string) *appsv1.ControllerRevision {
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: sts.Namespace,
+			Name:      n,
+			Labels:    sts.Spec.Selector.MatchLabels,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+					Name:       sts.Name,
+					UID:        sts.UID,
+					Controller: ptr.To(true),
+				},
+			},
+		},
+		Data: runtime.RawExtension{
+			Raw: []byte(`{"spec":{"template":{"metadata":{"labels":{"app":"db"},"annotations":{"kubectl.kubernetes.io/restartedAt":"` +
+				restartedAt + `"}},"spec":{"containers":[{"name":"c1","image":"db:1"}]},"$patch":"replace"}}}`),
+		},
+		Revision: rev,
+	}
+}
+
+func TestRollbackStatefulSet(t *testing.T) {
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "db", UID: types.UID("u1")},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		},
+	}
+	sts.Spec.Template.Labels = map[string]string{"app": "db"}
+	sts.Spec.Template.Annotations = map[string]string{"kubectl.kubernetes.io/restartedAt": "t2"}
+	sts.Spec.Template.Spec.Containers = []v1.Container{{Name: "c1", Image: "db:1"}}
+	dial := fake.NewClientset(
+		&sts,
+		makeStsRevision(&sts, "db-1", 1, "t1"),
+		makeStsRevision(&sts, "db-2", 2, "t2"),
+	)
+
+	require.NoError(t, rollbackStatefulSet(dial, &sts))
+	o, err := dial.AppsV1().StatefulSets("ns1").Get(context.Background(), "db", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"kubectl.kubernetes.io/restartedAt": "t1"}, o.Spec.Template.Annotations)
+}
+
+func TestRollbackStatefulSetNoHistory(t *testing.T) {
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "db"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		},
+	}
+
+	assert.Error(t, rollbackStatefulSet(fake.NewClientset(&sts), &sts))
+}
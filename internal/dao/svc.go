@@ -7,12 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 )
 
 var (
@@ -65,9 +71,113 @@ func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 	return &svc, nil
 }
 
+// CheckEndpointHealth probes all the service endpoints through the api server pod proxy
+// and reports their reachability from within the cluster.
+func (s *Service) CheckEndpointHealth(ctx context.Context, namespace, serviceName string, timeout time.Duration) ([]EndpointHealth, error) {
+	o, err := s.getFactory().Get(client.EpGVR, client.FQN(namespace, serviceName), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ep v1.Endpoints
+	if err := fromUnstructured(o, &ep); err != nil {
+		return nil, err
+	}
+	auth, err := s.Client().CanI(namespace, client.PodGVR.WithSubResource("proxy"), "", client.GetAccess)
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to proxy pods in namespace %q", namespace)
+	}
+	dial, err := s.Client().Dial()
+	if err != nil {
+		return nil, err
+	}
+
+	return checkEndpoints(ctx, &ep, timeout, proxyProbe(dial, namespace)), nil
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+type probeFunc func(ctx context.Context, pod string, port int32) error
+
+// proxyProbe probes a pod port via the api server proxy as pod IPs are usually not
+// reachable from outside the cluster network.
+func proxyProbe(dial kubernetes.Interface, ns string) probeFunc {
+	return func(ctx context.Context, pod string, port int32) error {
+		_, err := dial.CoreV1().Pods(ns).ProxyGet("", pod, strconv.Itoa(int(port)), "/", nil).DoRaw(ctx)
+		if endpointAnswered(err) {
+			return nil
+		}
+
+		return err
+	}
+}
+
+// endpointAnswered returns true if a proxied request reached the endpoint. Errors
+// raised by the api server proxy carry a status while endpoint responses do not.
+func endpointAnswered(err error) bool {
+	return err == nil || apierrors.HasStatusCause(err, metav1.CauseTypeUnexpectedServerResponse)
+}
+
+// checkEndpoints probes the endpoints ready addresses concurrently. Not ready addresses,
+// addresses not backed by a pod and non TCP ports are reported as unhealthy without being probed.
+func checkEndpoints(ctx context.Context, ep *v1.Endpoints, timeout time.Duration, probe probeFunc) []EndpointHealth {
+	var (
+		hh   []EndpointHealth
+		pods []string
+		wg   sync.WaitGroup
+	)
+	for _, sub := range ep.Subsets {
+		for _, port := range sub.Ports {
+			for _, a := range sub.NotReadyAddresses {
+				hh, pods = append(hh, EndpointHealth{Address: a.IP, Port: port.Port, Error: "endpoint not ready"}), append(pods, "")
+			}
+			for _, a := range sub.Addresses {
+				h := EndpointHealth{Address: a.IP, Port: port.Port}
+				var pod string
+				switch {
+				case port.Protocol != "" && port.Protocol != v1.ProtocolTCP:
+					h.Error = fmt.Sprintf("%s probes are not supported", port.Protocol)
+				case a.TargetRef == nil || a.TargetRef.Kind != "Pod":
+					h.Error = "endpoint is not backed by a pod"
+				default:
+					pod = a.TargetRef.Name
+				}
+				hh, pods = append(hh, h), append(pods, pod)
+			}
+		}
+	}
+	for i := range hh {
+		if hh[i].Error != "" {
+			continue
+		}
+		wg.Add(1)
+		go func(h *EndpointHealth, pod string) {
+			defer wg.Done()
+			probeEndpoint(ctx, h, pod, timeout, probe)
+		}(&hh[i], pods[i])
+	}
+	wg.Wait()
+
+	return hh
+}
+
+func probeEndpoint(ctx context.Context, h *EndpointHealth, pod string, timeout time.Duration, probe probeFunc) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	t := time.Now()
+	err := probe(ctx, pod, h.Port)
+	h.LatencyMs = time.Since(t).Milliseconds()
+	if err != nil {
+		h.Error = err.Error()
+		return
+	}
+	h.Healthy = true
+}
+
 func podFromSelector(f Factory, ns string, sel map[string]string) (string, error) {
 	oo, err := f.List(client.PodGVR, ns, true, labels.Set(sel).AsSelector())
 	if err != nil {
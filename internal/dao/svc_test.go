@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCheckEndpoints(t *testing.T) {
+	podRef := func(n string) *v1.ObjectReference {
+		return &v1.ObjectReference{Kind: "Pod", Name: n}
+	}
+	ep := v1.Endpoints{
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses: []v1.EndpointAddress{
+					{IP: "10.0.0.1", TargetRef: podRef("p1")},
+					{IP: "10.0.0.2", TargetRef: podRef("p2")},
+					{IP: "10.0.0.5"},
+				},
+				NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.3", TargetRef: podRef("p3")}},
+				Ports:             []v1.EndpointPort{{Port: 80, Protocol: v1.ProtocolTCP}},
+			},
+			{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.0.4", TargetRef: podRef("p4")}},
+				Ports:     []v1.EndpointPort{{Port: 53, Protocol: v1.ProtocolUDP}},
+			},
+		},
+	}
+	probe := func(_ context.Context, pod string, _ int32) error {
+		if pod == "p2" {
+			return errors.New("connection refused")
+		}
+		return nil
+	}
+
+	hh := checkEndpoints(context.Background(), &ep, time.Second, probe)
+	for i := range hh {
+		hh[i].LatencyMs = 0
+	}
+	assert.Equal(t, []EndpointHealth{
+		{Address: "10.0.0.3", Port: 80, Error: "endpoint not ready"},
+		{Address: "10.0.0.1", Port: 80, Healthy: true},
+		{Address: "10.0.0.2", Port: 80, Error: "connection refused"},
+		{Address: "10.0.0.5", Port: 80, Error: "endpoint is not backed by a pod"},
+		{Address: "10.0.0.4", Port: 53, Error: "UDP probes are not supported"},
+	}, hh)
+}
+
+func TestCheckEndpointsTimeout(t *testing.T) {
+	ep := v1.Endpoints{
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses: []v1.EndpointAddress{{IP: "10.0.0.1", TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p1"}}},
+				Ports:     []v1.EndpointPort{{Port: 80}},
+			},
+		},
+	}
+	probe := func(ctx context.Context, _ string, _ int32) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	hh := checkEndpoints(context.Background(), &ep, 10*time.Millisecond, probe)
+	assert.Len(t, hh, 1)
+	assert.False(t, hh[0].Healthy)
+	assert.Equal(t, context.DeadlineExceeded.Error(), hh[0].Error)
+}
+
+func TestEndpointAnswered(t *testing.T) {
+	gr := schema.GroupResource{Resource: "pods"}
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"ok": {
+			e: true,
+		},
+		"endpoint-error": {
+			err: apierrors.NewGenericServerResponse(http.StatusNotFound, "GET", gr, "p1", "404 page not found", 0, true),
+			e:   true,
+		},
+		"unreachable": {
+			err: apierrors.NewServiceUnavailable("error trying to reach service: dial tcp 10.0.0.1:80: connect: connection refused"),
+		},
+		"forbidden": {
+			err: apierrors.NewForbidden(gr, "p1", errors.New("denied")),
+		},
+		"timeout": {
+			err: context.DeadlineExceeded,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, endpointAnswered(u.err))
+		})
+	}
+}
@@ -11,11 +11,13 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/watch"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/informers"
 	restclient "k8s.io/client-go/rest"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 // Factory represents a resource factory.
@@ -86,6 +88,142 @@ type DrainOptions struct {
 	DeleteEmptyDirData  bool
 	Force               bool
 	DisableEviction     bool
+	DryRun              bool
+	CacheTTL            time.Duration
+	EvictionOrder       EvictionOrder
+	ChunkSize           int
+	InterChunkDelay     time.Duration
+	PreHooks            []string
+	PostHooks           []string
+	HookTimeout         time.Duration
+
+	// MarkSafeToEvict evicts pods annotated as not safe to evict. Their annotation
+	// is set for the drain duration and restored on the pods left behind.
+	MarkSafeToEvict bool
+}
+
+// EvictionOrder tracks the order in which pods are evicted during a drain.
+type EvictionOrder string
+
+const (
+	// EvictionOrderDefault evicts all pods at once.
+	EvictionOrderDefault EvictionOrder = "default"
+
+	// EvictionOrderPriority evicts pods by ascending priority, highest priority pods last.
+	EvictionOrderPriority EvictionOrder = "priority"
+
+	// EvictionOrderNamespace evicts pods one namespace at a time, alphabetically.
+	EvictionOrderNamespace EvictionOrder = "namespace"
+)
+
+// EvictionOrders returns all the supported eviction orders.
+func EvictionOrders() []EvictionOrder {
+	return []EvictionOrder{EvictionOrderDefault, EvictionOrderPriority, EvictionOrderNamespace}
+}
+
+// DrainEvent tracks a pod eviction attempt during a node drain.
+type DrainEvent struct {
+	PodName   string
+	Namespace string
+	Phase     string
+	Err       error
+}
+
+// DrainSimulation tracks the outcome of a simulated node drain.
+type DrainSimulation struct {
+	// BlockedPods tracks pods whose eviction would violate a PDB.
+	BlockedPods []BlockedPod
+
+	// SafePods tracks the fully qualified names of pods that can be evicted.
+	SafePods []string
+
+	// PDBs tracks the disruption budgets covering the drained pods.
+	PDBs []*policyv1.PodDisruptionBudget
+}
+
+// BlockedPod tracks a pod eviction blocked by a PodDisruptionBudget.
+type BlockedPod struct {
+	Pod                string
+	PDBName            string
+	CurrentDisruptions int32
+	MaxDisruptions     int32
+}
+
+// EndpointHealth tracks a service endpoint reachability.
+type EndpointHealth struct {
+	Address   string
+	Port      int32
+	Healthy   bool
+	LatencyMs int64
+	Error     string
+}
+
+// NodeReadinessEvent tracks a node Ready condition transition.
+type NodeReadinessEvent struct {
+	Name      string
+	OldReady  bool
+	NewReady  bool
+	Timestamp time.Time
+}
+
+// ConditionEvent tracks a node condition transition reported by an event.
+type ConditionEvent struct {
+	Condition v1.NodeConditionType
+	Timestamp time.Time
+	Status    v1.ConditionStatus
+	Reason    string
+	Message   string
+}
+
+// AnnotationOp tracks an annotation change operation.
+type AnnotationOp string
+
+const (
+	// AnnotationAdd tracks an added annotation.
+	AnnotationAdd AnnotationOp = "add"
+
+	// AnnotationRemove tracks a removed annotation.
+	AnnotationRemove AnnotationOp = "remove"
+
+	// AnnotationUpdate tracks an updated annotation.
+	AnnotationUpdate AnnotationOp = "update"
+)
+
+// AnnotationChange tracks a resource annotation change.
+type AnnotationChange struct {
+	Key      string
+	OldValue string
+	NewValue string
+	Op       AnnotationOp
+}
+
+// NodeResourceSummary tracks a node allocatable resources vs its pods requests.
+// CPU is expressed in millicores and memory in bytes.
+type NodeResourceSummary struct {
+	AllocatableCPU    int64
+	AllocatableMemory int64
+	RequestedCPU      int64
+	RequestedMemory   int64
+}
+
+// ResourceField tracks a pod resource used to rank pods.
+type ResourceField string
+
+const (
+	// ResourceCPU ranks pods by CPU usage.
+	ResourceCPU ResourceField = "cpu"
+
+	// ResourceMEM ranks pods by memory usage.
+	ResourceMEM ResourceField = "memory"
+)
+
+// PodWithMetrics tracks a pod along with its current usage.
+// CPU is expressed in millicores and memory in bytes.
+type PodWithMetrics struct {
+	Pod *v1.Pod
+	MX  *mv1beta1.PodMetrics
+	CPU int64
+	MEM int64
 }
 
 // NodeMaintainer performs node maintenance operations.
@@ -93,8 +231,35 @@ type NodeMaintainer interface {
 	// ToggleCordon toggles cordon/uncordon a node.
 	ToggleCordon(path string, cordon bool) error
 
+	// Cordon marks a node as unschedulable.
+	Cordon(path string) error
+
+	// Uncordon marks a node as schedulable.
+	Uncordon(path string) error
+
+	// BatchToggleCordon toggles cordon/uncordon on a collection of nodes.
+	BatchToggleCordon(paths []string, cordon bool) []error
+
+	// CordonWithReason cordons a node and records the reason.
+	CordonWithReason(path, reason string) error
+
+	// BatchCordonWithReason cordons a collection of nodes and records the reason.
+	BatchCordonWithReason(paths []string, reason string) []error
+
+	// Isolate cordons and taints a node.
+	Isolate(ctx context.Context, path string, taint v1.Taint) error
+
+	// Deisolate reverses a node isolation.
+	Deisolate(ctx context.Context, path string, taint v1.Taint) error
+
 	// Drain drains the given node.
 	Drain(path string, opts DrainOptions, w io.Writer) error
+
+	// DrainWithEvents drains the given node and reports pod evictions on the events channel.
+	DrainWithEvents(path string, opts DrainOptions, events chan<- DrainEvent) error
+
+	// DrainDryRun previews a drain without evicting any pods.
+	DrainDryRun(path string, opts DrainOptions, w io.Writer) ([]string, []error)
 }
 
 // Loggable represents resources with logs.
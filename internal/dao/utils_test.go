@@ -13,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/watch"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -52,8 +53,19 @@ func (f *testFactory) Get(gvr *client.GVR, fqn string, _ bool, _ labels.Selector
 
 	return nil, nil
 }
-func (f *testFactory) List(gvr *client.GVR, ns string, _ bool, _ labels.Selector) ([]runtime.Object, error) {
-	return f.inventory[ns][gvr], nil
+func (f *testFactory) List(gvr *client.GVR, ns string, _ bool, sel labels.Selector) ([]runtime.Object, error) {
+	oo := f.inventory[ns][gvr]
+	if sel == nil || sel.Empty() {
+		return oo, nil
+	}
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		if m, ok := o.(metav1.Object); ok && sel.Matches(labels.Set(m.GetLabels())) {
+			res = append(res, o)
+		}
+	}
+
+	return res, nil
 }
 
 func (*testFactory) ForResource(string, *client.GVR) (informers.GenericInformer, error) {
@@ -40,8 +40,15 @@ type Workload struct {
 	Table
 }
 
-func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) error {
+func (w *Workload) Delete(ctx context.Context, path string, propagation *metav1.DeletionPropagation, grace Grace) (err error) {
+	if err = ReadonlyGuard(); err != nil {
+		return err
+	}
 	gvr, _ := ctx.Value(internal.KeyGVR).(*client.GVR)
+	defer func() {
+		auditAction(w.getFactory(), gvr, path, "delete", deleteParams(propagation, grace), err)
+	}()
+
 	ns, n := client.Namespaced(path)
 	auth, err := w.Client().CanI(ns, gvr, n, []string{client.DeleteVerb})
 	if err != nil {
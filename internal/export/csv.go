@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+)
+
+// ExportTableCSV writes the given table rows visible columns as CSV.
+// Fields are truncated to their max column widths, as shown in the table.
+func ExportTableCSV(w io.Writer, h model1.Header, rr []model1.Row, cols []int, ww map[string]int) error {
+	if len(ww) == 0 {
+		return WriteCSV(w, h, rr, cols)
+	}
+	tt := make([]model1.Row, 0, len(rr))
+	for _, r := range rr {
+		ff := make(model1.Fields, len(r.Fields))
+		for c, f := range r.Fields {
+			ff[c] = f
+			if c >= len(h) {
+				continue
+			}
+			if width, ok := ww[h[c].Name]; ok {
+				ff[c] = render.TruncateColumn(f, width)
+			}
+		}
+		tt = append(tt, model1.Row{ID: r.ID, Fields: ff})
+	}
+
+	return WriteCSV(w, h, tt, cols)
+}
+
+// WriteCSV writes the given rows columns as CSV preceded by a header row.
+// Fields containing commas, quotes or new lines are quoted.
+func WriteCSV(w io.Writer, h model1.Header, rr []model1.Row, cols []int) error {
+	out := csv.NewWriter(w)
+
+	rec := make([]string, len(cols))
+	for i, c := range cols {
+		if c >= len(h) {
+			return fmt.Errorf("column index %d out of range (%d columns)", c, len(h))
+		}
+		rec[i] = h[c].Name
+	}
+	if err := out.Write(rec); err != nil {
+		return err
+	}
+	for _, r := range rr {
+		for i, c := range cols {
+			rec[i] = ""
+			if c < len(r.Fields) {
+				rec[i] = r.Fields[c]
+			}
+		}
+		if err := out.Write(rec); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+
+	return out.Error()
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package export_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/export"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteCSV(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "ROLE"},
+		model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	}
+	rr := []model1.Row{
+		{ID: "n1", Fields: model1.Fields{"n1", "control-plane,master", "a=b"}},
+		{ID: "n2", Fields: model1.Fields{"n2", `say "hi"`, "c=d"}},
+		{ID: "n3", Fields: model1.Fields{"n3"}},
+	}
+
+	uu := map[string]struct {
+		cols []int
+		e    string
+		err  bool
+	}{
+		"visible": {
+			cols: []int{0, 1},
+			e:    "NAME,ROLE\nn1,\"control-plane,master\"\nn2,\"say \"\"hi\"\"\"\nn3,\n",
+		},
+		"reordered": {
+			cols: []int{2, 0},
+			e:    "LABELS,NAME\na=b,n1\nc=d,n2\n,n3\n",
+		},
+		"none": {
+			e: "\n\n\n\n",
+		},
+		"out-of-range": {
+			cols: []int{3},
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			err := export.WriteCSV(&buff, h, rr, u.cols)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
+
+func TestExportTableCSV(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "ROLE"},
+		model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	}
+	rr := []model1.Row{
+		{ID: "n1", Fields: model1.Fields{"n1", "control-plane,worker", "a=b"}},
+		{ID: "n2", Fields: model1.Fields{"n2", "worker", "c=d"}},
+	}
+
+	uu := map[string]struct {
+		cols []int
+		ww   map[string]int
+		e    string
+	}{
+		"visible": {
+			cols: []int{0, 1},
+			e:    "NAME,ROLE\nn1,\"control-plane,worker\"\nn2,worker\n",
+		},
+		"wide": {
+			cols: []int{0, 1, 2},
+			e:    "NAME,ROLE,LABELS\nn1,\"control-plane,worker\",a=b\nn2,worker,c=d\n",
+		},
+		"truncated": {
+			cols: []int{0, 1},
+			ww:   map[string]int{"ROLE": 7},
+			e:    "NAME,ROLE\nn1,contro…\nn2,worker\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			require.NoError(t, export.ExportTableCSV(&buff, h, rr, u.cols, u.ww))
+			assert.Equal(t, u.e, buff.String())
+			assert.Equal(t, "control-plane,worker", rr[0].Fields[1])
+		})
+	}
+}
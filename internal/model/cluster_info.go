@@ -43,6 +43,7 @@ type ClusterMeta struct {
 	K9sVer, K9sLatest   string
 	K8sVer              string
 	Cpu, Mem, Ephemeral int
+	Pool                client.PoolHealth
 }
 
 // NewClusterMeta returns a new instance.
@@ -64,6 +65,9 @@ func (c *ClusterMeta) Deltas(n *ClusterMeta) bool {
 	if c.Cpu != n.Cpu || c.Mem != n.Mem || c.Ephemeral != n.Ephemeral {
 		return true
 	}
+	if c.Pool != n.Pool {
+		return true
+	}
 
 	return c.Context != n.Context ||
 		c.Cluster != n.Cluster ||
@@ -136,6 +140,7 @@ func (c *ClusterInfo) Refresh() {
 		data.Cluster = c.cluster.ClusterName()
 		data.User = c.cluster.UserName()
 		data.K8sVer = c.cluster.Version()
+		data.Pool = c.factory.Client().PoolHealth()
 		ctx, cancel := context.WithTimeout(context.Background(), c.cluster.factory.Client().Config().CallTimeout())
 		defer cancel()
 		var mx client.ClusterMetrics
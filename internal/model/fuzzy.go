@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sahilm/fuzzy"
+)
+
+// FuzzyFilterPrefix flags a table filter as a fuzzy resource name filter.
+const FuzzyFilterPrefix = "~"
+
+// FuzzyQuery returns the fuzzy query for a table filter if any.
+// Only filters starting with ~ are fuzzy, others keep the regex semantics.
+func FuzzyQuery(q string) (string, bool) {
+	q, ok := strings.CutPrefix(strings.TrimSpace(q), FuzzyFilterPrefix)
+	if q = strings.TrimSpace(q); !ok || q == "" {
+		return "", false
+	}
+
+	return q, true
+}
+
+// FuzzyMatcher scores resource names against a fuzzy query.
+type FuzzyMatcher struct {
+	query string
+}
+
+// NewFuzzyMatcher returns a new matcher.
+func NewFuzzyMatcher(q string) *FuzzyMatcher {
+	return &FuzzyMatcher{query: strings.TrimSpace(q)}
+}
+
+// Score returns a name match score and its matched characters rune offsets.
+// It returns false if the name does not match.
+func (f *FuzzyMatcher) Score(name string) (score int, indexes []int, ok bool) {
+	mm := fuzzy.Find(f.query, []string{name})
+	if len(mm) == 0 {
+		return 0, nil, false
+	}
+
+	// Matched indexes are byte offsets.
+	ii := make([]int, 0, len(mm[0].MatchedIndexes))
+	var r int
+	for i := range name {
+		if slices.Contains(mm[0].MatchedIndexes, i) {
+			ii = append(ii, r)
+		}
+		r++
+	}
+
+	return mm[0].Score, ii, true
+}
+
+// Matches checks if a name matches the query.
+func (f *FuzzyMatcher) Matches(name string) bool {
+	_, _, ok := f.Score(name)
+
+	return ok
+}
+
+// Highlight colors the characters of s matching the query.
+func (f *FuzzyMatcher) Highlight(s, color string) string {
+	_, ii, ok := f.Score(strings.TrimRight(s, " "))
+	if !ok {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s) + len(ii)*(len(color)+10))
+	var r int
+	for _, c := range s {
+		if slices.Contains(ii, r) {
+			b.WriteString("[" + color + "::b]" + string(c) + "[-::-]")
+		} else {
+			b.WriteRune(c)
+		}
+		r++
+	}
+
+	return b.String()
+}
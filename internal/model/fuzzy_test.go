@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyQuery(t *testing.T) {
+	uu := map[string]struct {
+		q, e string
+		ok   bool
+	}{
+		"empty":   {},
+		"blank":   {q: "~ "},
+		"fuzzy":   {q: " ~ngx ", e: "ngx", ok: true},
+		"plain":   {q: "ngx"},
+		"regex":   {q: "ngx.*"},
+		"inverse": {q: "!ngx"},
+		"labels":  {q: "-l app=ngx"},
+		"find":    {q: "-f ngx"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, ok := model.FuzzyQuery(u.q)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, q)
+		})
+	}
+}
+
+func TestFuzzyMatcherScore(t *testing.T) {
+	m := model.NewFuzzyMatcher("ngx")
+
+	s1, ii, ok := m.Score("nginx")
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 4}, ii)
+
+	s2, _, ok := m.Score("nginx-ingress-controller")
+	assert.True(t, ok)
+	assert.Greater(t, s1, s2)
+
+	assert.True(t, m.Matches("NGINX"))
+	assert.False(t, m.Matches("redis"))
+}
+
+func TestFuzzyMatcherHighlight(t *testing.T) {
+	uu := map[string]struct {
+		q, s, e string
+	}{
+		"match": {
+			q: "ngx",
+			s: "nginx  ",
+			e: "[red::b]n[-::-][red::b]g[-::-]in[red::b]x[-::-]  ",
+		},
+		"multibyte": {
+			q: "né",
+			s: "néo",
+			e: "[red::b]n[-::-][red::b]é[-::-]o",
+		},
+		"no-match": {
+			q: "redis",
+			s: "nginx",
+			e: "nginx",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, model.NewFuzzyMatcher(u.q).Highlight(u.s, "red"))
+		})
+	}
+}
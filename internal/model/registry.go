@@ -55,6 +55,22 @@ var Registry = map[string]ResourceMeta{
 		DAO:      new(dao.ScreenDump),
 		Renderer: new(render.ScreenDump),
 	},
+	client.MwGVR.String(): {
+		DAO:      new(dao.Maintenance),
+		Renderer: new(render.Maintenance),
+	},
+	client.NplGVR.String(): {
+		DAO:      new(dao.NodePool),
+		Renderer: new(render.NodePool),
+	},
+	client.QuoGVR.String(): {
+		DAO:      new(dao.ResourceQuota),
+		Renderer: new(render.QuotaUsage),
+	},
+	client.SrcGVR.String(): {
+		DAO:      new(dao.Search),
+		Renderer: new(render.Search),
+	},
 	client.RbacGVR.String(): {
 		DAO:      new(dao.Rbac),
 		Renderer: new(render.Rbac),
@@ -118,9 +134,11 @@ var Registry = map[string]ResourceMeta{
 		Renderer: new(render.ServiceAccount),
 	},
 	client.PvGVR.String(): {
+		DAO:      new(dao.PersistentVolume),
 		Renderer: new(render.PersistentVolume),
 	},
 	client.PvcGVR.String(): {
+		DAO:      new(dao.PersistentVolumeClaim),
 		Renderer: new(render.PersistentVolumeClaim),
 	},
 
@@ -147,8 +165,13 @@ var Registry = map[string]ResourceMeta{
 
 	// Extensions...
 	client.NpGVR.String(): {
+		DAO:      new(dao.NetworkPolicy),
 		Renderer: &render.NetworkPolicy{},
 	},
+	client.IngGVR.String(): {
+		DAO:      new(dao.Ingress),
+		Renderer: new(render.Ingress),
+	},
 
 	// Batch...
 	client.CjGVR.String(): {
@@ -191,3 +214,11 @@ var Registry = map[string]ResourceMeta{
 		Renderer: new(render.RoleBinding),
 	},
 }
+
+// UseAccessibleRenderers swaps resource renderers for their screen reader
+// friendly counterparts.
+func UseAccessibleRenderers() {
+	meta := Registry[client.NodeGVR.String()]
+	meta.Renderer = new(render.AccessibleNodeRenderer)
+	Registry[client.NodeGVR.String()] = meta
+}
@@ -32,6 +32,7 @@ type YAML struct {
 	lines     []string
 	listeners []ResourceViewerListener
 	options   ViewerToggleOpts
+	decode    bool
 }
 
 // NewYAML return a new yaml resource model.
@@ -174,6 +175,11 @@ func (y *YAML) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// Toggle toggles the decode flag.
+func (y *YAML) Toggle() {
+	y.decode = !y.decode
+}
+
 // AddListener adds a new model listener.
 func (y *YAML) AddListener(l ResourceViewerListener) {
 	y.listeners = append(y.listeners, l)
@@ -195,7 +201,7 @@ func (y *YAML) RemoveListener(l ResourceViewerListener) {
 }
 
 // ToYAML returns a resource yaml.
-func (*YAML) ToYAML(ctx context.Context, gvr *client.GVR, path string, showManaged bool) (string, error) {
+func (y *YAML) ToYAML(ctx context.Context, gvr *client.GVR, path string, showManaged bool) (string, error) {
 	meta, err := getMeta(ctx, gvr)
 	if err != nil {
 		return "", err
@@ -205,6 +211,9 @@ func (*YAML) ToYAML(ctx context.Context, gvr *client.GVR, path string, showManag
 	if !ok {
 		return "", fmt.Errorf("no describer for %q", meta.DAO.GVR())
 	}
+	if desc, ok := meta.DAO.(*dao.Secret); ok {
+		desc.SetDecodeData(y.decode)
+	}
 
 	return desc.ToYAML(path, showManaged)
 }
@@ -13,6 +13,26 @@ import (
 
 const ageCol = "AGE"
 
+// ColGroup identifies a set of columns only shown when their data is available.
+type ColGroup string
+
+const (
+	// NetMXGroup tracks the network metrics columns.
+	NetMXGroup ColGroup = "netmx"
+
+	// PromMXGroup tracks the Prometheus metrics columns.
+	PromMXGroup ColGroup = "prommx"
+
+	// GPUGroup tracks the GPU columns.
+	GPUGroup ColGroup = "gpu"
+
+	// CertGroup tracks the kubelet certificate expiry columns.
+	CertGroup ColGroup = "cert"
+
+	// CVEGroup tracks the kernel CVE columns.
+	CVEGroup ColGroup = "cve"
+)
+
 type Attrs struct {
 	Align     int
 	Decorator DecoratorFunc
@@ -24,6 +44,8 @@ type Attrs struct {
 	Capacity  bool
 	VS        bool
 	Hide      bool
+	Group     bool
+	ColGroup  ColGroup
 }
 
 func (a Attrs) Merge(b Attrs) Attrs {
@@ -32,6 +54,7 @@ func (a Attrs) Merge(b Attrs) Attrs {
 	a.MXM = b.MXM
 	a.Decorator = b.Decorator
 	a.VS = b.VS
+	a.ColGroup = b.ColGroup
 
 	if a.Align == 0 {
 		a.Align = b.Align
@@ -50,6 +73,9 @@ func (a Attrs) Merge(b Attrs) Attrs {
 	if !a.Capacity {
 		a.Capacity = b.Capacity
 	}
+	if !a.Group {
+		a.Group = b.Group
+	}
 
 	return a
 }
@@ -10,6 +10,7 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/fvbommel/sortorder"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -105,6 +106,15 @@ func labelize(labels string) map[string]string {
 	return data
 }
 
+// AgeToDuration converts a human readable age ie 2y10d to a duration.
+func AgeToDuration(age string) (time.Duration, bool) {
+	if age == "" || strings.Trim(age, "0123456789ydhms") != "" {
+		return 0, false
+	}
+
+	return time.Duration(durationToSeconds(age)) * time.Second, true
+}
+
 func durationToSeconds(duration string) int64 {
 	if duration == "" {
 		return 0
@@ -185,8 +195,15 @@ func lessCapacity(s1, s2 string) bool {
 	return c1 <= c2
 }
 
+// lessNumber compares numerical values. Values rendered as resource quantities
+// ie 0.25 or 1.5Gi are compared on their numerical value.
 func lessNumber(s1, s2 string) bool {
 	v1, v2 := strings.ReplaceAll(s1, ",", ""), strings.ReplaceAll(s2, ",", "")
+	q1, err1 := resource.ParseQuantity(v1)
+	q2, err2 := resource.ParseQuantity(v2)
+	if err1 == nil && err2 == nil {
+		return q1.Cmp(q2) <= 0
+	}
 
 	return sortorder.NaturalLess(v1, v2)
 }
@@ -6,6 +6,7 @@ package model1
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -125,6 +126,30 @@ func TestDurationToSecond(t *testing.T) {
 	}
 }
 
+func TestAgeToDuration(t *testing.T) {
+	uu := map[string]struct {
+		s  string
+		e  time.Duration
+		ok bool
+	}{
+		"blank":    {},
+		"n/a":      {s: NAValue},
+		"unknown":  {s: "<unknown>"},
+		"days":     {s: "45d", e: 45 * 24 * time.Hour, ok: true},
+		"year_day": {s: "1y2d", e: 367 * 24 * time.Hour, ok: true},
+		"minutes":  {s: "5m10s", e: 5*time.Minute + 10*time.Second, ok: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, ok := AgeToDuration(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
 func BenchmarkDurationToSecond(b *testing.B) {
 	t := "2d22h3m50s"
 
@@ -134,3 +159,24 @@ func BenchmarkDurationToSecond(b *testing.B) {
 		durationToSeconds(t)
 	}
 }
+
+func TestLessNumber(t *testing.T) {
+	uu := map[string]struct {
+		v1, v2 string
+		e      bool
+	}{
+		"ints":      {v1: "9", v2: "10", e: true},
+		"cores":     {v1: "1.5", v2: "1.25", e: false},
+		"binary":    {v1: "512Mi", v2: "1.5Gi", e: true},
+		"metric":    {v1: "2G", v2: "900M", e: false},
+		"thousands": {v1: "1,200", v2: "900", e: false},
+		"na":        {v1: "n/a", v2: "10", e: false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, Less(true, false, false, "a", "b", u.v1, u.v2))
+		})
+	}
+}
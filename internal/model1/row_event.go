@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"sort"
+
+	"github.com/fvbommel/sortorder"
 )
 
 type ReRangeFn func(int, RowEvent) bool
@@ -273,6 +275,18 @@ func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity,
 	r.reindex()
 }
 
+// SortBy sorts rows by multiple columns, applied left to right.
+func (r *RowEvents) SortBy(kk []SortKey) {
+	if len(kk) == 0 || r == nil {
+		return
+	}
+
+	sort.SliceStable(r.events, func(i, j int) bool {
+		return lessBy(kk, &r.events[i].Row, &r.events[j].Row)
+	})
+	r.reindex()
+}
+
 // For debugging...
 func (re RowEvents) Dump(msg string) {
 	slog.Debug("[DEBUG] RowEvents" + msg)
@@ -283,6 +297,31 @@ func (re RowEvents) Dump(msg string) {
 
 // ----------------------------------------------------------------------------
 
+// SortKey represents a column sort key.
+type SortKey struct {
+	Index      int
+	IsNumber   bool
+	IsDuration bool
+	IsCapacity bool
+	Asc        bool
+}
+
+// lessBy compares two rows using the first sort key with differing values.
+// Rows with identical keys are ordered by id.
+func lessBy(kk []SortKey, r1, r2 *Row) bool {
+	for _, k := range kk {
+		v1, v2 := r1.Fields[k.Index], r2.Fields[k.Index]
+		if v1 == v2 {
+			continue
+		}
+		return Less(k.IsNumber, k.IsDuration, k.IsCapacity, r1.ID, r2.ID, v1, v2) == k.Asc
+	}
+
+	return sortorder.NaturalLess(r1.ID, r2.ID)
+}
+
+// ----------------------------------------------------------------------------
+
 // RowEventSorter sorts row events by a given colon.
 type RowEventSorter struct {
 	Events     *RowEvents
@@ -42,6 +42,9 @@ type FilterOpts struct {
 	Toast  bool
 	Filter string
 	Invert bool
+
+	// NameMatcher filters rows by resource name in lieu of the filter when set.
+	NameMatcher func(name string) bool
 }
 
 // TableData tracks a K8s resource for tabular display.
@@ -119,6 +122,26 @@ func (t *TableData) Sort(sc SortColumn) {
 	)
 }
 
+// SortByColumns sorts rows by multiple columns, applied left to right.
+// Unknown columns are ignored.
+func (t *TableData) SortByColumns(cc []SortColumn) {
+	kk := make([]SortKey, 0, len(cc))
+	for _, sc := range cc {
+		col, idx := t.HeadCol(sc.Name, false)
+		if idx < 0 {
+			continue
+		}
+		kk = append(kk, SortKey{
+			Index:      idx,
+			IsNumber:   col.MX,
+			IsDuration: col.Time,
+			IsCapacity: col.Capacity,
+			Asc:        sc.ASC,
+		})
+	}
+	t.rowEvents.SortBy(kk)
+}
+
 func (t *TableData) Header() Header {
 	return t.header
 }
@@ -146,6 +169,10 @@ func (t *TableData) Filter(f FilterOpts) *TableData {
 	if f.Toast {
 		td.rowEvents = t.filterToast()
 	}
+	if f.NameMatcher != nil {
+		td.rowEvents = td.nameFilter(f.NameMatcher)
+		return td
+	}
 	if f.Filter == "" || internal.IsLabelSelector(f.Filter) {
 		return td
 	}
@@ -218,6 +245,25 @@ func (t *TableData) fuzzyFilter(q string) *RowEvents {
 	return rr
 }
 
+// nameFilter returns the rows with a matching name column or id if no name column is present.
+func (t *TableData) nameFilter(match func(string) bool) *RowEvents {
+	idx, ok := t.header.IndexOf("NAME", true)
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		name := re.Row.ID
+		if ok && idx < len(re.Row.Fields) {
+			name = re.Row.Fields[idx]
+		}
+		if match(name) {
+			rr.Add(re)
+		}
+
+		return true
+	})
+
+	return rr
+}
+
 func (t *TableData) filterToast() *RowEvents {
 	rr := NewRowEvents(10)
 	idx, ok := t.header.IndexOf("VALID", true)
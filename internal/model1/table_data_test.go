@@ -5,6 +5,7 @@ package model1
 
 import (
 	"log/slog"
+	"strings"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -369,3 +370,107 @@ func TestTableDataDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTableDataSortByColumns(t *testing.T) {
+	uu := map[string]struct {
+		cc []SortColumn
+		e  []string
+	}{
+		"none": {
+			e: []string{"A", "B", "C", "D"},
+		},
+		"single": {
+			cc: []SortColumn{{Name: "CPU", ASC: true}},
+			e:  []string{"B", "D", "A", "C"},
+		},
+		"secondary": {
+			cc: []SortColumn{{Name: "ROLE", ASC: true}, {Name: "CPU", ASC: false}},
+			e:  []string{"C", "A", "B", "D"},
+		},
+		"secondary-asc": {
+			cc: []SortColumn{{Name: "ROLE", ASC: false}, {Name: "CPU", ASC: true}},
+			e:  []string{"D", "B", "A", "C"},
+		},
+		"unknown": {
+			cc: []SortColumn{{Name: "FRED"}, {Name: "CPU", ASC: false}},
+			e:  []string{"C", "A", "B", "D"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("v1/nodes"),
+				Header{
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "ROLE"},
+					HeaderColumn{Name: "CPU", Attrs: Attrs{MX: true}},
+				},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "A", Fields: Fields{"a", "master", "100"}}},
+					RowEvent{Row: Row{ID: "B", Fields: Fields{"b", "master", "20"}}},
+					RowEvent{Row: Row{ID: "C", Fields: Fields{"c", "master", "300"}}},
+					RowEvent{Row: Row{ID: "D", Fields: Fields{"d", "worker", "20"}}},
+				),
+			)
+			td.SortByColumns(u.cc)
+
+			ids := make([]string, 0, td.RowCount())
+			td.RowsRange(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestTableDataFilterNameMatcher(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAMESPACE"},
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "default/nginx", Fields: Fields{"default", "nginx", "Running"}}},
+			RowEvent{Row: Row{ID: "nginx/redis", Fields: Fields{"nginx", "redis", "Running"}}},
+		),
+	)
+	f := td.Filter(FilterOpts{
+		Filter: "nginx",
+		NameMatcher: func(n string) bool {
+			return n == "nginx"
+		},
+	})
+
+	assert.Equal(t, 1, f.RowCount())
+	_, ok := f.FindRow("default/nginx")
+	assert.True(t, ok)
+}
+
+func TestTableDataFilterNameMatcherToast(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("v1/pods"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "VALID"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "default/nginx", Fields: Fields{"nginx", ""}}},
+			RowEvent{Row: Row{ID: "default/nginx-bad", Fields: Fields{"nginx-bad", "toast"}}},
+		),
+	)
+	f := td.Filter(FilterOpts{
+		Toast: true,
+		NameMatcher: func(n string) bool {
+			return strings.HasPrefix(n, "nginx")
+		},
+	})
+
+	assert.Equal(t, 1, f.RowCount())
+	_, ok := f.FindRow("default/nginx-bad")
+	assert.True(t, ok)
+}
@@ -4,8 +4,10 @@
 package render
 
 import (
+	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
@@ -73,3 +75,12 @@ func (ConfigMap) defaultRow(o any, r *model1.Row) error {
 
 	return nil
 }
+
+// HexDump renders binary data as a hex dump.
+func HexDump(bb []byte) string {
+	if len(bb) == 0 {
+		return "<empty>"
+	}
+
+	return strings.TrimSuffix(hex.Dump(bb), "\n")
+}
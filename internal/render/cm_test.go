@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHexDump(t *testing.T) {
+	assert.Equal(t, "<empty>", render.HexDump(nil))
+	assert.Equal(t, "00000000  6b 39 73                                          |k9s|", render.HexDump([]byte("k9s")))
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+const (
+	// maxMissedRuns caps the number of missed runs reported for a cronjob.
+	maxMissedRuns = 100
+
+	// missedRunGrace tracks how late a run may start before it is deemed missed.
+	missedRunGrace = time.Minute
+)
+
+// ParseCronSchedule parses a cronjob schedule in the given location, like the cronjob
+// controller does. A CRON_TZ or TZ prefix overrides the location.
+func ParseCronSchedule(spec string, loc *time.Location) (cron.Schedule, error) {
+	s, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+	spec = strings.TrimSpace(spec)
+	if ss, ok := s.(*cron.SpecSchedule); ok && loc != nil && !strings.HasPrefix(spec, "CRON_TZ=") && !strings.HasPrefix(spec, "TZ=") {
+		ss.Location = loc
+	}
+
+	return s, nil
+}
+
+// MissedCronRuns returns the scheduled runs of a cronjob that did not happen since
+// it was last scheduled. Schedules are evaluated in the cronjob time zone or UTC, the
+// controller manager default. Suspended cronjobs never miss a run, neither do runs
+// covered by an active job or skipped on purpose by the forbid concurrency policy.
+func MissedCronRuns(cj *batchv1.CronJob, now time.Time) ([]time.Time, error) {
+	if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+		return nil, nil
+	}
+	if cj.Spec.ConcurrencyPolicy == batchv1.ForbidConcurrent && len(cj.Status.Active) > 0 {
+		return nil, nil
+	}
+	loc := time.UTC
+	if tz := cj.Spec.TimeZone; tz != nil && *tz != "" {
+		l, err := time.LoadLocation(*tz)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cronjob time zone %q: %w", *tz, err)
+		}
+		loc = l
+	}
+	s, err := ParseCronSchedule(cj.Spec.Schedule, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	start := cj.CreationTimestamp.Time
+	if cj.Status.LastScheduleTime != nil {
+		start = cj.Status.LastScheduleTime.Time
+	}
+	grace := missedRunGrace
+	if d := cj.Spec.StartingDeadlineSeconds; d != nil {
+		grace = max(grace, time.Duration(*d)*time.Second)
+	}
+	active := activeRuns(cj)
+
+	var tt []time.Time
+	for t := s.Next(start); !t.IsZero() && t.Add(grace).Before(now); t = s.Next(t) {
+		if len(tt) == maxMissedRuns {
+			break
+		}
+		if _, ok := active[t.Unix()]; !ok {
+			tt = append(tt, t)
+		}
+	}
+
+	return tt, nil
+}
+
+// activeRuns returns the scheduled times of a cronjob active jobs. The controller
+// names jobs after the cronjob and their scheduled time in minutes.
+func activeRuns(cj *batchv1.CronJob) map[int64]struct{} {
+	mm := make(map[int64]struct{}, len(cj.Status.Active))
+	for _, ref := range cj.Status.Active {
+		suffix, ok := strings.CutPrefix(ref.Name, cj.Name+"-")
+		if !ok {
+			continue
+		}
+		if mins, err := strconv.ParseInt(suffix, 10, 64); err == nil {
+			mm[mins*60] = struct{}{}
+		}
+	}
+
+	return mm
+}
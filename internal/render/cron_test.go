@@ -0,0 +1,139 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	from := time.Date(2025, 1, 1, 10, 7, 30, 0, time.UTC) // Wednesday
+	uu := map[string]struct {
+		spec string
+		loc  *time.Location
+		e    time.Time
+	}{
+		"every-minute": {
+			spec: "* * * * *",
+			e:    time.Date(2025, 1, 1, 10, 8, 0, 0, time.UTC),
+		},
+		"hourly": {
+			spec: "@hourly",
+			e:    time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+		},
+		"weekday-names": {
+			spec: "30 9 * * mon-fri",
+			e:    time.Date(2025, 1, 2, 9, 30, 0, 0, time.UTC),
+		},
+		"location": {
+			spec: "0 16 * * *",
+			loc:  time.FixedZone("IST", 5*3600+1800),
+			e:    time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC),
+		},
+		"tz": {
+			spec: "CRON_TZ=Asia/Kolkata 0 16 * * *",
+			e:    time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC),
+		},
+		"never": {
+			spec: "0 0 31 2 *",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			loc := u.loc
+			if loc == nil {
+				loc = time.UTC
+			}
+			s, err := render.ParseCronSchedule(u.spec, loc)
+			require.NoError(t, err)
+			assert.True(t, u.e.Equal(s.Next(from)), s.Next(from))
+		})
+	}
+}
+
+func TestCronScheduleParseErrors(t *testing.T) {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "TZ=Bozo/Blee * * * * *"} {
+		_, err := render.ParseCronSchedule(spec, time.UTC)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestMissedCronRuns(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC)
+	uu := map[string]struct {
+		cj batchv1.CronJob
+		e  int
+	}{
+		"on-time": {
+			cj: makeCronJob("*/5 * * * *", now.Add(-2*time.Minute), nil),
+		},
+		"missed": {
+			cj: makeCronJob("* * * * *", now.Add(-10*time.Minute), nil),
+			e:  8,
+		},
+		"suspended": {
+			cj: makeCronJob("* * * * *", now.Add(-10*time.Minute), ptr.To(true)),
+		},
+		"capped": {
+			cj: makeCronJob("* * * * *", now.Add(-24*time.Hour), nil),
+			e:  100,
+		},
+		"active": {
+			cj: withActive(makeCronJob("* * * * *", now.Add(-10*time.Minute), nil), batchv1.AllowConcurrent, now.Add(-9*time.Minute)),
+			e:  7,
+		},
+		"forbid-active": {
+			cj: withActive(makeCronJob("* * * * *", now.Add(-10*time.Minute), nil), batchv1.ForbidConcurrent, now.Add(-9*time.Minute)),
+		},
+		"utc-default": {
+			cj: withTimeZone(makeCronJob("0 10 * * *", now.Add(-2*time.Hour), nil), nil),
+			e:  1,
+		},
+		"time-zone": {
+			cj: withTimeZone(makeCronJob("0 10 * * *", now.Add(-2*time.Hour), nil), ptr.To("America/New_York")),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt, err := render.MissedCronRuns(&u.cj, now)
+			require.NoError(t, err)
+			assert.Len(t, tt, u.e)
+		})
+	}
+}
+
+func makeCronJob(spec string, last time.Time, suspend *bool) batchv1.CronJob {
+	return batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "cj", CreationTimestamp: metav1.NewTime(last.Add(-time.Hour))},
+		Spec:       batchv1.CronJobSpec{Schedule: spec, Suspend: suspend, TimeZone: ptr.To("UTC")},
+		Status:     batchv1.CronJobStatus{LastScheduleTime: &metav1.Time{Time: last}},
+	}
+}
+
+func withActive(cj batchv1.CronJob, policy batchv1.ConcurrencyPolicy, scheduled time.Time) batchv1.CronJob {
+	cj.Spec.ConcurrencyPolicy = policy
+	cj.Status.Active = []v1.ObjectReference{{Name: fmt.Sprintf("%s-%d", cj.Name, scheduled.Unix()/60)}}
+
+	return cj
+}
+
+func withTimeZone(cj batchv1.CronJob, tz *string) batchv1.CronJob {
+	cj.Spec.TimeZone = tz
+
+	return cj
+}
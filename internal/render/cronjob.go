@@ -7,9 +7,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -24,6 +26,7 @@ var defaultCJHeader = model1.Header{
 	model1.HeaderColumn{Name: "SUSPEND"},
 	model1.HeaderColumn{Name: "ACTIVE"},
 	model1.HeaderColumn{Name: "LAST_SCHEDULE", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "MISSED", Attrs: model1.Attrs{Align: tview.AlignRight}},
 	model1.HeaderColumn{Name: "SELECTOR", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "CONTAINERS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "IMAGES", Attrs: model1.Attrs{Wide: true}},
@@ -86,6 +89,7 @@ func (CronJob) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
 		lastScheduled,
+		missedRuns(&cj),
 		jobSelector(&cj.Spec.JobTemplate.Spec),
 		podContainerNames(&cj.Spec.JobTemplate.Spec.Template.Spec, true),
 		podImageNames(&cj.Spec.JobTemplate.Spec.Template.Spec, true),
@@ -99,6 +103,16 @@ func (CronJob) defaultRow(raw *unstructured.Unstructured, r *model1.Row) error {
 
 // Helpers
 
+// missedRuns returns the number of runs a cronjob missed since it was last scheduled.
+func missedRuns(cj *batchv1.CronJob) string {
+	tt, err := MissedCronRuns(cj, time.Now())
+	if err != nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(len(tt))
+}
+
 func jobSelector(spec *batchv1.JobSpec) string {
 	if spec.Selector == nil {
 		return MissingValue
@@ -11,7 +11,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/vul"
 	"github.com/derailed/tview"
@@ -20,7 +19,6 @@ import (
 	"golang.org/x/text/message"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // ExtractImages returns a collection of container images.
@@ -189,7 +187,7 @@ func ToAge(t metav1.Time) string {
 		return UnknownValue
 	}
 
-	return duration.HumanDuration(time.Since(t.Time))
+	return Units().Age(time.Since(t.Time))
 }
 
 func toAgeHuman(s string) string {
@@ -202,7 +200,7 @@ func toAgeHuman(s string) string {
 		return NAValue
 	}
 
-	return duration.HumanDuration(time.Since(t))
+	return Units().Age(time.Since(t))
 }
 
 // Truncate a string to the given l and suffix ellipsis if needed.
@@ -266,17 +264,26 @@ func mapToIfc(m any) (s string) {
 }
 
 func toMc(v int64) string {
-	if v == 0 {
-		return ZeroValue
-	}
-	return strconv.Itoa(int(v))
+	return Units().CPU(v)
 }
 
 func toMi(v int64) string {
-	if v == 0 {
-		return ZeroValue
+	return Units().Memory(v)
+}
+
+// ToHumanBytes renders a byte count using binary units.
+func ToHumanBytes(v uint64) string {
+	const unit = 1024
+	if v < unit {
+		return strconv.FormatUint(v, 10) + "B"
 	}
-	return strconv.Itoa(int(client.ToMB(v)))
+	div, exp := uint64(unit), 0
+	for n := v / unit; n >= unit && exp < 5; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return strconv.FormatFloat(float64(v)/float64(div), 'f', 1, 64) + string("KMGTPE"[exp]) + "i"
 }
 
 func boolPtrToStr(b *bool) string {
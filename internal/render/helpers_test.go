@@ -56,7 +56,7 @@ func TestTableHydrate(t *testing.T) {
 	re := NewPod()
 	require.NoError(t, model1.Hydrate("blee", oo, rr, re))
 	assert.Len(t, rr, 1)
-	assert.Len(t, rr[0].Fields, 25)
+	assert.Len(t, rr[0].Fields, 26)
 }
 
 func TestToAge(t *testing.T) {
@@ -407,6 +407,24 @@ func TestToMi(t *testing.T) {
 	}
 }
 
+func TestToHumanBytes(t *testing.T) {
+	uu := []struct {
+		v uint64
+		e string
+	}{
+		{0, "0B"},
+		{1_023, "1023B"},
+		{1_024, "1.0Ki"},
+		{1_536, "1.5Ki"},
+		{5 * 1024 * 1024, "5.0Mi"},
+		{3 * 1024 * 1024 * 1024 * 1024, "3.0Ti"},
+	}
+
+	for _, u := range uu {
+		assert.Equal(t, u.e, ToHumanBytes(u.v))
+	}
+}
+
 func TestIntToStr(t *testing.T) {
 	uu := []struct {
 		v int
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// CertCritDays tracks the certificate days remaining below which a certificate is flagged red.
+	CertCritDays = 14
+
+	// CertWarnDays tracks the certificate days remaining below which a certificate is flagged yellow.
+	CertWarnDays = 30
+
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+)
+
+var defaultINGHeader = model1.Header{
+	model1.HeaderColumn{Name: "NAMESPACE"},
+	model1.HeaderColumn{Name: "NAME"},
+	model1.HeaderColumn{Name: "CLASS"},
+	model1.HeaderColumn{Name: "HOSTS"},
+	model1.HeaderColumn{Name: "ADDRESS"},
+	model1.HeaderColumn{Name: "PORTS"},
+	model1.HeaderColumn{Name: "CERT-DAYS", Attrs: model1.Attrs{Align: tview.AlignRight, Decorator: certDaysDecorator}},
+	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+}
+
+// Ingress renders a K8s Ingress to screen.
+type Ingress struct {
+	Base
+}
+
+// Header returns a header row.
+func (i Ingress) Header(_ string) model1.Header {
+	return i.doHeader(defaultINGHeader)
+}
+
+// Render renders a K8s resource to screen.
+func (i Ingress) Render(o any, _ string, row *model1.Row) error {
+	iwc, ok := o.(*IngressWithCerts)
+	if !ok {
+		raw, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expected IngressWithCerts, but got %T", o)
+		}
+		iwc = &IngressWithCerts{Raw: raw}
+	}
+	if err := i.defaultRow(iwc, row); err != nil {
+		return err
+	}
+	if i.specs.isEmpty() {
+		return nil
+	}
+
+	cols, err := i.specs.realize(iwc.Raw, defaultINGHeader, row)
+	if err != nil {
+		return err
+	}
+	cols.hydrateRow(row)
+
+	return nil
+}
+
+func (i Ingress) defaultRow(iwc *IngressWithCerts, r *model1.Row) error {
+	var ing netv1.Ingress
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(iwc.Raw.Object, &ing); err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(&ing.ObjectMeta)
+	r.Fields = model1.Fields{
+		ing.Namespace,
+		ing.Name,
+		ingressClass(&ing),
+		ingressHosts(&ing),
+		ingressAddress(&ing),
+		ingressPorts(&ing),
+		iwc.certDays(),
+		mapToStr(ing.Labels),
+		AsStatus(i.diagnose(iwc)),
+		ToAge(ing.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Ingress) diagnose(iwc *IngressWithCerts) error {
+	if iwc.CertDays != nil && *iwc.CertDays < 0 {
+		return fmt.Errorf("tls certificate expired %d day(s) ago", -*iwc.CertDays)
+	}
+
+	return nil
+}
+
+// IngressWithCerts represents an ingress along with its earliest TLS certificate expiry.
+type IngressWithCerts struct {
+	Raw *unstructured.Unstructured
+
+	// CertDays tracks the days remaining before the earliest TLS certificate expires.
+	CertDays *int
+}
+
+// GetObjectKind returns a schema object.
+func (*IngressWithCerts) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i *IngressWithCerts) DeepCopyObject() runtime.Object {
+	return i
+}
+
+func (i *IngressWithCerts) certDays() string {
+	if i.CertDays == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(*i.CertDays)
+}
+
+// certDaysDecorator colors certificates about to expire.
+func certDaysDecorator(s string) string {
+	d, err := strconv.Atoi(s)
+	switch {
+	case err != nil:
+		return s
+	case d < CertCritDays:
+		return "[red::b]" + s
+	case d < CertWarnDays:
+		return "[yellow::b]" + s
+	default:
+		return s
+	}
+}
+
+func ingressClass(ing *netv1.Ingress) string {
+	if c := ing.Spec.IngressClassName; c != nil {
+		return *c
+	}
+	if c, ok := ing.Annotations[ingressClassAnnotation]; ok {
+		return c
+	}
+
+	return MissingValue
+}
+
+func ingressHosts(ing *netv1.Ingress) string {
+	hh := make([]string, 0, len(ing.Spec.Rules))
+	for _, r := range ing.Spec.Rules {
+		if r.Host != "" {
+			hh = append(hh, r.Host)
+		}
+	}
+	if len(hh) == 0 {
+		return "*"
+	}
+
+	return strings.Join(hh, ",")
+}
+
+func ingressAddress(ing *netv1.Ingress) string {
+	aa := make([]string, 0, len(ing.Status.LoadBalancer.Ingress))
+	for _, lb := range ing.Status.LoadBalancer.Ingress {
+		switch {
+		case lb.IP != "":
+			aa = append(aa, lb.IP)
+		case lb.Hostname != "":
+			aa = append(aa, lb.Hostname)
+		}
+	}
+
+	return strings.Join(aa, ",")
+}
+
+func ingressPorts(ing *netv1.Ingress) string {
+	if len(ing.Spec.TLS) > 0 {
+		return "80, 443"
+	}
+
+	return "80"
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestIngressRender(t *testing.T) {
+	uu := map[string]struct {
+		o     any
+		days  string
+		valid string
+	}{
+		"raw": {
+			o:    load(t, "ing"),
+			days: "n/a",
+		},
+		"certs": {
+			o:    &render.IngressWithCerts{Raw: load(t, "ing"), CertDays: ptr.To(20)},
+			days: "20",
+		},
+		"expired": {
+			o:     &render.IngressWithCerts{Raw: load(t, "ing"), CertDays: ptr.To(-2)},
+			days:  "-2",
+			valid: "tls certificate expired 2 day(s) ago",
+		},
+	}
+
+	var re render.Ingress
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, re.Render(u.o, "", &r))
+			assert.Equal(t, "default/test-ingress", r.ID)
+			assert.Equal(t, model1.Fields{"default", "test-ingress", "<none>", "*", "", "80", u.days}, r.Fields[:7])
+			assert.Equal(t, u.valid, r.Fields[8])
+		})
+	}
+}
+
+func TestIngressCertDaysDecorator(t *testing.T) {
+	var re render.Ingress
+	h := re.Header("")
+	idx, ok := h.IndexOf("CERT-DAYS", true)
+	require.True(t, ok)
+	dec := h[idx].Decorator
+	require.NotNil(t, dec)
+
+	uu := map[string]struct {
+		days, e string
+	}{
+		"na":      {days: "n/a", e: "n/a"},
+		"expired": {days: "-1", e: "[red::b]-1"},
+		"crit":    {days: "13", e: "[red::b]13"},
+		"warn":    {days: "14", e: "[yellow::b]14"},
+		"ok":      {days: "30", e: "30"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dec(u.days))
+		})
+	}
+}
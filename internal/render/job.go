@@ -14,7 +14,6 @@ import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 var defaultJOBHeader = model1.Header{
@@ -149,5 +148,5 @@ func toDuration(status *batchv1.JobStatus) string {
 		return MissingValue
 	}
 
-	return duration.HumanDuration(status.CompletionTime.Sub(status.StartTime.Time))
+	return Units().Age(status.CompletionTime.Sub(status.StartTime.Time))
 }
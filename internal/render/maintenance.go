@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Maintenance renders scheduled node maintenance windows to screen.
+type Maintenance struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Maintenance) ColorerFunc() model1.ColorerFunc {
+	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return tcell.ColorPaleGreen
+	}
+}
+
+// Header returns a header row.
+func (Maintenance) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STAGE"},
+		model1.HeaderColumn{Name: "SCHEDULED"},
+		model1.HeaderColumn{Name: "DUE"},
+	}
+}
+
+// Render renders a maintenance window to screen.
+func (Maintenance) Render(o any, _ string, r *model1.Row) error {
+	m, ok := o.(MaintenanceRes)
+	if !ok {
+		return fmt.Errorf("expecting maintenance window, but got %T", o)
+	}
+
+	r.ID = m.Node
+	r.Fields = model1.Fields{
+		m.Node,
+		m.Stage,
+		m.At.Format(time.DateTime),
+		dueIn(m.At),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func dueIn(at time.Time) string {
+	d := time.Until(at)
+	if d <= 0 {
+		return "now"
+	}
+
+	return Units().Age(d)
+}
+
+// MaintenanceRes represents a scheduled node maintenance window.
+type MaintenanceRes struct {
+	Node  string
+	At    time.Time
+	Stage string
+}
+
+// GetObjectKind returns a schema object.
+func (MaintenanceRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a maintenance window copy.
+func (m MaintenanceRes) DeepCopyObject() runtime.Object {
+	return m
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.MaintenanceRes
+		e model1.Fields
+	}{
+		"pending": {
+			o: render.MaintenanceRes{
+				Node:  "n1",
+				At:    time.Date(2050, 1, 2, 3, 4, 5, 0, time.Local),
+				Stage: "Pending",
+			},
+			e: model1.Fields{"n1", "Pending", "2050-01-02 03:04:05"},
+		},
+		"overdue": {
+			o: render.MaintenanceRes{
+				Node:  "n2",
+				At:    time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local),
+				Stage: "Draining",
+			},
+			e: model1.Fields{"n2", "Draining", "2020-01-02 03:04:05", "now"},
+		},
+	}
+
+	var m render.Maintenance
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, m.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Node, r.ID)
+			assert.Equal(t, u.e, r.Fields[:len(u.e)])
+		})
+	}
+}
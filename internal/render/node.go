@@ -4,8 +4,10 @@
 package render
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +16,7 @@ import (
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -23,29 +26,66 @@ import (
 const (
 	labelNodeRolePrefix = "node-role.kubernetes.io/"
 	labelNodeRoleSuffix = "kubernetes.io/role"
+	taintsLen           = 40
+	barWidth            = 10
 )
 
+// nodeLiveCols tracks the pods derived node columns.
+var nodeLiveCols = map[string]struct{}{
+	"PODS":    {},
+	"RUNNING": {},
+	"PENDING": {},
+	"FAILED":  {},
+	"%CPU/R":  {},
+	"%MEM/R":  {},
+	"GPU/U":   {},
+}
+
+var pressureConditions = []struct {
+	kind  v1.NodeConditionType
+	label string
+}{
+	{kind: v1.NodeMemoryPressure, label: "M"},
+	{kind: v1.NodeDiskPressure, label: "D"},
+	{kind: v1.NodePIDPressure, label: "P"},
+}
+
 var defaultNOHeader = model1.Header{
 	model1.HeaderColumn{Name: "NAME"},
 	model1.HeaderColumn{Name: "STATUS"},
+	model1.HeaderColumn{Name: "CONDITIONS"},
 	model1.HeaderColumn{Name: "ROLE"},
-	model1.HeaderColumn{Name: "ARCH", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "ARCH"},
 	model1.HeaderColumn{Name: "TAINTS"},
 	model1.HeaderColumn{Name: "VERSION"},
-	model1.HeaderColumn{Name: "OS-IMAGE", Attrs: model1.Attrs{Wide: true}},
+	model1.HeaderColumn{Name: "OS-IMAGE", Attrs: model1.Attrs{Wide: true, Group: true}},
 	model1.HeaderColumn{Name: "KERNEL", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "INTERNAL-IP", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "EXTERNAL-IP", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "PODS", Attrs: model1.Attrs{Align: tview.AlignRight}},
+	model1.HeaderColumn{Name: "RUNNING", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "PENDING", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
+	model1.HeaderColumn{Name: "FAILED", Attrs: model1.Attrs{Align: tview.AlignRight, Wide: true}},
 	model1.HeaderColumn{Name: "CPU", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%CPU", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%MEM", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "CPU/A", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "MEM/A", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "RX", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.NetMXGroup}},
+	model1.HeaderColumn{Name: "TX", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.NetMXGroup}},
+	model1.HeaderColumn{Name: "THROTTLE", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.PromMXGroup}},
+	model1.HeaderColumn{Name: "CVES", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.CVEGroup}},
+	model1.HeaderColumn{Name: "CERT-DAYS", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.CertGroup, Decorator: certDaysDecorator}},
+	model1.HeaderColumn{Name: "GPU", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.GPUGroup}},
+	model1.HeaderColumn{Name: "GPU/U", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.GPUGroup}},
+	model1.HeaderColumn{Name: "GPU-VENDOR", Attrs: model1.Attrs{Wide: true, ColGroup: model1.GPUGroup}},
+	model1.HeaderColumn{Name: "%CPU/R"},
+	model1.HeaderColumn{Name: "%MEM/R"},
 	model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
 	model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	model1.HeaderColumn{Name: "TAINT-FILTER", Attrs: model1.Attrs{Hide: true}},
 }
 
 // Node renders a K8s Node to screen.
@@ -54,7 +94,12 @@ type Node struct {
 }
 
 // Header returns a header row.
+// Custom views take precedence over the configured node columns.
 func (n Node) Header(_ string) model1.Header {
+	if l := currentNodeLayout(); n.specs.isEmpty() && !l.isEmpty() {
+		return l.header(defaultNOHeader)
+	}
+
 	return n.doHeader(defaultNOHeader)
 }
 
@@ -68,6 +113,9 @@ func (n Node) Render(o any, _ string, row *model1.Row) error {
 		return err
 	}
 	if n.specs.isEmpty() {
+		if l := currentNodeLayout(); !l.isEmpty() {
+			row.Fields = l.fields(row.Fields)
+		}
 		return nil
 	}
 
@@ -80,6 +128,20 @@ func (n Node) Render(o any, _ string, row *model1.Row) error {
 	return nil
 }
 
+// MergeLiveFields carries over the metrics and pods fields of a previously
+// rendered row. Node watch events do not carry these, so they are retained
+// until the next refresh cycle.
+func (Node) MergeLiveFields(h model1.Header, old, r *model1.Row) {
+	for i, c := range h {
+		if i >= len(old.Fields) || i >= len(r.Fields) {
+			break
+		}
+		if _, ok := nodeLiveCols[c.Name]; ok || c.MX || c.ColGroup == model1.NetMXGroup || c.ColGroup == model1.PromMXGroup {
+			r.Fields[i] = old.Fields[i]
+		}
+	}
+}
+
 // Render renders a K8s resource to screen.
 func (n Node) defaultRow(nwm *NodeWithMetrics, r *model1.Row) error {
 	var no v1.Node
@@ -103,28 +165,46 @@ func (n Node) defaultRow(nwm *NodeWithMetrics, r *model1.Row) error {
 	if pc := nwm.PodCount; pc == -1 {
 		podCount = NAValue
 	}
+	running, pending, failed := nwm.phaseCount(v1.PodRunning), nwm.phaseCount(v1.PodPending), nwm.phaseCount(v1.PodFailed)
+	gpu, gpuUsed, gpuVendor := nwm.gpus(&no)
+	taints := nodeTaints(no.Spec.Taints)
 	r.ID = client.FQN("", no.Name)
 	r.Fields = model1.Fields{
 		no.Name,
 		join(statuses, ","),
+		nodePressures(no.Status.Conditions),
 		join(roles, ","),
-		no.Status.NodeInfo.Architecture,
-		strconv.Itoa(len(no.Spec.Taints)),
+		NodeArch(&no),
+		Truncate(missing(taints), taintsLen),
 		no.Status.NodeInfo.KubeletVersion,
 		no.Status.NodeInfo.OSImage,
 		no.Status.NodeInfo.KernelVersion,
 		iIP,
 		eIP,
 		podCount,
+		running,
+		pending,
+		failed,
 		toMc(c.cpu),
 		toMi(c.mem),
 		client.ToPercentageStr(c.cpu, a.cpu),
 		client.ToPercentageStr(c.mem, a.mem),
 		toMc(a.cpu),
 		toMi(a.mem),
+		nwm.rx(),
+		nwm.tx(),
+		nwm.throttle(),
+		nwm.cves(),
+		nwm.certDays(),
+		gpu,
+		gpuUsed,
+		gpuVendor,
+		nwm.requestedBar(v1.ResourceCPU, a.cpu),
+		nwm.requestedBar(v1.ResourceMemory, a.mem),
 		mapToStr(no.Labels),
 		AsStatus(n.diagnose(statuses)),
 		ToAge(no.GetCreationTimestamp()),
+		taints,
 	}
 
 	return nil
@@ -160,9 +240,75 @@ func (Node) diagnose(ss []string) error {
 
 // NodeWithMetrics represents a node with its associated metrics.
 type NodeWithMetrics struct {
-	Raw      *unstructured.Unstructured
-	MX       *mv1beta1.NodeMetrics
-	PodCount int
+	Raw       *unstructured.Unstructured
+	MX        *mv1beta1.NodeMetrics
+	Net       *client.NodeNetworkMetrics
+	PodCount  int
+	PodPhases map[v1.PodPhase]int
+	Requested v1.ResourceList
+
+	// CPUThrottle tracks the ratio of throttled cpu periods reported by Prometheus.
+	CPUThrottle *float64
+
+	// CVECount tracks the number of known CVEs affecting the node kernel.
+	CVECount *int
+
+	// CertDays tracks the days remaining before the kubelet client certificate expires.
+	CertDays *int
+}
+
+func (n *NodeWithMetrics) rx() string {
+	if n.Net == nil {
+		return NAValue
+	}
+
+	return ToHumanBytes(n.Net.RxBytes)
+}
+
+func (n *NodeWithMetrics) throttle() string {
+	if n.CPUThrottle == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(int(math.Round(*n.CPUThrottle*100))) + "%"
+}
+
+func (n *NodeWithMetrics) cves() string {
+	if n.CVECount == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(*n.CVECount)
+}
+
+func (n *NodeWithMetrics) certDays() string {
+	if n.CertDays == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(*n.CertDays)
+}
+
+// gpus returns the node allocatable and requested GPUs along with the GPU vendor.
+func (n *NodeWithMetrics) gpus(no *v1.Node) (alloc, used, vendor string) {
+	gpu, ok := NodeGPU(no)
+	if !ok {
+		return NAValue, NAValue, NAValue
+	}
+	alloc, used = strconv.FormatInt(no.Status.Allocatable.Name(gpu.Resource, resource.DecimalSI).Value(), 10), NAValue
+	if n.PodCount >= 0 {
+		used = strconv.FormatInt(n.Requested.Name(gpu.Resource, resource.DecimalSI).Value(), 10)
+	}
+
+	return alloc, used, gpu.Vendor
+}
+
+func (n *NodeWithMetrics) tx() string {
+	if n.Net == nil {
+		return NAValue
+	}
+
+	return ToHumanBytes(n.Net.TxBytes)
 }
 
 // GetObjectKind returns a schema object.
@@ -175,6 +321,66 @@ func (n *NodeWithMetrics) DeepCopyObject() runtime.Object {
 	return n
 }
 
+// MarshalJSON returns the node record along with its metrics if available.
+func (n *NodeWithMetrics) MarshalJSON() ([]byte, error) {
+	rec := struct {
+		Node        map[string]any      `json:"node"`
+		Usage       v1.ResourceList     `json:"usage,omitempty"`
+		Network     *nodeNetworkJSON    `json:"network,omitempty"`
+		PodCount    *int                `json:"podCount,omitempty"`
+		PodPhases   map[v1.PodPhase]int `json:"podPhases,omitempty"`
+		Requested   v1.ResourceList     `json:"requested,omitempty"`
+		CPUThrottle *float64            `json:"cpuThrottle,omitempty"`
+		CVECount    *int                `json:"cveCount,omitempty"`
+	}{
+		PodPhases:   n.PodPhases,
+		Requested:   n.Requested,
+		CPUThrottle: n.CPUThrottle,
+		CVECount:    n.CVECount,
+	}
+	if n.Raw != nil {
+		rec.Node = n.Raw.Object
+	}
+	if n.MX != nil {
+		rec.Usage = n.MX.Usage
+	}
+	if n.Net != nil {
+		rec.Network = &nodeNetworkJSON{RxBytes: n.Net.RxBytes, TxBytes: n.Net.TxBytes}
+	}
+	if n.PodCount >= 0 {
+		rec.PodCount = &n.PodCount
+	}
+
+	return json.Marshal(rec)
+}
+
+type nodeNetworkJSON struct {
+	RxBytes uint64 `json:"rxBytes"`
+	TxBytes uint64 `json:"txBytes"`
+}
+
+func (n *NodeWithMetrics) phaseCount(p v1.PodPhase) string {
+	if n.PodPhases == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(n.PodPhases[p])
+}
+
+// requestedBar renders the ratio of requested to allocatable resources as a bar graph.
+func (n *NodeWithMetrics) requestedBar(r v1.ResourceName, alloc int64) string {
+	if n.Requested == nil || alloc == 0 {
+		return NAValue
+	}
+	q := n.Requested[r]
+	req := q.Value()
+	if r == v1.ResourceCPU {
+		req = q.MilliValue()
+	}
+
+	return AsBar(client.ToPercentage(req, alloc))
+}
+
 type metric struct {
 	cpu, mem   int64
 	lcpu, lmem int64
@@ -212,6 +418,46 @@ func nodeRoles(node *v1.Node, res []string) {
 	}
 }
 
+// nodePressures renders the node memory, disk and pid pressure conditions.
+func nodePressures(conds []v1.NodeCondition) string {
+	pressures := make(map[v1.NodeConditionType]bool, len(conds))
+	for _, c := range conds {
+		pressures[c.Type] = c.Status == v1.ConditionTrue
+	}
+
+	ss := make([]string, 0, len(pressureConditions))
+	for _, p := range pressureConditions {
+		icon := "🟢"
+		if pressures[p.kind] {
+			icon = "🔴"
+		}
+		ss = append(ss, p.label+icon)
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// nodeTaints renders node taints as comma separated key=value:effect pairs.
+func nodeTaints(tt []v1.Taint) string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		if t.Value == "" {
+			ss = append(ss, t.Key+":"+string(t.Effect))
+			continue
+		}
+		ss = append(ss, t.Key+"="+t.Value+":"+string(t.Effect))
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// AsBar renders a percentage followed by a bar graph.
+func AsBar(perc int) string {
+	fill := min(max(perc, 0), 100) * barWidth / 100
+
+	return fmt.Sprintf("%d%% %s%s", perc, strings.Repeat("▰", fill), strings.Repeat("▱", barWidth-fill))
+}
+
 func getIPs(addrs []v1.NodeAddress) (iIP, eIP string) {
 	for _, a := range addrs {
 		//nolint:exhaustive
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	a11yOK   = "[OK]"
+	a11yWarn = "[WARN]"
+	a11yErr  = "[ERR]"
+)
+
+// a11yIcons swaps emoji indicators for plain text ones.
+var a11yIcons = strings.NewReplacer("🟢", a11yOK, "🔴", a11yErr)
+
+// AccessibleNodeRenderer renders nodes as high contrast plain text.
+// Statuses are spelled out as ASCII markers and emojis, bar graphs and
+// row colors are dropped so the output reads well on screen readers.
+type AccessibleNodeRenderer struct {
+	Node
+}
+
+// ColorerFunc colors all rows using the standard color.
+func (*AccessibleNodeRenderer) ColorerFunc() model1.ColorerFunc {
+	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return model1.StdColor
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (a *AccessibleNodeRenderer) Render(o any, ns string, row *model1.Row) error {
+	if err := a.Node.Render(o, ns, row); err != nil {
+		return err
+	}
+	for i, c := range a.Header(ns) {
+		if i >= len(row.Fields) {
+			break
+		}
+		switch c.Name {
+		case "STATUS":
+			row.Fields[i] = a11yStatus(row.Fields[i])
+		case "CONDITIONS":
+			row.Fields[i] = a11yIcons.Replace(row.Fields[i])
+		case "%CPU/R", "%MEM/R":
+			row.Fields[i] = strings.TrimRight(row.Fields[i], " ▰▱")
+		}
+	}
+
+	return nil
+}
+
+// a11yStatus prefixes a node status with a plain text severity marker.
+func a11yStatus(s string) string {
+	var ready, cordoned bool
+	for _, st := range strings.Split(s, ",") {
+		switch st {
+		case "Ready":
+			ready = true
+		case "SchedulingDisabled":
+			cordoned = true
+		}
+	}
+	switch {
+	case !ready:
+		return a11yErr + " " + s
+	case cordoned:
+		return a11yWarn + " " + s
+	default:
+		return a11yOK + " " + s
+	}
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAccessibleNodeRender(t *testing.T) {
+	uu := map[string]struct {
+		unschedulable bool
+		status        string
+	}{
+		"ready": {
+			status: "[OK] Ready",
+		},
+		"cordoned": {
+			unschedulable: true,
+			status:        "[WARN] Ready,SchedulingDisabled",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw := load(t, "no")
+			require.NoError(t, unstructured.SetNestedField(raw.Object, u.unschedulable, "spec", "unschedulable"))
+			nwm := render.NodeWithMetrics{
+				Raw:      raw,
+				MX:       makeNodeMX("n1", "10m", "20Mi"),
+				PodCount: -1,
+				Requested: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("2"),
+					v1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			}
+
+			var no render.AccessibleNodeRenderer
+			r := model1.NewRow(14)
+			require.NoError(t, no.Render(&nwm, "", &r))
+
+			assert.Equal(t, u.status, r.Fields[1])
+			assert.Equal(t, "M[OK] D[OK] P[OK]", r.Fields[2])
+			assert.Equal(t, "50%", r.Fields[29])
+			for _, f := range r.Fields {
+				assert.NotContains(t, f, "🟢")
+				assert.NotContains(t, f, "▰")
+			}
+		})
+	}
+}
+
+func TestAccessibleNodeColorer(t *testing.T) {
+	var no render.AccessibleNodeRenderer
+	re := model1.RowEvent{Kind: model1.EventAdd, Row: model1.Row{Fields: model1.Fields{"a", "NotReady"}}}
+
+	assert.Equal(t, model1.StdColor, no.ColorerFunc()("", no.Header(""), &re))
+}
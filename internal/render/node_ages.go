@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+const ageCol = "AGE"
+
+// NodeAges tracks node rows age colors across refresh cycles.
+type NodeAges struct {
+	warn, crit time.Duration
+	col        int
+	colors     map[string]tcell.Color
+	mx         sync.RWMutex
+}
+
+// NewNodeAges returns a new node age colorer. Nodes younger than warn are
+// colored green, older than crit red and yellow otherwise.
+func NewNodeAges(warn, crit time.Duration) *NodeAges {
+	return &NodeAges{
+		warn:   warn,
+		crit:   crit,
+		col:    -1,
+		colors: make(map[string]tcell.Color),
+	}
+}
+
+// Update computes the given table rows age colors.
+func (a *NodeAges) Update(td *model1.TableData) {
+	col, _ := td.Header().IndexOf(ageCol, true)
+	colors := make(map[string]tcell.Color, td.RowCount())
+	if col >= 0 {
+		td.RowsRange(func(_ int, re model1.RowEvent) bool {
+			if col >= len(re.Row.Fields) {
+				return true
+			}
+			if d, ok := model1.AgeToDuration(re.Row.Fields[col]); ok {
+				colors[re.Row.ID] = a.ageColor(d)
+			}
+			return true
+		})
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.col, a.colors = col, colors
+}
+
+// Color returns the age color of the given row cell if it is the age column.
+func (a *NodeAges) Color(id string, col int) (tcell.Color, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	if col != a.col {
+		return tcell.ColorDefault, false
+	}
+	c, ok := a.colors[id]
+
+	return c, ok
+}
+
+func (a *NodeAges) ageColor(d time.Duration) tcell.Color {
+	switch {
+	case d > a.crit:
+		return tcell.ColorRed
+	case d >= a.warn:
+		return tcell.ColorYellow
+	default:
+		return tcell.ColorGreen
+	}
+}
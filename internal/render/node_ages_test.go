@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeAges(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+	uu := map[string]struct {
+		age string
+		e   tcell.Color
+		ok  bool
+	}{
+		"fresh":   {age: "29d", e: tcell.ColorGreen, ok: true},
+		"aging":   {age: "30d", e: tcell.ColorYellow, ok: true},
+		"old":     {age: "90d", e: tcell.ColorYellow, ok: true},
+		"stale":   {age: "1y2d", e: tcell.ColorRed, ok: true},
+		"unknown": {age: "<unknown>", e: tcell.ColorDefault},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := render.NewNodeAges(30*24*time.Hour, 90*24*time.Hour)
+			a.Update(makeNodeTable(h, model1.Fields{"n1", u.age}))
+			c, ok := a.Color("n1", 1)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, c)
+
+			_, ok = a.Color("n1", 0)
+			assert.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	archCol = "ARCH"
+
+	archAMD64 = "amd64"
+	archARM64 = "arm64"
+)
+
+// NodeArch returns a node cpu architecture as advertised by its kubernetes.io/arch
+// label, falling back to the kubelet reported one.
+func NodeArch(no *v1.Node) string {
+	if a, ok := no.Labels[v1.LabelArchStable]; ok && a != "" {
+		return a
+	}
+
+	return no.Status.NodeInfo.Architecture
+}
+
+// NodeArchs tracks node rows architecture colors across refresh cycles.
+type NodeArchs struct {
+	col    int
+	colors map[string]tcell.Color
+	mx     sync.RWMutex
+}
+
+// NewNodeArchs returns a new node architecture colorer. Arm64 nodes are colored
+// blue, amd64 ones use the default row color and any other architecture yellow.
+func NewNodeArchs() *NodeArchs {
+	return &NodeArchs{
+		col:    -1,
+		colors: make(map[string]tcell.Color),
+	}
+}
+
+// Update computes the given table rows architecture colors.
+func (a *NodeArchs) Update(td *model1.TableData) {
+	col, _ := td.Header().IndexOf(archCol, true)
+	colors := make(map[string]tcell.Color, td.RowCount())
+	if col >= 0 {
+		td.RowsRange(func(_ int, re model1.RowEvent) bool {
+			if col >= len(re.Row.Fields) {
+				return true
+			}
+			if c, ok := archColor(re.Row.Fields[col]); ok {
+				colors[re.Row.ID] = c
+			}
+			return true
+		})
+	}
+
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.col, a.colors = col, colors
+}
+
+// Color returns the architecture color of the given row cell if it is the arch column.
+func (a *NodeArchs) Color(id string, col int) (tcell.Color, bool) {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	if col != a.col {
+		return tcell.ColorDefault, false
+	}
+	c, ok := a.colors[id]
+
+	return c, ok
+}
+
+func archColor(arch string) (tcell.Color, bool) {
+	switch arch {
+	case archAMD64, "":
+		return tcell.ColorDefault, false
+	case archARM64:
+		return tcell.ColorBlue, true
+	default:
+		return tcell.ColorYellow, true
+	}
+}
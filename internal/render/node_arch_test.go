@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNodeArch(t *testing.T) {
+	uu := map[string]struct {
+		labels map[string]string
+		info   string
+		e      string
+	}{
+		"label":    {labels: map[string]string{v1.LabelArchStable: "arm64"}, info: "amd64", e: "arm64"},
+		"fallback": {info: "amd64", e: "amd64"},
+		"none":     {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			no := v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: u.labels},
+				Status:     v1.NodeStatus{NodeInfo: v1.NodeSystemInfo{Architecture: u.info}},
+			}
+			assert.Equal(t, u.e, render.NodeArch(&no))
+		})
+	}
+}
+
+func TestNodeArchs(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "ARCH"},
+	}
+	uu := map[string]struct {
+		arch string
+		e    tcell.Color
+		ok   bool
+	}{
+		"arm64":   {arch: "arm64", e: tcell.ColorBlue, ok: true},
+		"amd64":   {arch: "amd64", e: tcell.ColorDefault},
+		"s390x":   {arch: "s390x", e: tcell.ColorYellow, ok: true},
+		"unknown": {arch: "", e: tcell.ColorDefault},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := render.NewNodeArchs()
+			a.Update(makeNodeTable(h, model1.Fields{"n1", u.arch}))
+			c, ok := a.Color("n1", 1)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, c)
+
+			_, ok = a.Color("n1", 0)
+			assert.False(t, ok)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+)
+
+const (
+	// DefaultHighlightDuration tracks how long a changed cell remains highlighted.
+	DefaultHighlightDuration = 5 * time.Second
+
+	// ChangedColor tracks the color of changed cells.
+	ChangedColor = tcell.ColorYellow
+)
+
+type trackedRow struct {
+	fields  model1.Fields
+	changed []time.Time
+}
+
+// NodeChanges tracks node rows fields across refresh cycles
+// to flag cells whose values recently changed.
+type NodeChanges struct {
+	rows map[string]trackedRow
+	ttl  time.Duration
+	mx   sync.RWMutex
+}
+
+// NewNodeChanges returns a new changes tracker.
+func NewNodeChanges(ttl time.Duration) *NodeChanges {
+	return &NodeChanges{
+		rows: make(map[string]trackedRow),
+		ttl:  ttl,
+	}
+}
+
+// Update diffs the given table rows against the previous refresh cycle.
+// Time columns are ignored and rows no longer present are dropped.
+func (c *NodeChanges) Update(td *model1.TableData, now time.Time) {
+	h := td.Header()
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	seen := make(map[string]struct{}, td.RowCount())
+	td.RowsRange(func(_ int, re model1.RowEvent) bool {
+		seen[re.Row.ID] = struct{}{}
+		prev, ok := c.rows[re.Row.ID]
+		changed := make([]time.Time, len(re.Row.Fields))
+		for i, f := range re.Row.Fields {
+			if i < len(prev.changed) {
+				changed[i] = prev.changed[i]
+			}
+			if !ok || i >= len(prev.fields) || h.IsTimeCol(i) {
+				continue
+			}
+			if prev.fields[i] != f {
+				changed[i] = now
+			}
+		}
+		c.rows[re.Row.ID] = trackedRow{
+			fields:  slices.Clone(re.Row.Fields),
+			changed: changed,
+		}
+
+		return true
+	})
+	for id := range c.rows {
+		if _, ok := seen[id]; !ok {
+			delete(c.rows, id)
+		}
+	}
+}
+
+// Changed returns true if the given row cell changed within the highlight duration.
+func (c *NodeChanges) Changed(id string, col int, now time.Time) bool {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	r, ok := c.rows[id]
+	if !ok || col >= len(r.changed) || r.changed[col].IsZero() {
+		return false
+	}
+
+	return now.Sub(r.changed[col]) < c.ttl
+}
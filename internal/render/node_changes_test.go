@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNodeChanges(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+	t0 := time.Now()
+	c := render.NewNodeChanges(5 * time.Second)
+
+	c.Update(makeNodeTable(h, model1.Fields{"n1", "Ready", "1m"}), t0)
+	assert.False(t, c.Changed("n1", 1, t0))
+
+	t1 := t0.Add(2 * time.Second)
+	c.Update(makeNodeTable(h, model1.Fields{"n1", "NotReady", "2m"}), t1)
+	assert.False(t, c.Changed("n1", 0, t1))
+	assert.True(t, c.Changed("n1", 1, t1))
+	assert.False(t, c.Changed("n1", 2, t1))
+
+	t2 := t1.Add(2 * time.Second)
+	c.Update(makeNodeTable(h, model1.Fields{"n1", "NotReady", "3m"}), t2)
+	assert.True(t, c.Changed("n1", 1, t2))
+	assert.False(t, c.Changed("n1", 1, t1.Add(5*time.Second)))
+
+	c.Update(makeNodeTable(h, model1.Fields{"n2", "Ready", "1m"}), t2)
+	assert.False(t, c.Changed("n1", 1, t2))
+	assert.False(t, c.Changed("n2", 1, t2))
+}
+
+// Helpers...
+
+func makeNodeTable(h model1.Header, ff model1.Fields) *model1.TableData {
+	re := model1.NewRowEventsWithEvts(model1.RowEvent{
+		Row: model1.Row{ID: ff[0], Fields: ff},
+	})
+
+	return model1.NewTableDataWithRows(client.NodeGVR, h, re)
+}
@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/model1"
+)
+
+// nodeLayout tracks a node columns layout as indices into the default node header.
+// The first listed indices are the user specified columns.
+type nodeLayout struct {
+	order  []int
+	listed int
+}
+
+func (l nodeLayout) isEmpty() bool {
+	return len(l.order) == 0
+}
+
+var nodeColumns struct {
+	sync.RWMutex
+	layout nodeLayout
+}
+
+// DefaultNodeColumns returns the node columns shown by default, in order.
+func DefaultNodeColumns() []string {
+	cc := make([]string, 0, len(defaultNOHeader))
+	for _, h := range defaultNOHeader {
+		if h.Wide || h.Hide {
+			continue
+		}
+		cc = append(cc, h.Name)
+	}
+
+	return cc
+}
+
+// SetNodeColumns orders the node columns using the given column names.
+// Unlisted columns are moved to the wide view and unknown columns are skipped and reported.
+// A blank list restores the default layout.
+func SetNodeColumns(cols []string) error {
+	l, err := newNodeLayout(defaultNOHeader, cols)
+
+	nodeColumns.Lock()
+	defer nodeColumns.Unlock()
+	nodeColumns.layout = l
+
+	return err
+}
+
+func currentNodeLayout() nodeLayout {
+	nodeColumns.RLock()
+	defer nodeColumns.RUnlock()
+
+	return nodeColumns.layout
+}
+
+// newNodeLayout computes the header indices matching the given columns,
+// followed by the remaining header columns.
+func newNodeLayout(h model1.Header, cols []string) (nodeLayout, error) {
+	if len(cols) == 0 {
+		return nodeLayout{}, nil
+	}
+
+	var unknown []string
+	order := make([]int, 0, len(h))
+	for _, c := range cols {
+		idx, ok := h.IndexOf(strings.ToUpper(strings.TrimSpace(c)), true)
+		if !ok || h[idx].Hide {
+			unknown = append(unknown, c)
+			continue
+		}
+		if !slices.Contains(order, idx) {
+			order = append(order, idx)
+		}
+	}
+	if len(order) == 0 {
+		return nodeLayout{}, fmt.Errorf("no valid node columns in %v. Valid columns are %v", cols, nodeColumnNames(h))
+	}
+	listed := len(order)
+	for i := range h {
+		if !slices.Contains(order[:listed], i) {
+			order = append(order, i)
+		}
+	}
+	l := nodeLayout{order: order, listed: listed}
+	if len(unknown) > 0 {
+		return l, fmt.Errorf("unknown node columns %v. Valid columns are %v", unknown, nodeColumnNames(h))
+	}
+
+	return l, nil
+}
+
+// header lays out the given header. Listed columns are always shown
+// while the remaining ones are only shown in wide mode.
+func (l nodeLayout) header(h model1.Header) model1.Header {
+	hh := make(model1.Header, 0, len(l.order))
+	for i, idx := range l.order {
+		c := h[idx].Clone()
+		if !c.Hide {
+			c.Wide = i >= l.listed
+		}
+		hh = append(hh, c)
+	}
+
+	return hh
+}
+
+// fields lays out the given row fields.
+func (l nodeLayout) fields(ff model1.Fields) model1.Fields {
+	oo := make(model1.Fields, 0, len(l.order))
+	for _, idx := range l.order {
+		oo = append(oo, ff[idx])
+	}
+
+	return oo
+}
+
+func nodeColumnNames(h model1.Header) []string {
+	nn := make([]string, 0, len(h))
+	for _, c := range h {
+		if !c.Hide {
+			nn = append(nn, c.Name)
+		}
+	}
+
+	return nn
+}
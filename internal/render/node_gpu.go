@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"github.com/derailed/k9s/internal/model1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const gpuCol = "GPU"
+
+// GPUResource represents a vendor GPU extended resource.
+type GPUResource struct {
+	Vendor   string
+	Resource v1.ResourceName
+}
+
+// GPUResources tracks the GPU extended resources advertised by device plugins.
+var GPUResources = []GPUResource{
+	{Vendor: "nvidia", Resource: "nvidia.com/gpu"},
+	{Vendor: "amd", Resource: "amd.com/gpu"},
+}
+
+// NodeGPU returns the GPU resource advertised by the given node if any.
+func NodeGPU(no *v1.Node) (GPUResource, bool) {
+	for _, g := range GPUResources {
+		if q, ok := no.Status.Capacity[g.Resource]; ok && !q.IsZero() {
+			return g, true
+		}
+	}
+
+	return GPUResource{}, false
+}
+
+// IsGPUNode checks if the given raw node advertises GPUs.
+func IsGPUNode(u *unstructured.Unstructured) bool {
+	if u == nil {
+		return false
+	}
+	cc, _, _ := unstructured.NestedStringMap(u.Object, "status", "capacity")
+	for _, g := range GPUResources {
+		if q, err := resource.ParseQuantity(cc[string(g.Resource)]); err == nil && !q.IsZero() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasGPUNodes checks if any of the rendered nodes advertises GPUs.
+func HasGPUNodes(td *model1.TableData) bool {
+	col, ok := td.Header().IndexOf(gpuCol, true)
+	if !ok {
+		return false
+	}
+	var found bool
+	td.RowsRange(func(_ int, re model1.RowEvent) bool {
+		if col < len(re.Row.Fields) && re.Row.Fields[col] != NAValue {
+			found = true
+		}
+		return !found
+	})
+
+	return found
+}
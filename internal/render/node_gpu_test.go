@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNodeGPU(t *testing.T) {
+	uu := map[string]struct {
+		capacity v1.ResourceList
+		vendor   string
+		ok       bool
+	}{
+		"nvidia": {capacity: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")}, vendor: "nvidia", ok: true},
+		"amd":    {capacity: v1.ResourceList{"amd.com/gpu": resource.MustParse("2")}, vendor: "amd", ok: true},
+		"zero":   {capacity: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("0")}},
+		"none":   {capacity: v1.ResourceList{v1.ResourceCPU: resource.MustParse("4")}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			no := v1.Node{Status: v1.NodeStatus{Capacity: u.capacity}}
+			g, ok := render.NodeGPU(&no)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.vendor, g.Vendor)
+			assert.Equal(t, u.ok, render.IsGPUNode(toUnstructuredNode(t, &no)))
+		})
+	}
+}
+
+func TestNodeRenderGPU(t *testing.T) {
+	no := v1.Node{
+		Status: v1.NodeStatus{
+			Capacity:    v1.ResourceList{"nvidia.com/gpu": resource.MustParse("8")},
+			Allocatable: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("7")},
+		},
+	}
+	no.Name = "n1"
+	uu := map[string]struct {
+		nwm render.NodeWithMetrics
+		e   model1.Fields
+	}{
+		"counted": {
+			nwm: render.NodeWithMetrics{
+				Raw:       toUnstructuredNode(t, &no),
+				PodCount:  2,
+				Requested: v1.ResourceList{"nvidia.com/gpu": resource.MustParse("3")},
+			},
+			e: model1.Fields{"7", "3", "nvidia"},
+		},
+		"uncounted": {
+			nwm: render.NodeWithMetrics{Raw: toUnstructuredNode(t, &no), PodCount: -1},
+			e:   model1.Fields{"7", "n/a", "nvidia"},
+		},
+		"no-gpu": {
+			nwm: render.NodeWithMetrics{Raw: load(t, "no"), PodCount: -1},
+			e:   model1.Fields{"n/a", "n/a", "n/a"},
+		},
+	}
+
+	var re render.Node
+	h := re.Header("")
+	idx, ok := h.IndexOf("GPU", true)
+	require.True(t, ok)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, re.Render(&u.nwm, "", &r))
+			assert.Equal(t, u.e, r.Fields[idx:idx+3])
+		})
+	}
+}
+
+func TestHasGPUNodes(t *testing.T) {
+	h := model1.Header{{Name: "NAME"}, {Name: "GPU"}}
+	uu := map[string]struct {
+		h  model1.Header
+		ff []model1.Fields
+		e  bool
+	}{
+		"gpu":    {h: h, ff: []model1.Fields{{"n1", "n/a"}, {"n2", "4"}}, e: true},
+		"none":   {h: h, ff: []model1.Fields{{"n1", "n/a"}}},
+		"no-col": {h: model1.Header{{Name: "NAME"}}, ff: []model1.Fields{{"n1"}}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ee := make([]model1.RowEvent, 0, len(u.ff))
+			for _, f := range u.ff {
+				ee = append(ee, model1.RowEvent{Row: model1.Row{ID: f[0], Fields: f}})
+			}
+			td := model1.NewTableDataWithRows(client.NodeGVR, u.h, model1.NewRowEventsWithEvts(ee...))
+			assert.Equal(t, u.e, render.HasGPUNodes(td))
+		})
+	}
+}
+
+func toUnstructuredNode(t *testing.T, no *v1.Node) *unstructured.Unstructured {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(no)
+	require.NoError(t, err)
+
+	return &unstructured.Unstructured{Object: m}
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func Test_nodePressures(t *testing.T) {
+	uu := map[string]struct {
+		cc []v1.NodeCondition
+		e  string
+	}{
+		"none": {
+			e: "M🟢 D🟢 P🟢",
+		},
+		"healthy": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeReady, Status: v1.ConditionTrue},
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionFalse},
+			},
+			e: "M🟢 D🟢 P🟢",
+		},
+		"pressured": {
+			cc: []v1.NodeCondition{
+				{Type: v1.NodeMemoryPressure, Status: v1.ConditionTrue},
+				{Type: v1.NodePIDPressure, Status: v1.ConditionTrue},
+			},
+			e: "M🔴 D🟢 P🔴",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nodePressures(u.cc))
+		})
+	}
+}
+
+func Test_nodeTaints(t *testing.T) {
+	uu := map[string]struct {
+		tt []v1.Taint
+		e  string
+	}{
+		"none": {},
+		"no-value": {
+			tt: []v1.Taint{
+				{Key: "node.kubernetes.io/unschedulable", Effect: v1.TaintEffectNoSchedule},
+			},
+			e: "node.kubernetes.io/unschedulable:NoSchedule",
+		},
+		"multi": {
+			tt: []v1.Taint{
+				{Key: "gpu", Value: "present", Effect: v1.TaintEffectNoSchedule},
+				{Key: "spot", Value: "true", Effect: v1.TaintEffectPreferNoSchedule},
+			},
+			e: "gpu=present:NoSchedule,spot=true:PreferNoSchedule",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nodeTaints(u.tt))
+		})
+	}
+}
+
+func TestAsBar(t *testing.T) {
+	uu := map[string]struct {
+		perc int
+		e    string
+	}{
+		"empty": {
+			e: "0% ▱▱▱▱▱▱▱▱▱▱",
+		},
+		"partial": {
+			perc: 45,
+			e:    "45% ▰▰▰▰▱▱▱▱▱▱",
+		},
+		"full": {
+			perc: 100,
+			e:    "100% ▰▰▰▰▰▰▰▰▰▰",
+		},
+		"over": {
+			perc: 120,
+			e:    "120% ▰▰▰▰▰▰▰▰▰▰",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, AsBar(u.perc))
+		})
+	}
+}
@@ -4,8 +4,10 @@
 package render_test
 
 import (
+	"encoding/json"
 	"testing"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
@@ -26,8 +28,147 @@ func TestNodeRender(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "minikube", r.ID)
-	e := model1.Fields{"minikube", "Ready", "master", "amd64", "0", "v1.15.2", "Buildroot 2018.05.3", "4.15.0", "192.168.64.107", "<none>", "0", "10", "20", "0", "0", "4000", "7874"}
-	assert.Equal(t, e, r.Fields[:17])
+	e := model1.Fields{"minikube", "Ready", "M🟢 D🟢 P🟢", "master", "amd64", "<none>", "v1.15.2", "Buildroot 2018.05.3", "4.15.0", "192.168.64.107", "<none>", "0", "n/a", "n/a", "n/a", "10", "20", "0", "0", "4000", "7874"}
+	assert.Equal(t, e, r.Fields[:21])
+}
+
+func TestNodeRenderCertDays(t *testing.T) {
+	days := 7
+	uu := map[string]struct {
+		nwm render.NodeWithMetrics
+		e   string
+	}{
+		"reported": {
+			nwm: render.NodeWithMetrics{Raw: load(t, "no"), CertDays: &days},
+			e:   "7",
+		},
+		"unknown": {
+			nwm: render.NodeWithMetrics{Raw: load(t, "no")},
+			e:   "n/a",
+		},
+	}
+
+	var re render.Node
+	h := re.Header("")
+	idx, ok := h.IndexOf("CERT-DAYS", true)
+	require.True(t, ok)
+	assert.Equal(t, model1.CertGroup, h[idx].ColGroup)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, re.Render(&u.nwm, "", &r))
+			assert.Equal(t, u.e, r.Fields[idx])
+		})
+	}
+}
+
+func TestNodeWithMetricsMarshalJSON(t *testing.T) {
+	uu := map[string]struct {
+		nwm  render.NodeWithMetrics
+		keys []string
+	}{
+		"plain": {
+			nwm:  render.NodeWithMetrics{Raw: load(t, "no"), PodCount: -1},
+			keys: []string{"node"},
+		},
+		"metrics": {
+			nwm: render.NodeWithMetrics{
+				Raw:      load(t, "no"),
+				MX:       makeNodeMX("n1", "10m", "20Mi"),
+				Net:      &client.NodeNetworkMetrics{RxBytes: 10, TxBytes: 20},
+				PodCount: 3,
+			},
+			keys: []string{"network", "node", "podCount", "usage"},
+		},
+		"throttle": {
+			nwm: render.NodeWithMetrics{
+				Raw:         load(t, "no"),
+				PodCount:    -1,
+				CPUThrottle: new(float64),
+			},
+			keys: []string{"node", "cpuThrottle"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bb, err := json.Marshal(&u.nwm)
+			require.NoError(t, err)
+
+			var rec map[string]any
+			require.NoError(t, json.Unmarshal(bb, &rec))
+			kk := make([]string, 0, len(rec))
+			for k := range rec {
+				kk = append(kk, k)
+			}
+			assert.ElementsMatch(t, u.keys, kk)
+			assert.Equal(t, "minikube", rec["node"].(map[string]any)["metadata"].(map[string]any)["name"])
+		})
+	}
+}
+
+func TestNodeMergeLiveFields(t *testing.T) {
+	var no render.Node
+	old := model1.NewRow(1)
+	require.NoError(t, no.Render(&render.NodeWithMetrics{
+		Raw:      load(t, "no"),
+		MX:       makeNodeMX("n1", "10m", "20Mi"),
+		PodCount: 5,
+	}, "", &old))
+
+	r := model1.NewRow(1)
+	require.NoError(t, no.Render(&render.NodeWithMetrics{Raw: load(t, "no"), PodCount: -1}, "", &r))
+	h := no.Header("")
+	no.MergeLiveFields(h, &old, &r)
+
+	assert.Equal(t, old.Fields, r.Fields)
+	pods, _ := h.IndexOf("PODS", true)
+	assert.Equal(t, "5", r.Fields[pods])
+	cpu, _ := h.IndexOf("CPU", true)
+	assert.Equal(t, "10", r.Fields[cpu])
+}
+
+func TestNodeRenderColumns(t *testing.T) {
+	require.NoError(t, render.SetNodeColumns([]string{"status", "NAME", "CPU"}))
+	defer func() {
+		require.NoError(t, render.SetNodeColumns(nil))
+	}()
+
+	var no render.Node
+	h := no.Header("")
+	assert.Equal(t, []string{"STATUS", "NAME", "CPU", "CONDITIONS"}, h.ColumnNames(true)[:4])
+	assert.False(t, h[2].Wide)
+	assert.True(t, h[3].Wide)
+	idx, ok := h.IndexOf("TAINT-FILTER", true)
+	assert.True(t, ok)
+	assert.True(t, h[idx].Hide)
+
+	pom := render.NodeWithMetrics{
+		Raw: load(t, "no"),
+		MX:  makeNodeMX("n1", "10m", "20Mi"),
+	}
+	r := model1.NewRow(14)
+	require.NoError(t, no.Render(&pom, "", &r))
+	assert.Equal(t, model1.Fields{"Ready", "minikube", "10"}, r.Fields[:3])
+	assert.Len(t, r.Fields, len(h))
+}
+
+func TestSetNodeColumnsUnknown(t *testing.T) {
+	defer func() {
+		require.NoError(t, render.SetNodeColumns(nil))
+	}()
+
+	err := render.SetNodeColumns([]string{"NAME", "ROLES", "TAINT-FILTER"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[ROLES TAINT-FILTER]")
+
+	var no render.Node
+	assert.Equal(t, "NAME", no.Header("")[0].Name)
+
+	require.Error(t, render.SetNodeColumns([]string{"FRED"}))
+	assert.Equal(t, "STATUS", no.Header("")[1].Name)
 }
 
 func BenchmarkNodeRender(b *testing.B) {
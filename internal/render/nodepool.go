@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	poolReady    = "Ready"
+	poolDegraded = "Degraded"
+	poolNotReady = "NotReady"
+)
+
+// NodePool renders nodes aggregated by pool to screen.
+type NodePool struct {
+	Base
+}
+
+// Header returns a header row.
+func (NodePool) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "NODES", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "READY", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "CPU", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "CPU/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "MEM", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "MEM/A", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "LABEL", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "VALID", Attrs: model1.Attrs{Wide: true}},
+	}
+}
+
+// Render renders a node pool to screen.
+func (NodePool) Render(o any, _ string, r *model1.Row) error {
+	p, ok := o.(NodePoolRes)
+	if !ok {
+		return fmt.Errorf("expecting node pool, but got %T", o)
+	}
+
+	r.ID = p.Name
+	r.Fields = model1.Fields{
+		p.Name,
+		strconv.Itoa(p.Nodes),
+		strconv.Itoa(p.Ready) + "/" + strconv.Itoa(p.Nodes),
+		p.status(),
+		toMc(p.CPU),
+		toMc(p.AllocatableCPU),
+		toMi(p.MEM),
+		toMi(p.AllocatableMEM),
+		p.Label,
+		AsStatus(p.diagnose()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NodePoolRes represents a collection of nodes sharing a pool label value.
+type NodePoolRes struct {
+	Name, Label         string
+	Nodes, Ready        int
+	CPU, AllocatableCPU int64
+	MEM, AllocatableMEM int64
+}
+
+// GetObjectKind returns a schema object.
+func (NodePoolRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a node pool copy.
+func (p NodePoolRes) DeepCopyObject() runtime.Object {
+	return p
+}
+
+func (p NodePoolRes) status() string {
+	switch p.Ready {
+	case p.Nodes:
+		return poolReady
+	case 0:
+		return poolNotReady
+	default:
+		return poolDegraded
+	}
+}
+
+func (p NodePoolRes) diagnose() error {
+	if p.Ready != p.Nodes {
+		return fmt.Errorf("%d node(s) not ready", p.Nodes-p.Ready)
+	}
+
+	return nil
+}
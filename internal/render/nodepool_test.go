@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePoolRender(t *testing.T) {
+	uu := map[string]struct {
+		o render.NodePoolRes
+		e model1.Fields
+	}{
+		"ready": {
+			o: render.NodePoolRes{
+				Name:           "p1",
+				Label:          "pool",
+				Nodes:          2,
+				Ready:          2,
+				CPU:            4000,
+				AllocatableCPU: 3800,
+				MEM:            8 * 1024 * 1024 * 1024,
+				AllocatableMEM: 7 * 1024 * 1024 * 1024,
+			},
+			e: model1.Fields{"p1", "2", "2/2", "Ready", "4000", "3800", "8192", "7168", "pool", ""},
+		},
+		"degraded": {
+			o: render.NodePoolRes{Name: "p2", Label: "pool", Nodes: 3, Ready: 1},
+			e: model1.Fields{"p2", "3", "1/3", "Degraded", "0", "0", "0", "0", "pool", "2 node(s) not ready"},
+		},
+		"not-ready": {
+			o: render.NodePoolRes{Name: "p3", Label: "pool", Nodes: 1},
+			e: model1.Fields{"p3", "1", "0/1", "NotReady", "0", "0", "0", "0", "pool", "1 node(s) not ready"},
+		},
+	}
+
+	var p render.NodePool
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, p.Render(u.o, "", &r))
+			assert.Equal(t, u.o.Name, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
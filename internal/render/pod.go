@@ -63,6 +63,7 @@ var defaultPodHeader = model1.Header{
 	model1.HeaderColumn{Name: "%CPU/L", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%MEM/R", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
 	model1.HeaderColumn{Name: "%MEM/L", Attrs: model1.Attrs{Align: tview.AlignRight, MX: true}},
+	model1.HeaderColumn{Name: "OOM", Attrs: model1.Attrs{Align: tview.AlignRight, ColGroup: model1.PromMXGroup}},
 	model1.HeaderColumn{Name: "IP"},
 	model1.HeaderColumn{Name: "NODE"},
 	model1.HeaderColumn{Name: "SERVICE-ACCOUNT", Attrs: model1.Attrs{Wide: true}},
@@ -174,7 +175,7 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 		"●",
 		strconv.Itoa(cr) + "/" + strconv.Itoa(len(spec.Containers)),
 		phase,
-		strconv.Itoa(rc + irc),
+		restartsWithDelta(rc+irc, pwm.RestartDelta),
 		ToAge(lr),
 		toMc(c.cpu),
 		toMi(c.mem),
@@ -184,6 +185,7 @@ func (p *Pod) defaultRow(pwm *PodWithMetrics, row *model1.Row) error {
 		client.ToPercentageStr(c.cpu, r.lcpu),
 		client.ToPercentageStr(c.mem, r.mem),
 		client.ToPercentageStr(c.mem, r.lmem),
+		pwm.oomKills(),
 		na(st.PodIP),
 		na(spec.NodeName),
 		na(spec.ServiceAccountName),
@@ -215,6 +217,15 @@ func (*Pod) diagnose(phase string, cr, ct int) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// restartsWithDelta renders the pod total restarts along with its recent restarts if any.
+func restartsWithDelta(count int, delta int32) string {
+	if delta <= 0 {
+		return strconv.Itoa(count)
+	}
+
+	return strconv.Itoa(count) + " (+" + strconv.Itoa(int(delta)) + ")"
+}
+
 func asNominated(n string) string {
 	if n == "" {
 		return MissingValue
@@ -245,8 +256,20 @@ func asReadinessGate(spec *v1.PodSpec, st *v1.PodStatus) string {
 
 // PodWithMetrics represents a pod and its metrics.
 type PodWithMetrics struct {
-	Raw *unstructured.Unstructured
-	MX  *mv1beta1.PodMetrics
+	Raw          *unstructured.Unstructured
+	MX           *mv1beta1.PodMetrics
+	RestartDelta int32
+
+	// OOMKills tracks the pod OOM kills reported by Prometheus.
+	OOMKills *int
+}
+
+func (p *PodWithMetrics) oomKills() string {
+	if p.OOMKills == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(*p.OOMKills)
 }
 
 // GetObjectKind returns a schema object.
@@ -164,8 +164,35 @@ func TestPodRender(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := model1.Fields{"default", "nginx", "0", "●", "1/1", "Running", "0", "<unknown>", "100", "50", "100:0", "70:170", "100", "n/a", "71", "29", "172.17.0.6", "minikube", "default", "<none>"}
-	assert.Equal(t, e, r.Fields[:20])
+	e := model1.Fields{"default", "nginx", "0", "●", "1/1", "Running", "0", "<unknown>", "100", "50", "100:0", "70:170", "100", "n/a", "71", "29", "n/a", "172.17.0.6", "minikube", "default", "<none>"}
+	assert.Equal(t, e, r.Fields[:21])
+}
+
+func TestPodRenderRestartDelta(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:          load(t, "po"),
+		RestartDelta: 3,
+	}
+
+	po := render.NewPod()
+	r := model1.NewRow(14)
+	require.NoError(t, po.Render(&pom, "", &r))
+
+	assert.Equal(t, "0 (+3)", r.Fields[6])
+}
+
+func TestPodRenderOOMKills(t *testing.T) {
+	ooms := 2
+	pom := render.PodWithMetrics{
+		Raw:      load(t, "po"),
+		OOMKills: &ooms,
+	}
+
+	po := render.NewPod()
+	r := model1.NewRow(14)
+	require.NoError(t, po.Render(&pom, "", &r))
+
+	assert.Equal(t, "2", r.Fields[16])
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -195,8 +222,8 @@ func TestPodInitRender(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := model1.Fields{"default", "nginx", "0", "●", "1/1", "Init:0/1", "0", "<unknown>", "10", "10", "100:0", "70:170", "10", "n/a", "14", "5", "172.17.0.6", "minikube", "default", "<none>"}
-	assert.Equal(t, e, r.Fields[:20])
+	e := model1.Fields{"default", "nginx", "0", "●", "1/1", "Init:0/1", "0", "<unknown>", "10", "10", "100:0", "70:170", "10", "n/a", "14", "5", "n/a", "172.17.0.6", "minikube", "default", "<none>"}
+	assert.Equal(t, e, r.Fields[:21])
 }
 
 func TestPodSidecarRender(t *testing.T) {
@@ -211,8 +238,8 @@ func TestPodSidecarRender(t *testing.T) {
 	require.NoError(t, err)
 
 	assert.Equal(t, "default/sleep", r.ID)
-	e := model1.Fields{"default", "sleep", "0", "●", "1/1", "Running", "0", "<unknown>", "100", "40", "50:250", "50:80", "200", "40", "80", "50", "10.244.0.8", "kind-control-plane", "default", "<none>"}
-	assert.Equal(t, e, r.Fields[:20])
+	e := model1.Fields{"default", "sleep", "0", "●", "1/1", "Running", "0", "<unknown>", "100", "40", "50:250", "50:80", "200", "40", "80", "50", "n/a", "10.244.0.8", "kind-control-plane", "default", "<none>"}
+	assert.Equal(t, e, r.Fields[:21])
 }
 
 func TestCheckPodStatus(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,6 +23,7 @@ func TestPortForwardRender(t *testing.T) {
 			Host: "0.0.0.0",
 			Path: "/",
 		},
+		Transferred: 2048,
 	}
 
 	var p render.PortForward
@@ -33,11 +35,35 @@ func TestPortForwardRender(t *testing.T) {
 		"fred",
 		"co",
 		"p1:p2",
+		"p1",
+		"Active",
+		"2.0Ki",
 		"http://0.0.0.0:p1/",
 		"1",
 		"1",
 		"",
-	}, r.Fields[:8])
+	}, r.Fields[:11])
+}
+
+func TestPortForwardColorer(t *testing.T) {
+	var p render.PortForward
+	h := p.Header("")
+	uu := map[string]struct {
+		status string
+		e      tcell.Color
+	}{
+		"active": {status: render.PortForwardActive, e: tcell.ColorSkyblue},
+		"failed": {status: render.PortForwardFailed, e: model1.ErrColor},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, p.Render(render.ForwardRes{Forwarder: fwd{}, Status: u.status}, "", &r))
+			assert.Equal(t, u.e, p.ColorerFunc()("", h, &model1.RowEvent{Row: r}))
+		})
+	}
 }
 
 // Helpers...
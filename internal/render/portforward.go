@@ -11,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,6 +38,13 @@ type Forwarder interface {
 	Age() time.Time
 }
 
+// PortForward statuses.
+const (
+	PortForwardStarting = "Starting"
+	PortForwardActive   = "Active"
+	PortForwardFailed   = "Failed"
+)
+
 // PortForward renders a portforwards to screen.
 type PortForward struct {
 	Base
@@ -44,7 +52,12 @@ type PortForward struct {
 
 // ColorerFunc colors a resource row.
 func (PortForward) ColorerFunc() model1.ColorerFunc {
-	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
+	return func(_ string, h model1.Header, re *model1.RowEvent) tcell.Color {
+		idx, ok := h.IndexOf("STATUS", true)
+		if ok && idx < len(re.Row.Fields) && re.Row.Fields[idx] == PortForwardFailed {
+			return model1.ErrColor
+		}
+
 		return tcell.ColorSkyblue
 	}
 }
@@ -56,6 +69,9 @@ func (PortForward) Header(string) model1.Header {
 		model1.HeaderColumn{Name: "NAME"},
 		model1.HeaderColumn{Name: "CONTAINER"},
 		model1.HeaderColumn{Name: "PORTS"},
+		model1.HeaderColumn{Name: "LOCAL", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "BYTES", Attrs: model1.Attrs{Align: tview.AlignRight}},
 		model1.HeaderColumn{Name: "URL"},
 		model1.HeaderColumn{Name: "C"},
 		model1.HeaderColumn{Name: "N"},
@@ -80,6 +96,9 @@ func (PortForward) Render(o any, _ string, r *model1.Row) error {
 		trimContainer(n),
 		pf.Container(),
 		pf.Port(),
+		ports[0],
+		pf.status(),
+		ToHumanBytes(pf.Transferred),
 		UrlFor(pf.Config.Host, pf.Config.Path, ports[0], pf.Address()),
 		AsThousands(int64(pf.Config.C)),
 		AsThousands(int64(pf.Config.N)),
@@ -124,6 +143,23 @@ type BenchCfg struct {
 type ForwardRes struct {
 	Forwarder
 	Config BenchCfg
+
+	// Status tracks the port-forward status. Defaults to the forwarder state.
+	Status string
+
+	// Transferred tracks the bytes transferred through the port-forward.
+	Transferred uint64
+}
+
+func (f ForwardRes) status() string {
+	switch {
+	case f.Status != "":
+		return f.Status
+	case f.Active():
+		return PortForwardActive
+	default:
+		return PortForwardStarting
+	}
 }
 
 // GetObjectKind returns a schema object.
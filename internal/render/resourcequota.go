@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// QuotaResourceSep separates a quota usage id from its quota resource name.
+const QuotaResourceSep = ":"
+
+// QuotaUsage renders a resource quota usage to screen.
+type QuotaUsage struct {
+	Base
+}
+
+// Header returns a header row.
+func (QuotaUsage) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "RESOURCE"},
+		model1.HeaderColumn{Name: "USED", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "HARD", Attrs: model1.Attrs{Align: tview.AlignRight}},
+		model1.HeaderColumn{Name: "%USED"},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+}
+
+// Render renders a resource quota usage to screen.
+func (QuotaUsage) Render(o any, _ string, r *model1.Row) error {
+	q, ok := o.(QuotaUsageRes)
+	if !ok {
+		return fmt.Errorf("expecting quota usage, but got %T", o)
+	}
+
+	r.ID = QuotaUsageID(q.Namespace, q.Name, q.Resource)
+	r.Fields = model1.Fields{
+		q.Namespace,
+		q.Name,
+		q.Resource,
+		q.Used.String(),
+		q.Hard.String(),
+		AsBar(q.percentage()),
+		ToAge(q.Created),
+	}
+
+	return nil
+}
+
+// QuotaUsageID returns a quota resource usage id. Slashes in resource names
+// ie count/pods are swapped out to keep the id a valid fqn.
+func QuotaUsageID(ns, name, res string) string {
+	return client.FQN(ns, name) + QuotaResourceSep + strings.ReplaceAll(res, "/", ".")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// QuotaUsageRes represents a resource quota usage for a given resource.
+type QuotaUsageRes struct {
+	Namespace, Name, Resource string
+	Used, Hard                resource.Quantity
+	Created                   metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (QuotaUsageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a quota usage copy.
+func (q QuotaUsageRes) DeepCopyObject() runtime.Object {
+	return q
+}
+
+func (q QuotaUsageRes) percentage() int {
+	return client.ToPercentage(q.Used.MilliValue(), q.Hard.MilliValue())
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestQuotaUsageRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.QuotaUsageRes
+		id string
+		e  model1.Fields
+	}{
+		"cpu": {
+			o: render.QuotaUsageRes{
+				Namespace: "ns1",
+				Name:      "q1",
+				Resource:  "requests.cpu",
+				Used:      resource.MustParse("1500m"),
+				Hard:      resource.MustParse("2"),
+			},
+			id: "ns1/q1:requests.cpu",
+			e:  model1.Fields{"ns1", "q1", "requests.cpu", "1500m", "2", "75% ▰▰▰▰▰▰▰▱▱▱", "<unknown>"},
+		},
+		"count": {
+			o: render.QuotaUsageRes{
+				Namespace: "ns1",
+				Name:      "q1",
+				Resource:  "count/pods",
+				Used:      resource.MustParse("0"),
+				Hard:      resource.MustParse("0"),
+			},
+			id: "ns1/q1:count.pods",
+			e:  model1.Fields{"ns1", "q1", "count/pods", "0", "0", "0% ▱▱▱▱▱▱▱▱▱▱", "<unknown>"},
+		},
+	}
+
+	var q render.QuotaUsage
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, q.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // ScreenDump renders a screendumps to screen.
@@ -60,7 +59,7 @@ func (ScreenDump) Render(o any, _ string, r *model1.Row) error {
 // Helpers...
 
 func timeToAge(timestamp time.Time) string {
-	return duration.HumanDuration(time.Since(timestamp))
+	return Units().Age(time.Since(timestamp))
 }
 
 // FileRes represents a file resource.
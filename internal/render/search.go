@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SearchSep separates a search result resource from its fqn.
+const SearchSep = "|"
+
+// Search renders global search results to screen.
+type Search struct {
+	Base
+}
+
+// Header returns a header row.
+func (Search) Header(string) model1.Header {
+	return model1.Header{
+		model1.HeaderColumn{Name: "KIND"},
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "MATCH"},
+	}
+}
+
+// Render renders a search result to screen.
+func (Search) Render(o any, _ string, r *model1.Row) error {
+	s, ok := o.(SearchRes)
+	if !ok {
+		return fmt.Errorf("expecting search result, but got %T", o)
+	}
+
+	r.ID = SearchID(s.GVR, s.Namespace, s.Name)
+	r.Fields = model1.Fields{
+		client.NewGVR(s.GVR).R(),
+		s.Namespace,
+		s.Name,
+		s.Match,
+	}
+
+	return nil
+}
+
+// SearchID returns a search result id.
+func SearchID(gvr, ns, name string) string {
+	return gvr + SearchSep + client.FQN(ns, name)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// SearchRes represents a resource matching a global search.
+type SearchRes struct {
+	GVR, Namespace, Name, Match string
+}
+
+// GetObjectKind returns a schema object.
+func (SearchRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a search result copy.
+func (s SearchRes) DeepCopyObject() runtime.Object {
+	return s
+}
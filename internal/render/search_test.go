@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchRender(t *testing.T) {
+	uu := map[string]struct {
+		o  render.SearchRes
+		id string
+		e  model1.Fields
+	}{
+		"namespaced": {
+			o:  render.SearchRes{GVR: "apps/v1/deployments", Namespace: "ns1", Name: "fred", Match: "name"},
+			id: "apps/v1/deployments|ns1/fred",
+			e:  model1.Fields{"deployments", "ns1", "fred", "name"},
+		},
+		"cluster": {
+			o:  render.SearchRes{GVR: "v1/nodes", Name: "n1", Match: "label app=fred"},
+			id: "v1/nodes|n1",
+			e:  model1.Fields{"nodes", "", "n1", "label app=fred"},
+		},
+	}
+
+	var s render.Search
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r model1.Row
+			require.NoError(t, s.Render(u.o, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
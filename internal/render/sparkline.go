@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import "strings"
+
+const brailleBlank = '⠀'
+
+// Braille dot masks filling a character cell bottom up, for its left and right columns.
+var (
+	brailleLeft  = [...]rune{0, 0x40, 0x44, 0x46, 0x47}
+	brailleRight = [...]rune{0, 0x80, 0xA0, 0xB0, 0xB8}
+)
+
+// Sparkline renders percentages as a braille sparkline, two samples per character.
+func Sparkline(pp []int) string {
+	var b strings.Builder
+	b.Grow(len(pp) * 2)
+	for i := 0; i < len(pp); i += 2 {
+		r := brailleBlank + brailleLeft[brailleLevel(pp[i])]
+		if i+1 < len(pp) {
+			r += brailleRight[brailleLevel(pp[i+1])]
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// brailleLevel maps a percentage to a 0-4 dots height, showing any non zero value.
+func brailleLevel(p int) int {
+	switch {
+	case p <= 0:
+		return 0
+	case p >= 100:
+		return len(brailleLeft) - 1
+	default:
+		return 1 + p*(len(brailleLeft)-2)/100
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSparkline(t *testing.T) {
+	uu := map[string]struct {
+		pp []int
+		e  string
+	}{
+		"empty": {},
+		"zeros": {
+			pp: []int{0, 0},
+			e:  "⠀",
+		},
+		"odd": {
+			pp: []int{100},
+			e:  "⡇",
+		},
+		"ramp": {
+			pp: []int{0, 10, 40, 70, 100, 100},
+			e:  "⢀⣴⣿",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.Sparkline(u.pp))
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// RawUnits renders cpu in millicores and memory in MiB.
+	RawUnits = "raw"
+
+	// MetricUnits renders cpu in cores and memory using SI units ie 512M, 1.5G.
+	MetricUnits = "metric"
+
+	// BinaryUnits renders cpu in cores and memory using binary units ie 512Mi, 1.5Gi.
+	BinaryUnits = "binary"
+)
+
+var (
+	metricSuffixes = []string{"", "k", "M", "G", "T", "P", "E"}
+	binarySuffixes = []string{"", "Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+)
+
+// UnitConverter normalizes resource quantities and durations for display.
+// Memory suffixes are valid resource quantities so columns sort numerically.
+type UnitConverter struct {
+	units string
+}
+
+// NewUnitConverter returns a converter for the given units convention.
+// A blank convention defaults to raw units.
+func NewUnitConverter(units string) (UnitConverter, error) {
+	switch units {
+	case "":
+		return UnitConverter{units: RawUnits}, nil
+	case RawUnits, MetricUnits, BinaryUnits:
+		return UnitConverter{units: units}, nil
+	default:
+		return UnitConverter{units: RawUnits}, fmt.Errorf("invalid units %q. Must be one of %s, %s or %s", units, MetricUnits, BinaryUnits, RawUnits)
+	}
+}
+
+// Units returns the converter units convention.
+func (u UnitConverter) Units() string {
+	return u.units
+}
+
+// CPU renders the given millicores.
+func (u UnitConverter) CPU(mc int64) string {
+	switch {
+	case mc == 0:
+		return ZeroValue
+	case u.units == MetricUnits || u.units == BinaryUnits:
+		return strconv.FormatFloat(float64(mc)/1000, 'f', -1, 64)
+	default:
+		return strconv.FormatInt(mc, 10)
+	}
+}
+
+// Memory renders the given bytes, auto scaling to the largest fitting unit.
+func (u UnitConverter) Memory(b int64) string {
+	switch {
+	case b == 0:
+		return ZeroValue
+	case u.units == MetricUnits:
+		return scaleUnits(b, 1000, metricSuffixes)
+	case u.units == BinaryUnits:
+		return scaleUnits(b, 1024, binarySuffixes)
+	default:
+		return strconv.FormatInt(client.ToMB(b), 10)
+	}
+}
+
+// Age renders the given duration in a human readable form.
+func (UnitConverter) Age(d time.Duration) string {
+	return duration.HumanDuration(d)
+}
+
+func scaleUnits(v int64, base float64, suffixes []string) string {
+	f, i := float64(v), 0
+	for ; math.Abs(f) >= base && i < len(suffixes)-1; i++ {
+		f /= base
+	}
+
+	return strconv.FormatFloat(math.Round(f*10)/10, 'f', -1, 64) + suffixes[i]
+}
+
+var units = struct {
+	sync.RWMutex
+	conv UnitConverter
+}{
+	conv: UnitConverter{units: RawUnits},
+}
+
+// SetUnits sets the units convention used by all renderers.
+// Invalid conventions fall back to raw units and are reported.
+func SetUnits(s string) error {
+	c, err := NewUnitConverter(s)
+
+	units.Lock()
+	defer units.Unlock()
+	units.conv = c
+
+	return err
+}
+
+// Units returns the current unit converter.
+func Units() UnitConverter {
+	units.RLock()
+	defer units.RUnlock()
+
+	return units.conv
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUnitConverter(t *testing.T) {
+	uu := map[string]struct {
+		units, e string
+		err      bool
+	}{
+		"blank":   {e: render.RawUnits},
+		"raw":     {units: render.RawUnits, e: render.RawUnits},
+		"metric":  {units: render.MetricUnits, e: render.MetricUnits},
+		"binary":  {units: render.BinaryUnits, e: render.BinaryUnits},
+		"invalid": {units: "imperial", e: render.RawUnits, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := render.NewUnitConverter(u.units)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, c.Units())
+		})
+	}
+}
+
+func TestUnitConverter(t *testing.T) {
+	uu := map[string]struct {
+		units    string
+		mc, b    int64
+		cpu, mem string
+	}{
+		"raw":         {units: render.RawUnits, mc: 250, b: 512 * 1024 * 1024, cpu: "250", mem: "512"},
+		"metric":      {units: render.MetricUnits, mc: 250, b: 1_500_000_000, cpu: "0.25", mem: "1.5G"},
+		"metric-kb":   {units: render.MetricUnits, mc: 2000, b: 1500, cpu: "2", mem: "1.5k"},
+		"binary":      {units: render.BinaryUnits, mc: 1500, b: 1536 * 1024 * 1024, cpu: "1.5", mem: "1.5Gi"},
+		"binary-mib":  {units: render.BinaryUnits, mc: 1, b: 512 * 1024 * 1024, cpu: "0.001", mem: "512Mi"},
+		"binary-byte": {units: render.BinaryUnits, mc: 10, b: 100, cpu: "0.01", mem: "100"},
+		"zero":        {units: render.BinaryUnits, cpu: "0", mem: "0"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := render.NewUnitConverter(u.units)
+			require.NoError(t, err)
+			assert.Equal(t, u.cpu, c.CPU(u.mc))
+			assert.Equal(t, u.mem, c.Memory(u.b))
+			assert.Equal(t, "90m", c.Age(90*time.Minute))
+		})
+	}
+}
+
+func TestSetUnits(t *testing.T) {
+	defer func() {
+		_ = render.SetUnits("")
+	}()
+	require.NoError(t, render.SetUnits(render.BinaryUnits))
+	pom := render.NodeWithMetrics{
+		Raw: load(t, "no"),
+		MX:  makeNodeMX("n1", "10m", "20Mi"),
+	}
+
+	var no render.Node
+	r := model1.NewRow(14)
+	require.NoError(t, no.Render(&pom, "", &r))
+	assert.Equal(t, model1.Fields{"0.01", "20Mi"}, r.Fields[15:17])
+
+	require.Error(t, render.SetUnits("imperial"))
+	assert.Equal(t, render.RawUnits, render.Units().Units())
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render
+
+// TruncateColumn shortens a column value to at most width display cells,
+// marking elided values with an ellipsis. A non positive width leaves the value as is.
+func TruncateColumn(value string, width int) string {
+	if width <= 0 {
+		return value
+	}
+
+	return Truncate(value, width)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateColumn(t *testing.T) {
+	uu := map[string]struct {
+		value string
+		width int
+		e     string
+	}{
+		"empty": {},
+		"fits": {
+			value: "fred",
+			width: 4,
+			e:     "fred",
+		},
+		"truncated": {
+			value: "fred-blee-duh",
+			width: 6,
+			e:     "fred-…",
+		},
+		"no-limit": {
+			value: "fred-blee-duh",
+			e:     "fred-blee-duh",
+		},
+		"negative": {
+			value: "fred-blee-duh",
+			width: -1,
+			e:     "fred-blee-duh",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.TruncateColumn(u.value, u.width))
+		})
+	}
+}
@@ -218,4 +218,22 @@ const (
 
 	// JQExp tracks a jq expression logger key.
 	JQExp = "jq-exp"
+
+	// Ready tracks a readiness logger key.
+	Ready = "ready"
+
+	// Timestamp tracks a timestamp logger key.
+	Timestamp = "timestamp"
+
+	// Host tracks a host logger key.
+	Host = "host"
+
+	// Health tracks a health logger key.
+	Health = "health"
+
+	// SecretData tracks a secret data logger key. Values are always redacted.
+	SecretData = "secret-data"
+
+	// Kernel tracks a kernel version logger key.
+	Kernel = "kernel"
 )
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package slogs
+
+import (
+	"context"
+	"log/slog"
+)
+
+const (
+	// Redacted tracks the value logged in place of sensitive data.
+	Redacted = "[REDACTED]"
+
+	secretsGVR = "v1/secrets"
+)
+
+// safeSecretKeys tracks keys that may be logged as-is for secret related entries.
+var safeSecretKeys = map[string]struct{}{
+	Subsys:    {},
+	GVR:       {},
+	FQN:       {},
+	Namespace: {},
+	ResName:   {},
+	Path:      {},
+}
+
+// RedactHandler scrubs sensitive values prior to handing records to the
+// underlying handler. Secret data attributes are always redacted. Entries
+// related to secrets only retain attributes identifying the resource.
+type RedactHandler struct {
+	slog.Handler
+
+	secret bool
+}
+
+// NewRedactHandler returns a new redacting handler.
+func NewRedactHandler(h slog.Handler) *RedactHandler {
+	return &RedactHandler{Handler: h}
+}
+
+// Handle redacts sensitive record attributes.
+func (h *RedactHandler) Handle(ctx context.Context, r slog.Record) error {
+	secret := h.secret
+	r.Attrs(func(a slog.Attr) bool {
+		secret = secret || isSecretAttr(a)
+		return !secret
+	})
+
+	rr := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+	r.Attrs(func(a slog.Attr) bool {
+		rr.AddAttrs(redact(a, secret))
+		return true
+	})
+
+	return h.Handler.Handle(ctx, rr)
+}
+
+// WithAttrs returns a new handler with the given redacted attributes.
+func (h *RedactHandler) WithAttrs(aa []slog.Attr) slog.Handler {
+	secret := h.secret
+	for _, a := range aa {
+		secret = secret || isSecretAttr(a)
+	}
+	ra := make([]slog.Attr, 0, len(aa))
+	for _, a := range aa {
+		ra = append(ra, redact(a, secret))
+	}
+
+	return &RedactHandler{Handler: h.Handler.WithAttrs(ra), secret: secret}
+}
+
+// WithGroup returns a new handler with the given group.
+func (h *RedactHandler) WithGroup(n string) slog.Handler {
+	return &RedactHandler{Handler: h.Handler.WithGroup(n), secret: h.secret}
+}
+
+func isSecretAttr(a slog.Attr) bool {
+	return a.Key == GVR && a.Value.String() == secretsGVR
+}
+
+func redact(a slog.Attr, secret bool) slog.Attr {
+	if a.Value.Kind() == slog.KindGroup {
+		gg := a.Value.Group()
+		aa := make([]any, 0, len(gg))
+		for _, g := range gg {
+			aa = append(aa, redact(g, secret))
+		}
+		return slog.Group(a.Key, aa...)
+	}
+	if a.Key == SecretData {
+		return slog.String(a.Key, Redacted)
+	}
+	if _, ok := safeSecretKeys[a.Key]; secret && !ok {
+		return slog.String(a.Key, Redacted)
+	}
+
+	return a
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package slogs_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactHandler(t *testing.T) {
+	uu := map[string]struct {
+		log      func(*slog.Logger)
+		has, not []string
+	}{
+		"plain": {
+			log: func(l *slog.Logger) {
+				l.Info("blee", slogs.GVR, "v1/pods", slogs.Message, "duh")
+			},
+			has: []string{"gvr=v1/pods", "message=duh"},
+		},
+		"secret-data": {
+			log: func(l *slog.Logger) {
+				l.Info("blee", slogs.SecretData, "s3cr3t")
+			},
+			has: []string{"secret-data=" + slogs.Redacted},
+			not: []string{"s3cr3t"},
+		},
+		"secret-entry": {
+			log: func(l *slog.Logger) {
+				l.Info("blee", slogs.Message, "s3cr3t", slogs.GVR, "v1/secrets", slogs.FQN, "fred/blee")
+			},
+			has: []string{"gvr=v1/secrets", "fqn=fred/blee", "message=" + slogs.Redacted},
+			not: []string{"s3cr3t"},
+		},
+		"secret-logger": {
+			log: func(l *slog.Logger) {
+				l.With(slogs.GVR, "v1/secrets").WithGroup("g").Info("blee", slogs.Key, "s3cr3t")
+			},
+			not: []string{"s3cr3t"},
+		},
+		"group": {
+			log: func(l *slog.Logger) {
+				l.Info("blee", slog.Group("g", slogs.SecretData, "s3cr3t"))
+			},
+			not: []string{"s3cr3t"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			u.log(slog.New(slogs.NewRedactHandler(slog.NewTextHandler(&buff, nil))))
+			for _, s := range u.has {
+				assert.Contains(t, buff.String(), s)
+			}
+			for _, s := range u.not {
+				assert.NotContains(t, buff.String(), s)
+			}
+		})
+	}
+}
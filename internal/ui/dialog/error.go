@@ -15,6 +15,15 @@ import (
 
 // ShowError pops an error dialog.
 func ShowError(styles *config.Dialog, pages *ui.Pages, msg string) {
+	showMessage(styles, pages, "<error>", cowTalk(msg), tcell.ColorOrangeRed)
+}
+
+// ShowWarning pops a warning dialog.
+func ShowWarning(styles *config.Dialog, pages *ui.Pages, title, msg string) {
+	showMessage(styles, pages, "<"+title+">", msg, tcell.ColorDarkOrange)
+}
+
+func showMessage(styles *config.Dialog, pages *ui.Pages, title, msg string, c tcell.Color) {
 	f := tview.NewForm()
 	f.SetItemPadding(0)
 	f.SetButtonsAlign(tview.AlignCenter).
@@ -30,9 +39,9 @@ func ShowError(styles *config.Dialog, pages *ui.Pages, msg string) {
 		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
 	}
 	f.SetFocus(0)
-	modal := tview.NewModalForm("<error>", f)
-	modal.SetText(cowTalk(msg))
-	modal.SetTextColor(tcell.ColorOrangeRed)
+	modal := tview.NewModalForm(title, f)
+	modal.SetText(msg)
+	modal.SetTextColor(c)
 	modal.SetDoneFunc(func(int, string) {
 		dismiss(pages)
 	})
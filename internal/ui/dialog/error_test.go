@@ -22,3 +22,14 @@ func TestErrorDialog(t *testing.T) {
 	dismiss(p)
 	assert.Nil(t, p.GetPrimitive(dialogKey))
 }
+
+func TestWarningDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowWarning(new(config.Dialog), p, "Config Warnings", "Yo")
+
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
@@ -48,20 +49,34 @@ func (s *StatusIndicator) StylesChanged(styles *config.Styles) {
 	s.SetTextColor(styles.FgColor())
 }
 
-const statusIndicatorFmt = "[orange::b]K9s [aqua::]%s [white::]%s:%s:%s [lawngreen::]%s[white::]::[darkturquoise::]%s"
+const statusIndicatorFmt = "[orange::b]K9s [aqua::]%s [white::]%s:%s:%s%s [lawngreen::]%s[white::]::[darkturquoise::]%s"
+
+// poolIndicator returns the api server connection latency.
+func poolIndicator(h client.PoolHealth) string {
+	if h.Samples == 0 {
+		return ""
+	}
+	color := "white"
+	if h.Degraded() {
+		color = "orangered"
+	}
+
+	return fmt.Sprintf(" [%s::]%s", color, h.Latency.Round(time.Millisecond))
+}
 
 // ClusterInfoUpdated notifies the cluster meta was updated.
 func (s *StatusIndicator) ClusterInfoUpdated(data *model.ClusterMeta) {
 	s.app.QueueUpdateDraw(func() {
-		s.SetPermanent(fmt.Sprintf(
+		s.SetPermanent(s.withROBadge(fmt.Sprintf(
 			statusIndicatorFmt,
 			data.K9sVer,
 			data.Context,
 			data.Cluster,
 			data.K8sVer,
+			poolIndicator(data.Pool),
 			render.PrintPerc(data.Cpu),
 			render.PrintPerc(data.Mem),
-		))
+		)))
 	})
 }
 
@@ -71,18 +86,31 @@ func (s *StatusIndicator) ClusterInfoChanged(prev, cur *model.ClusterMeta) {
 		return
 	}
 	s.app.QueueUpdateDraw(func() {
-		s.SetPermanent(fmt.Sprintf(
+		s.SetPermanent(s.withROBadge(fmt.Sprintf(
 			statusIndicatorFmt,
 			cur.K9sVer,
 			cur.Context,
 			cur.Cluster,
 			cur.K8sVer,
+			poolIndicator(cur.Pool),
 			AsPercDelta(prev.Cpu, cur.Cpu),
 			AsPercDelta(prev.Cpu, cur.Mem),
-		))
+		)))
 	})
 }
 
+// withROBadge flags the status with a badge when the session is read-only.
+func (s *StatusIndicator) withROBadge(info string) string {
+	if s.app.Config == nil {
+		return info
+	}
+	if b := ROBadge(s.app.Config.IsReadOnly()); b != "" {
+		return info + " " + b
+	}
+
+	return info
+}
+
 // SetPermanent sets permanent title to be reset to after updates.
 func (s *StatusIndicator) SetPermanent(info string) {
 	s.permanent = info
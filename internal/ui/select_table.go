@@ -12,11 +12,12 @@ import (
 type SelectTable struct {
 	*tview.Table
 
-	model      Tabular
-	selectedFn func(string) string
-	marks      map[string]struct{}
-	selFgColor tcell.Color
-	selBgColor tcell.Color
+	model         Tabular
+	selectedFn    func(string) string
+	selectedRowFn SelectedRowFunc
+	marks         map[string]struct{}
+	selFgColor    tcell.Color
+	selBgColor    tcell.Color
 }
 
 // SetModel sets the table model.
@@ -96,6 +97,11 @@ func (s *SelectTable) SetSelectedFn(f func(string) string) {
 	s.selectedFn = f
 }
 
+// SetSelectedRowFn sets a callback notified when the selected row changes.
+func (s *SelectTable) SetSelectedRowFn(f SelectedRowFunc) {
+	s.selectedRowFn = f
+}
+
 // GetSelectedRowIndex fetch the currently selected row index.
 func (s *SelectTable) GetSelectedRowIndex() int {
 	r, _ := s.GetSelection()
@@ -129,6 +135,9 @@ func (s *SelectTable) selectionChanged(r, c int) {
 			tcell.StyleDefault.Foreground(s.selFgColor).
 				Background(cell.Color).Attributes(tcell.AttrBold))
 	}
+	if s.selectedRowFn != nil {
+		s.selectedRowFn(r)
+	}
 }
 
 // ClearMarks delete all marked items.
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"sync"
 
 	"github.com/derailed/k9s/internal"
@@ -30,6 +31,9 @@ type (
 	// DecorateFunc represents a row decorator.
 	DecorateFunc func(*model1.TableData)
 
+	// CellColorerFunc overrides a given row cell color when ok.
+	CellColorerFunc func(id string, col int) (color tcell.Color, ok bool)
+
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
 )
@@ -39,23 +43,31 @@ type Table struct {
 	*SelectTable
 	gvr         *client.GVR
 	sortCol     model1.SortColumn
+	defaultSort model1.SortColumn
+	subSortCols []model1.SortColumn
 	manualSort  bool
 	Path        string
 	Extras      string
+	Warning     string
 	actions     *KeyActions
 	cmdBuff     *model.FishBuff
 	styles      *config.Styles
 	viewSetting *config.ViewSetting
 	colorerFn   model1.ColorerFunc
+	fuzzy       *model.FuzzyMatcher
+	cellColorFn CellColorerFunc
 	decorateFn  DecorateFunc
 	wide        bool
 	toast       bool
 	hasMetrics  bool
+	colGroups   map[model1.ColGroup]bool
 	ctx         context.Context
 	mx          sync.RWMutex
 	readOnly    bool
 	noIcon      bool
 	fullGVR     bool
+	pads        MaxyPad
+	colWidths   map[string]int
 }
 
 // NewTable returns a new table view.
@@ -66,11 +78,12 @@ func NewTable(gvr *client.GVR) *Table {
 			model: model.NewTable(gvr),
 			marks: make(map[string]struct{}),
 		},
-		ctx:     context.Background(),
-		gvr:     gvr,
-		actions: NewKeyActions(),
-		cmdBuff: model.NewFishBuff('/', model.FilterBuffer),
-		sortCol: model1.SortColumn{ASC: true},
+		ctx:         context.Background(),
+		gvr:         gvr,
+		actions:     NewKeyActions(),
+		cmdBuff:     model.NewFishBuff('/', model.FilterBuffer),
+		sortCol:     model1.SortColumn{ASC: true},
+		defaultSort: model1.SortColumn{ASC: true},
 	}
 }
 
@@ -90,6 +103,53 @@ func (t *Table) SetNoIcon(b bool) {
 	t.noIcon = b
 }
 
+// SetColumnWidths sets the max column widths keyed by column names.
+func (t *Table) SetColumnWidths(ww map[string]int) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.colWidths = ww
+}
+
+// ColumnWidths returns a copy of the max column widths keyed by column names.
+func (t *Table) ColumnWidths() map[string]int {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return maps.Clone(t.colWidths)
+}
+
+func (t *Table) columnWidth(col string) (int, bool) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	w, ok := t.colWidths[col]
+
+	return w, ok
+}
+
+// TruncatedFields returns the given row columns exceeding their max width
+// along with their full value.
+func (t *Table) TruncatedFields(id string) []string {
+	data := t.GetModel().Peek()
+	re, ok := data.FindRow(id)
+	if !ok {
+		return nil
+	}
+	h := data.Header()
+	var ff []string
+	for c, field := range re.Row.Fields {
+		if c >= len(h) || !t.isColVisible(h[c]) {
+			continue
+		}
+		if w, ok := t.columnWidth(h[c].Name); ok && render.TruncateColumn(field, w) != field {
+			ff = append(ff, h[c].Name+": "+field)
+		}
+	}
+
+	return ff
+}
+
 // SetReadOnly toggles read-only mode.
 func (t *Table) SetReadOnly(ro bool) {
 	t.mx.Lock()
@@ -119,6 +179,41 @@ func (t *Table) getSortCol() model1.SortColumn {
 	return t.sortCol
 }
 
+// getSortCols returns the primary sort column followed by any secondary sort keys.
+// Secondary keys only apply to manual sorts.
+func (t *Table) getSortCols() []model1.SortColumn {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	cc := []model1.SortColumn{t.sortCol}
+	if t.manualSort {
+		cc = append(cc, t.subSortCols...)
+	}
+
+	return cc
+}
+
+func (t *Table) setSubSortCols(cc []model1.SortColumn) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.subSortCols = cc
+}
+
+// addSubSortCol adds a secondary sort key or flips its order if already present.
+func (t *Table) addSubSortCol(name string, asc bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	for i := range t.subSortCols {
+		if t.subSortCols[i].Name == name {
+			t.subSortCols[i].ASC = !t.subSortCols[i].ASC
+			return
+		}
+	}
+	t.subSortCols = append(t.subSortCols, model1.SortColumn{Name: name, ASC: asc})
+}
+
 func (t *Table) setMSort(b bool) {
 	t.mx.Lock()
 	defer t.mx.Unlock()
@@ -278,14 +373,41 @@ func (t *Table) SetDecorateFn(f DecorateFunc) {
 	t.decorateFn = f
 }
 
+// SetCellColorerFn specifies a cell colorer overriding the row colors.
+func (t *Table) SetCellColorerFn(f CellColorerFunc) {
+	t.cellColorFn = f
+}
+
+// SetColGroup toggles the visibility of the given column group.
+func (t *Table) SetColGroup(g model1.ColGroup, b bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.colGroups == nil {
+		t.colGroups = make(map[model1.ColGroup]bool)
+	}
+	t.colGroups[g] = b
+}
+
+func (t *Table) colGroupVisible(g model1.ColGroup) bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.colGroups[g]
+}
+
 // SetColorerFn specifies the default colorer.
 func (t *Table) SetColorerFn(f model1.ColorerFunc) {
 	t.colorerFn = f
 }
 
-// SetSortCol sets in sort column index and order.
+// SetSortCol sets the view default sort column and order.
 func (t *Table) SetSortCol(name string, asc bool) {
-	t.setSortCol(model1.SortColumn{Name: name, ASC: asc})
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.sortCol = model1.SortColumn{Name: name, ASC: asc}
+	t.defaultSort = t.sortCol
 }
 
 // Update table content.
@@ -328,16 +450,7 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 
 	var col int
 	for _, h := range cdata.Header() {
-		if h.Hide || (!t.wide && h.Wide) {
-			continue
-		}
-		if h.Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
-			continue
-		}
-		if h.MX && !t.hasMetrics {
-			continue
-		}
-		if h.VS && vul.ImgScanner == nil {
+		if !t.isColVisible(h) {
 			continue
 		}
 
@@ -347,17 +460,33 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 		c.SetTextColor(fg)
 		col++
 	}
-	cdata.Sort(t.getSortCol())
+	if cc := t.getSortCols(); len(cc) > 1 {
+		cdata.SortByColumns(cc)
+	} else {
+		cdata.Sort(t.getSortCol())
+	}
 
 	pads := make(MaxyPad, cdata.HeaderCount())
 	ComputeMaxColumns(pads, t.getSortCol().Name, cdata)
+	t.mx.RLock()
+	capPads(pads, cdata.Header(), t.colWidths)
+	t.mx.RUnlock()
+	t.pads = pads
+	gCol, prev := groupColIndex(cdata.Header(), t.getSortCol().Name), ""
 	cdata.RowsRange(func(row int, re model1.RowEvent) bool {
 		ore, ok := data.FindRow(re.Row.ID)
 		if !ok {
 			slog.Error("Unable to find original row event", slogs.RowID, re.Row.ID)
 			return true
 		}
-		t.buildRow(row+1, re, ore, cdata.Header(), pads)
+		blankCol := -1
+		if gCol >= 0 && gCol < len(re.Row.Fields) {
+			if row > 0 && re.Row.Fields[gCol] == prev {
+				blankCol = gCol
+			}
+			prev = re.Row.Fields[gCol]
+		}
+		t.buildRow(row+1, re, ore, cdata.Header(), pads, blankCol)
 
 		return true
 	})
@@ -366,13 +495,77 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 	t.UpdateTitle()
 }
 
-func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads MaxyPad) {
+// UpdateRow re-renders the displayed row matching the given row event in place.
+// Returns false if the row is not currently displayed.
+func (t *Table) UpdateRow(re model1.RowEvent, h model1.Header) bool {
+	pads := t.pads
+	if len(pads) < len(h) {
+		pads = make(MaxyPad, len(h))
+	}
+	for r := 1; r < t.GetRowCount(); r++ {
+		if id, ok := t.GetRowID(r); ok && id == re.Row.ID {
+			t.buildRow(r, re, re, h, pads, -1)
+			return true
+		}
+	}
+
+	return false
+}
+
+// VisibleColumns returns the indices of the given header columns currently displayed.
+func (t *Table) VisibleColumns(h model1.Header) []int {
+	cols := make([]int, 0, len(h))
+	for i, c := range h {
+		if t.isColVisible(c) {
+			cols = append(cols, i)
+		}
+	}
+
+	return cols
+}
+
+// RowIDs returns the displayed row ids in display order.
+func (t *Table) RowIDs() []string {
+	ids := make([]string, 0, t.GetRowCount())
+	for r := 1; r < t.GetRowCount(); r++ {
+		if id, ok := t.GetRowID(r); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (t *Table) isColVisible(h model1.HeaderColumn) bool {
+	switch {
+	case h.Hide || (!t.wide && h.Wide):
+		return false
+	case h.Name == "NAMESPACE" && !t.GetModel().ClusterWide():
+		return false
+	case h.MX && !t.hasMetrics:
+		return false
+	case h.ColGroup != "" && !t.colGroupVisible(h.ColGroup):
+		return false
+	case h.VS && vul.ImgScanner == nil:
+		return false
+	default:
+		return true
+	}
+}
+
+// buildRow renders a table row. The blankCol cell, if any, is left empty
+// to visually group rows sharing the same value.
+func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads MaxyPad, blankCol int) {
 	color := model1.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
 
 	marked := t.IsMarked(re.Row.ID)
+	nameCol, fuzzy := -1, t.getFuzzy()
+	if fuzzy != nil {
+		nameCol, _ = h.IndexOf("NAME", true)
+	}
 	var col int
 	ns := t.GetModel().GetNamespace()
 	for c, field := range re.Row.Fields {
@@ -384,18 +577,11 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			)
 			continue
 		}
-		if h[c].Hide || (!t.wide && h[c].Wide) {
+		if !t.isColVisible(h[c]) {
 			continue
 		}
-
-		if h[c].Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
-			continue
-		}
-		if h[c].MX && !t.hasMetrics {
-			continue
-		}
-		if h[c].VS && vul.ImgScanner == nil {
-			continue
+		if w, ok := t.columnWidth(h[c].Name); ok {
+			field = render.TruncateColumn(field, w)
 		}
 
 		if !re.Deltas.IsBlank() && !h.IsTimeCol(c) {
@@ -409,17 +595,28 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			field += Deltas(old, field)
 		}
 
+		if c == blankCol {
+			field = ""
+		}
 		if h[c].Decorator != nil {
 			field = h[c].Decorator(field)
 		}
 		if h[c].Align == tview.AlignLeft {
 			field = formatCell(field, pads[c])
 		}
+		if c == nameCol {
+			field = fuzzy.Highlight(field, t.styles.Frame().Title.FilterColor.String())
+		}
 
 		cell := tview.NewTableCell(field)
 		cell.SetExpansion(1)
 		cell.SetAlign(h[c].Align)
 		fgColor := color(ns, h, &re)
+		if t.cellColorFn != nil {
+			if cc, ok := t.cellColorFn(re.Row.ID, c); ok {
+				fgColor = cc
+			}
+		}
 		cell.SetTextColor(fgColor)
 		if marked {
 			cell.SetTextColor(t.styles.Table().MarkColor.Color())
@@ -448,6 +645,36 @@ func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tce
 	}
 }
 
+// AddSortColCmd sorts by a column. Once a column was manually sorted on, other
+// columns are added as secondary sort keys instead.
+func (t *Table) AddSortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	sortCmd := t.SortColCmd(name, asc)
+
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !t.getMSort() {
+			t.setSubSortCols(nil)
+			return sortCmd(evt)
+		}
+		if t.getSortCol().Name == name {
+			return sortCmd(evt)
+		}
+		t.addSubSortCol(name, asc)
+		t.Refresh()
+
+		return nil
+	}
+}
+
+// ResetSortCmd clears out all sort keys and reverts to the default sort.
+func (t *Table) ResetSortCmd(*tcell.EventKey) *tcell.EventKey {
+	t.mx.Lock()
+	t.sortCol, t.subSortCols, t.manualSort = t.defaultSort, nil, false
+	t.mx.Unlock()
+	t.Refresh()
+
+	return nil
+}
+
 // SortInvertCmd reverses sorting order.
 func (t *Table) SortInvertCmd(*tcell.EventKey) *tcell.EventKey {
 	t.toggleSortCol()
@@ -499,10 +726,14 @@ func (t *Table) NameColIndex() int {
 
 // AddHeaderCell configures a table cell header.
 func (t *Table) AddHeaderCell(col int, h model1.HeaderColumn) {
-	sc := t.getSortCol()
-	sortCol := h.Name == sc.Name
+	cc := t.getSortCols()
+	sc, rank := sortColRank(cc, h.Name)
 	styles := t.styles.Table()
-	c := tview.NewTableCell(sortIndicator(sortCol, sc.ASC, &styles, h.Name))
+	name := sortIndicator(rank > 0, sc.ASC, &styles, h.Name)
+	if len(cc) > 1 {
+		name += sortRank(rank)
+	}
+	c := tview.NewTableCell(name)
 	c.SetExpansion(1)
 	c.SetSelectable(false)
 	c.SetAlign(h.Align)
@@ -510,10 +741,32 @@ func (t *Table) AddHeaderCell(col int, h model1.HeaderColumn) {
 }
 
 func (t *Table) filtered(data *model1.TableData) *model1.TableData {
-	return data.Filter(model1.FilterOpts{
+	opts := model1.FilterOpts{
 		Toast:  t.toast,
 		Filter: t.cmdBuff.GetText(),
-	})
+	}
+	var fuzzy *model.FuzzyMatcher
+	if q, ok := model.FuzzyQuery(opts.Filter); ok {
+		fuzzy = model.NewFuzzyMatcher(q)
+		opts.NameMatcher = fuzzy.Matches
+	}
+	t.setFuzzy(fuzzy)
+
+	return data.Filter(opts)
+}
+
+func (t *Table) setFuzzy(m *model.FuzzyMatcher) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.fuzzy = m
+}
+
+func (t *Table) getFuzzy() *model.FuzzyMatcher {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.fuzzy
 }
 
 // CmdBuff returns the associated command buffer.
@@ -573,6 +826,10 @@ func (t *Table) styleTitle() string {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, resource, ns, render.AsThousands(rc)), &styles)
 	}
 
+	if t.Warning != "" {
+		title += SkinTitle(fmt.Sprintf(WarnFmt, t.Warning), &styles)
+	}
+
 	buff := t.cmdBuff.GetText()
 	if internal.IsLabelSelector(buff) {
 		buff = render.Truncate(TrimLabelSelector(buff), maxTruncate)
@@ -598,3 +855,12 @@ func ROIndicator(ro, noIC bool) string {
 		return unlockedIC
 	}
 }
+
+// ROBadge returns the read-only session badge.
+func ROBadge(ro bool) string {
+	if !ro {
+		return ""
+	}
+
+	return roBadge
+}
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/slogs"
 )
 
@@ -22,6 +24,9 @@ const (
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
+	// WarnFmt represents a view title warning.
+	WarnFmt = "<[orange:bg:b]⚠ %s[fg:bg:-]> "
+
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = " [fg:bg:b]%s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
 
@@ -38,6 +43,9 @@ const (
 	NoNSFmat = "%s-%d.csv"
 )
 
+// superscripts tracks superscript digits used to rank sort keys.
+var superscripts = []rune("⁰¹²³⁴⁵⁶⁷⁸⁹")
+
 func mustExtractStyles(ctx context.Context) *config.Styles {
 	styles, ok := ctx.Value(internal.KeyStyles).(*config.Styles)
 	if !ok {
@@ -94,6 +102,31 @@ func sortIndicator(sort, asc bool, style *config.Table, name string) string {
 	return fmt.Sprintf("%s[%s::b]%s[::]", name, style.Header.SorterColor, order)
 }
 
+// sortColRank returns a column sort key and its 1 based rank or 0 if not sorted on.
+func sortColRank(cc []model1.SortColumn, name string) (model1.SortColumn, int) {
+	for i, c := range cc {
+		if c.Name == name {
+			return c, i + 1
+		}
+	}
+
+	return model1.SortColumn{}, 0
+}
+
+// sortRank returns a sort key rank as superscript digits.
+func sortRank(rank int) string {
+	if rank <= 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, d := range strconv.Itoa(rank) {
+		b.WriteRune(superscripts[d-'0'])
+	}
+
+	return b.String()
+}
+
 func formatCell(field string, padding int) string {
 	if IsASCII(field) {
 		return Pad(field, padding)
@@ -101,3 +134,27 @@ func formatCell(field string, padding int) string {
 
 	return field
 }
+
+// capPads caps columns padding to their max widths if any.
+func capPads(pads MaxyPad, h model1.Header, ww map[string]int) {
+	for i, c := range h {
+		w, ok := ww[c.Name]
+		if !ok || i >= len(pads) {
+			continue
+		}
+		if limit := max(w, len(c.Name)) + 1; pads[i] > limit {
+			pads[i] = limit
+		}
+	}
+}
+
+// groupColIndex returns the index of the sorted column when it groups identical values
+// or -1 otherwise.
+func groupColIndex(h model1.Header, sortCol string) int {
+	idx, ok := h.IndexOf(sortCol, true)
+	if !ok || !h[idx].Group {
+		return -1
+	}
+
+	return idx
+}
@@ -6,6 +6,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
@@ -48,3 +49,70 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupColIndex(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "OS-IMAGE", Attrs: model1.Attrs{Wide: true, Group: true}},
+		model1.HeaderColumn{Name: "KERNEL", Attrs: model1.Attrs{Wide: true}},
+	}
+
+	uu := map[string]struct {
+		col string
+		e   int
+	}{
+		"grouped":   {col: "OS-IMAGE", e: 1},
+		"ungrouped": {col: "KERNEL", e: -1},
+		"missing":   {col: "BLEE", e: -1},
+		"blank":     {e: -1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, groupColIndex(h, u.col))
+		})
+	}
+}
+
+func TestCapPads(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAMESPACE"},
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "IP"},
+	}
+	pads := MaxyPad{20, 40, 16}
+	capPads(pads, h, map[string]int{"NAMESPACE": 5, "NAME": 30})
+
+	assert.Equal(t, MaxyPad{10, 31, 16}, pads)
+}
+
+func TestSortRank(t *testing.T) {
+	uu := map[string]struct {
+		rank int
+		e    string
+	}{
+		"none":   {},
+		"first":  {rank: 1, e: "¹"},
+		"second": {rank: 2, e: "²"},
+		"tenth":  {rank: 10, e: "¹⁰"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sortRank(u.rank))
+		})
+	}
+}
+
+func TestSortColRank(t *testing.T) {
+	cc := []model1.SortColumn{{Name: "ROLE", ASC: true}, {Name: "CPU"}}
+
+	sc, rank := sortColRank(cc, "CPU")
+	assert.Equal(t, 2, rank)
+	assert.False(t, sc.ASC)
+
+	_, rank = sortColRank(cc, "MEM")
+	assert.Equal(t, 0, rank)
+}
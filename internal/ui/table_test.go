@@ -5,6 +5,8 @@ package ui_test
 
 import (
 	"context"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -39,6 +41,81 @@ func TestTableUpdate(t *testing.T) {
 	assert.Equal(t, data.HeaderCount(), v.GetColumnCount())
 }
 
+func TestTableVisibleColumns(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+
+	h := model1.Header{
+		model1.HeaderColumn{Name: "A"},
+		model1.HeaderColumn{Name: "B", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "C", Attrs: model1.Attrs{MX: true}},
+		model1.HeaderColumn{Name: "D", Attrs: model1.Attrs{Hide: true}},
+		model1.HeaderColumn{Name: "E"},
+	}
+	assert.Equal(t, []int{0, 4}, v.VisibleColumns(h))
+
+	v.ToggleWide()
+	assert.Equal(t, []int{0, 1, 4}, v.VisibleColumns(h))
+}
+
+func TestTableColGroup(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+
+	h := model1.Header{
+		model1.HeaderColumn{Name: "A"},
+		model1.HeaderColumn{Name: "GPU", Attrs: model1.Attrs{ColGroup: model1.GPUGroup}},
+	}
+	assert.Equal(t, []int{0}, v.VisibleColumns(h))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range 100 {
+			v.SetColGroup(model1.GPUGroup, i%2 == 0)
+		}
+	}()
+	for range 100 {
+		v.VisibleColumns(h)
+	}
+	wg.Wait()
+
+	v.SetColGroup(model1.GPUGroup, true)
+	assert.Equal(t, []int{0, 1}, v.VisibleColumns(h))
+}
+
+func TestTableRowIDs(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+
+	data := makeTableData()
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	assert.Equal(t, []string{"r1", "r2"}, v.RowIDs())
+}
+
+func TestTableUpdateRow(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+
+	data := makeTableData()
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	re := model1.RowEvent{Row: model1.Row{ID: "r2", Fields: model1.Fields{"blee", "duh", "zap"}}}
+	assert.True(t, v.UpdateRow(re, data.Header()))
+	assert.Equal(t, "zap", strings.TrimSpace(v.GetCell(2, 2).Text))
+	assert.Equal(t, "fred", strings.TrimSpace(v.GetCell(1, 2).Text))
+
+	re.Row.ID = "r3"
+	assert.False(t, v.UpdateRow(re, data.Header()))
+	assert.Equal(t, data.RowCount()+1, v.GetRowCount())
+}
+
 func TestTableSelection(t *testing.T) {
 	v := ui.NewTable(client.NewGVR("fred"))
 	v.Init(makeContext())
@@ -63,6 +140,65 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableAddSortCol(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+
+	v.AddSortColCmd("B", true)(nil)
+	v.AddSortColCmd("C", false)(nil)
+	assert.Equal(t, "A", strings.TrimSpace(v.GetCell(0, 0).Text))
+	assert.Contains(t, v.GetCell(0, 1).Text, "¹")
+	assert.Contains(t, v.GetCell(0, 2).Text, "²")
+	assert.Equal(t, []string{"r2", "r1"}, v.RowIDs())
+
+	v.ResetSortCmd(nil)
+	assert.NotContains(t, v.GetCell(0, 2).Text, "²")
+	assert.Equal(t, []string{"r1", "r2"}, v.RowIDs())
+}
+
+func TestTableResetSort(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	v.SetSortCol("C", false)
+	v.Refresh()
+	assert.Equal(t, []string{"r2", "r1"}, v.RowIDs())
+
+	v.SortColCmd("A", true)(nil)
+	v.AddSortColCmd("C", true)(nil)
+	assert.Equal(t, []string{"r1", "r2"}, v.RowIDs())
+
+	v.ResetSortCmd(nil)
+	assert.Equal(t, []string{"r2", "r1"}, v.RowIDs())
+	assert.NotContains(t, v.GetCell(0, 2).Text, "²")
+}
+
+func TestTableFilter(t *testing.T) {
+	uu := map[string]struct {
+		q string
+		e []string
+	}{
+		"none":  {e: []string{"r1", "r2"}},
+		"fuzzy": {q: "~r2", e: []string{"r2"}},
+		"regex": {q: "zorg", e: []string{"r2"}},
+		"miss":  {q: "~zorg", e: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.SetModel(&mockModel{})
+			v.CmdBuff().SetText(u.q, "")
+			v.Refresh()
+
+			assert.Equal(t, u.e, v.RowIDs())
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -133,3 +269,8 @@ func makeContext() context.Context {
 
 	return ctx
 }
+
+func TestROBadge(t *testing.T) {
+	assert.Empty(t, ui.ROBadge(false))
+	assert.Equal(t, "[red::b]READONLY[-::-]", ui.ROBadge(true))
+}
@@ -18,6 +18,7 @@ import (
 const (
 	unlockedIC = "🖍"
 	lockedIC   = "🔑"
+	roBadge    = "[red::b]READONLY[-::-]"
 )
 
 // Namespaceable tracks namespaces.
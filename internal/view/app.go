@@ -20,9 +20,12 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/audit"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -58,6 +61,7 @@ type App struct {
 	showHeader    bool
 	showLogo      bool
 	showCrumbs    bool
+	cfgWarnings   []config.ConfigWarning
 }
 
 // NewApp returns a K9s app instance.
@@ -76,6 +80,11 @@ func NewApp(cfg *config.Config) *App {
 	return &a
 }
 
+// SetConfigWarnings tracks the config warnings to report on startup.
+func (a *App) SetConfigWarnings(ww []config.ConfigWarning) {
+	a.cfgWarnings = ww
+}
+
 // ReloadStyles reloads skin file.
 func (a *App) ReloadStyles() {
 	a.RefreshStyles(a)
@@ -111,8 +120,29 @@ func (a *App) Init(version string, _ int) error {
 		return errors.New("no client connection detected")
 	}
 	ns := a.Config.ActiveNamespace()
+	if cfg := a.Conn().Config(); cfg != nil {
+		cfg.SetTokenRefreshFn(a.tokenRefreshed)
+	}
+	audit.Configure(a.Config.K9s.Audit.LogPath)
 
 	a.factory = watch.NewFactory(a.Conn())
+	dao.SetReadonlyFn(a.Config.IsReadOnly)
+	client.SetMetricsRetry(a.Config.K9s.MetricsRetry.MaxRetries, a.Config.K9s.MetricsRetry.BackoffDuration)
+	client.SetMultiMetricsEndpoints(a.Config.K9s.MultiMetricsEndpoints)
+	dao.SetConfigMapHistoryDepth(a.Config.K9s.ConfigMap.HistoryDepth)
+	a.configurePrometheus()
+	if err := client.ConfigureCVEFeed(a.Config.K9s.KernelCVEs.FeedConfig()); err != nil {
+		slog.Error("Invalid kernel CVE feed configuration", slogs.Error, err)
+	}
+	if err := render.SetNodeColumns(a.Config.K9s.NodeColumns); err != nil {
+		slog.Error("Invalid node columns", slogs.Error, err)
+	}
+	if err := render.SetUnits(a.Config.K9s.UI.Units); err != nil {
+		slog.Error("Invalid units convention", slogs.Error, err)
+	}
+	if a.Config.K9s.IsA11y() {
+		model.UseAccessibleRenderers()
+	}
 	a.initFactory(ns)
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s)
@@ -451,6 +481,10 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		return nil
 	}
 
+	prevNS, prevView := a.Config.ActiveNamespace(), lastView(a.cmdHistory.List())
+	if n := dao.CancelMaintenanceWindows(); n > 0 {
+		a.Flash().Warnf("Canceled %d pending node maintenance window(s) on context switch", n)
+	}
 	a.Halt()
 	defer a.Resume()
 	{
@@ -459,8 +493,16 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		if err != nil {
 			return err
 		}
+		a.configurePrometheus()
 		if cns, ok := ci.NSArg(); ok {
 			ct.Namespace.Active = cns
+		} else {
+			nns, err := a.Conn().ValidNamespaceNames()
+			var shared bool
+			ct.Namespace.Active, shared = switchNamespace(prevNS, ct.Namespace.Active, nns, err)
+			if shared && prevView != "" {
+				a.Config.SetActiveView(prevView)
+			}
 		}
 
 		p := cmd.NewInterpreter(a.Config.ActiveView())
@@ -499,6 +541,61 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 	return nil
 }
 
+// switchNamespace returns the namespace to use on a new context and whether the
+// current namespace is shared. The current namespace is kept if the new context
+// has it and the default namespace is used if it's known not to exist. When the
+// namespaces can't be listed the new context namespace is left as is.
+func switchNamespace(prevNS, ctxNS string, nns client.NamespaceNames, err error) (string, bool) {
+	if client.IsClusterWide(prevNS) || prevNS == client.NotNamespaced {
+		return prevNS, true
+	}
+	if err != nil {
+		slog.Warn("Unable to list namespaces", slogs.Error, err)
+		return ctxNS, false
+	}
+	if _, ok := nns[prevNS]; ok {
+		return prevNS, true
+	}
+
+	return client.DefaultNamespace, false
+}
+
+// configurePrometheus sets up the active context Prometheus client if any.
+func (a *App) configurePrometheus() {
+	ct, err := a.Config.K9s.ActiveContext()
+	if err != nil {
+		return
+	}
+	if err := client.ConfigurePrometheus(a.Config.ActiveContextName(), ct.Prometheus.ClientConfig()); err != nil {
+		slog.Error("Invalid prometheus configuration", slogs.Context, a.Config.ActiveContextName(), slogs.Error, err)
+	}
+}
+
+// lastView returns the most recent non context command from the history.
+func lastView(cmds []string) string {
+	for i := len(cmds) - 1; i >= 0; i-- {
+		if p := cmd.NewInterpreter(cmds[i]); !p.IsContextCmd() {
+			return contextRX.ReplaceAllString(p.GetLine(), "")
+		}
+	}
+
+	return ""
+}
+
+// tokenRefreshed notifies the user of oidc token refreshes without blocking the request.
+func (a *App) tokenRefreshed(done bool, err error) {
+	go func() {
+		switch {
+		case !done:
+			a.Flash().Info("Refreshing OIDC token...")
+		case err != nil:
+			a.Flash().Errf("OIDC token refresh failed: %s", err)
+		default:
+			a.Flash().Info("OIDC token refreshed")
+		}
+	}()
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
 	a.factory.Start(ns)
@@ -535,6 +632,7 @@ func (a *App) Run() error {
 			if a.CmdBuff().IsActive() {
 				a.SetFocus(a.Prompt())
 			}
+			a.showConfigWarnings()
 		})
 	}()
 
@@ -549,6 +647,25 @@ func (a *App) Run() error {
 	return nil
 }
 
+func (a *App) showConfigWarnings() {
+	if len(a.cfgWarnings) == 0 {
+		return
+	}
+	d := a.Styles.Dialog()
+	dialog.ShowWarning(&d, a.Content.Pages, "config warnings", configWarningsMsg(a.cfgWarnings))
+}
+
+// configWarningsMsg lists the unknown config keys.
+func configWarningsMsg(ww []config.ConfigWarning) string {
+	ll := make([]string, 0, len(ww)+1)
+	ll = append(ll, fmt.Sprintf("Ignoring %d unknown key(s) in %s:", len(ww), ww[0].Path))
+	for _, w := range ww {
+		ll = append(ll, "  "+w.Key)
+	}
+
+	return strings.Join(ll, "\n")
+}
+
 // Status reports a new app status for display.
 func (a *App) Status(l model.FlashLevel, msg string) {
 	a.QueueUpdateDraw(func() {
@@ -670,6 +787,116 @@ func (a *App) dirCmd(path string, pushCmd bool) error {
 	return a.inject(NewDir(path), true)
 }
 
+func (a *App) searchCmd(term string, pushCmd bool) {
+	slog.Debug("Exec Search command", slogs.Command, "search "+term)
+	if pushCmd {
+		a.cmdHistory.Push("search " + term)
+	}
+	if err := a.inject(NewSearch(term), true); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) dashboardCmd(pushCmd bool) {
+	slog.Debug("Exec Dashboard command", slogs.Command, "dashboard")
+	if pushCmd {
+		a.cmdHistory.Push("dashboard")
+	}
+	if err := a.inject(NewDashboard(), true); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) netpolMatrixCmd(ns string, pushCmd bool) {
+	slog.Debug("Exec NetworkPolicy Matrix command", slogs.Command, "netpol matrix "+ns)
+	if pushCmd {
+		a.cmdHistory.Push("netpol matrix " + ns)
+	}
+	if err := a.inject(NewPolicyMatrix(ns), true); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) orphanRSCmd(pushCmd bool) {
+	slog.Debug("Exec Orphaned ReplicaSets command", slogs.Command, "orphanrs")
+	if pushCmd {
+		a.cmdHistory.Push("orphanrs")
+	}
+	if err := a.inject(NewOrphanReplicaSet(client.RsGVR), true); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) gpuNodesCmd(pushCmd bool) {
+	slog.Debug("Exec GPU Nodes command", slogs.Command, "gpunodes")
+	if pushCmd {
+		a.cmdHistory.Push("gpunodes")
+	}
+	if err := a.inject(NewGPUNode(client.NodeGVR), true); err != nil {
+		a.Flash().Err(err)
+	}
+}
+
+func (a *App) playbackCmd(path string, pushCmd bool) error {
+	slog.Debug("Exec Playback command", slogs.Command, "playback "+path)
+	rr, err := loadExecRecording(path)
+	if err != nil {
+		return err
+	}
+	if pushCmd {
+		a.cmdHistory.Push("playback " + path)
+	}
+
+	return a.inject(newPlayback(a, path, rr), false)
+}
+
+func (a *App) splitCmd(context string, pushCmd bool) error {
+	slog.Debug("Exec Split command", slogs.Command, "split "+context)
+	top, ok := a.Content.Top().(ResourceViewer)
+	if !ok {
+		return errors.New("split view is only available from a resource view")
+	}
+	if pushCmd {
+		a.cmdHistory.Push("split " + context)
+	}
+
+	return a.inject(NewSplitView(top.GVR(), a.Config.ActiveNamespace(), context), false)
+}
+
+func (a *App) helmDiffCmd(ns, n string, pushCmd bool) error {
+	slog.Debug("Exec Helm Diff command", slogs.Command, "helmdiff "+client.FQN(ns, n))
+	if ns == "" {
+		ns = a.Config.ActiveNamespace()
+	}
+	if client.IsAllNamespaces(ns) {
+		return errors.New("a release namespace is required. Use `helmdiff namespace/release`")
+	}
+	if pushCmd {
+		a.cmdHistory.Push("helmdiff " + client.FQN(ns, n))
+	}
+	a.Flash().Infof("Diffing helm release %s...", client.FQN(ns, n))
+	go showHelmDiff(a, ns, n)
+
+	return nil
+}
+
+func (a *App) drainZoneCmd(zone string, pushCmd bool) error {
+	slog.Debug("Exec Drain Zone command", slogs.Command, "drainzone "+zone)
+	if a.Config.IsReadOnly() {
+		return errors.New("zone drains are disabled in read-only mode")
+	}
+	if pushCmd {
+		a.cmdHistory.Push("drainzone " + zone)
+	}
+	d := a.Styles.Dialog()
+	msg := fmt.Sprintf("Drain all nodes in zone %s one at a time?", zone)
+	dialog.ShowConfirm(&d, a.Content.Pages, "Confirm Zone Drain", msg, func() {
+		drainZone(a, zone, drainOptions(a.Config.K9s.DrainOptions))
+	}, func() {})
+
+	return nil
+}
+
 func (a *App) quitCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if a.InCmdMode() {
 		return evt
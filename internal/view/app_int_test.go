@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_lastView(t *testing.T) {
+	uu := map[string]struct {
+		cmds []string
+		e    string
+	}{
+		"empty": {},
+		"last": {
+			cmds: []string{"pods", "dp fred"},
+			e:    "dp fred",
+		},
+		"skip-contexts": {
+			cmds: []string{"svc", "ctx", "ctx blee"},
+			e:    "svc",
+		},
+		"strip-context": {
+			cmds: []string{"pods @blee", "contexts"},
+			e:    "pods",
+		},
+		"only-contexts": {
+			cmds: []string{"ctx", "context"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, lastView(u.cmds))
+		})
+	}
+}
+
+func Test_configWarningsMsg(t *testing.T) {
+	ww := []config.ConfigWarning{
+		{Path: "config.yaml", Key: "k9s.refreshRat"},
+		{Path: "config.yaml", Key: "k9s.ui.enableMous"},
+	}
+
+	assert.Equal(t, "Ignoring 2 unknown key(s) in config.yaml:\n  k9s.refreshRat\n  k9s.ui.enableMous", configWarningsMsg(ww))
+}
+
+func Test_switchNamespace(t *testing.T) {
+	nns := client.NamespaceNames{"ns1": {}, "ns2": {}}
+
+	uu := map[string]struct {
+		prev, ctx string
+		nns       client.NamespaceNames
+		err       error
+		e         string
+		shared    bool
+	}{
+		"shared": {
+			prev:   "ns1",
+			ctx:    "ns2",
+			nns:    nns,
+			e:      "ns1",
+			shared: true,
+		},
+		"all": {
+			prev:   client.NamespaceAll,
+			ctx:    "ns2",
+			err:    errors.New("boom"),
+			e:      client.NamespaceAll,
+			shared: true,
+		},
+		"missing": {
+			prev: "ns3",
+			ctx:  "ns2",
+			nns:  nns,
+			e:    client.DefaultNamespace,
+		},
+		"unknown": {
+			prev: "ns1",
+			ctx:  "ns2",
+			err:  errors.New("user not authorized to list all namespaces"),
+			e:    "ns2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns, shared := switchNamespace(u.prev, u.ctx, u.nns, u.err)
+			assert.Equal(t, u.e, ns)
+			assert.Equal(t, u.shared, shared)
+		})
+	}
+}
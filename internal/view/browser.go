@@ -94,8 +94,13 @@ func (b *Browser) Init(ctx context.Context) error {
 		b.app.CmdBuff().Reset()
 	}
 	b.SetReadOnly(b.app.Config.IsReadOnly())
-	b.SetNoIcon(b.app.Config.K9s.UI.NoIcons)
+	b.SetColGroup(model1.PromMXGroup, client.Prometheus(b.app.Config.ActiveContextName()) != nil)
+	b.SetNoIcon(b.app.Config.K9s.UI.NoIcons || b.app.Config.K9s.IsA11y())
 	b.SetFullGVR(b.app.Config.K9s.UI.UseFullGVRTitle)
+	if ww := b.app.Config.K9s.UI.ColumnWidthsFor(b.GVR()); len(ww) > 0 {
+		b.SetColumnWidths(ww)
+		b.SetSelectedRowFn(b.truncatedRowChanged)
+	}
 
 	b.bindKeys(b.Actions())
 	for _, f := range b.bindKeysFn {
@@ -345,6 +350,17 @@ func (b *Browser) TableLoadFailed(err error) {
 	})
 }
 
+// truncatedRowChanged shows the selected row truncated columns full values.
+func (b *Browser) truncatedRowChanged(r int) {
+	id, ok := b.GetRowID(r)
+	if !ok {
+		return
+	}
+	if ff := b.TruncatedFields(id); len(ff) > 0 {
+		b.app.Flash().Info(strings.Join(ff, " | "))
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Actions...
 
@@ -6,6 +6,7 @@ package view
 import (
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -23,8 +24,9 @@ var _ model.ClusterInfoListener = (*ClusterInfo)(nil)
 type ClusterInfo struct {
 	*tview.Table
 
-	app    *App
-	styles *config.Styles
+	app      *App
+	styles   *config.Styles
+	degraded bool
 }
 
 // NewClusterInfo returns a new cluster info view.
@@ -127,23 +129,47 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr *model.ClusterMeta) {
 		} else {
 			row = c.setCell(row, curr.K9sVer)
 		}
-		row = c.setCell(row, curr.K8sVer)
+		row = c.setCell(row, curr.K8sVer+c.poolCell(curr.Pool))
 		if c.hasMetrics() {
 			row = c.setCell(row, ui.AsPercDelta(prev.Cpu, curr.Cpu))
 			_ = c.setCell(row, ui.AsPercDelta(prev.Mem, curr.Mem))
-			c.setDefCon(curr.Cpu, curr.Mem)
+			c.setDefCon(curr.Cpu, curr.Mem, curr.Pool)
 		} else {
 			row = c.setCell(row, c.warnCell(render.NAValue, true))
 			_ = c.setCell(row, c.warnCell(render.NAValue, true))
+			c.setPoolStatus(curr.Pool)
 		}
 		c.updateStyle()
 	})
 }
 
-const defconFmt = "%s %s level!"
+const (
+	defconFmt   = "%s %s level!"
+	poolWarnFmt = "Degraded api server connection (%s). Reconnecting..."
+)
+
+// poolCell returns the api server connection latency.
+func (c *ClusterInfo) poolCell(h client.PoolHealth) string {
+	if h.Samples == 0 {
+		return ""
+	}
+
+	return " " + c.warnCell(h.Latency.Round(time.Millisecond).String(), h.Degraded())
+}
+
+// setPoolStatus flashes a warning when the api server connection becomes degraded.
+func (c *ClusterInfo) setPoolStatus(h client.PoolHealth) bool {
+	degraded := h.Degraded()
+	if degraded && !c.degraded {
+		c.app.Status(model.FlashWarn, fmt.Sprintf(poolWarnFmt, h))
+	}
+	c.degraded = degraded
+
+	return degraded
+}
 
-func (c *ClusterInfo) setDefCon(cpu, mem int) {
-	var set bool
+func (c *ClusterInfo) setDefCon(cpu, mem int, pool client.PoolHealth) {
+	set := c.setPoolStatus(pool)
 	l := c.app.Config.K9s.Thresholds.LevelFor(config.CPU, cpu)
 	if l > config.SeverityLow {
 		c.app.Status(flashLevel(l), fmt.Sprintf(defconFmt, flashMessage(l), "CPU"))
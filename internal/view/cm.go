@@ -5,14 +5,27 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 )
 
+// viewerEnvVar tracks the env var specifying an image viewer command.
+const viewerEnvVar = "VIEWER"
+
 // ConfigMap represents a configmap viewer.
 type ConfigMap struct {
 	ResourceViewer
@@ -31,7 +44,200 @@ func NewConfigMap(gvr *client.GVR) ResourceViewer {
 }
 
 func (s *ConfigMap) bindKeys(aa *ui.KeyActions) {
-	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true))
+	aa.Bulk(ui.KeyMap{
+		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
+		ui.KeyB: ui.NewKeyAction("Binary Data", s.binaryDataCmd, true),
+		ui.KeyH: ui.NewKeyAction("History", s.historyCmd, true),
+	})
+}
+
+func (s *ConfigMap) binaryDataCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	cm, err := s.configMapDAO()
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	ns, n := client.Namespaced(path)
+	kk, err := cm.BinaryKeys(ns, n)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	switch len(kk) {
+	case 0:
+		s.App().Flash().Warnf("No binary data found in configmap %s", path)
+	case 1:
+		s.showBinaryEntry(cm, path, kk[0])
+	default:
+		picker := NewPicker()
+		picker.populate(kk)
+		picker.SetSelectedFunc(func(_ int, key, _ string, _ rune) {
+			s.showBinaryEntry(cm, path, key)
+		})
+		if err := s.App().inject(picker, false); err != nil {
+			s.App().Flash().Err(err)
+			return nil
+		}
+		picker.SetTitle(" [aqua::b]Binary Data Picker ")
+	}
+
+	return nil
+}
+
+// pushVersionItem tracks the history picker entry snapshotting the current data.
+const pushVersionItem = "Push current version"
+
+func (s *ConfigMap) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	cm, err := s.configMapDAO()
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	ns, n := client.Namespaced(path)
+	rr, err := cm.History(ns, n)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+
+	picker := NewPicker()
+	picker.populate(append([]string{pushVersionItem}, revisionItems(rr)...))
+	picker.SetSelectedFunc(func(idx int, _, _ string, _ rune) {
+		s.App().PrevCmd(nil)
+		if idx == 0 {
+			s.pushVersion(cm, path)
+			return
+		}
+		s.restoreVersion(cm, path, idx-1)
+	})
+	if err := s.App().inject(picker, false); err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	picker.SetTitle(" [aqua::b]History Picker ")
+
+	return nil
+}
+
+// revisionItems renders configmap revisions as picker entries, most recent first.
+func revisionItems(rr []dao.ConfigMapRevision) []string {
+	ii := make([]string, 0, len(rr))
+	for i, r := range rr {
+		ii = append(ii, fmt.Sprintf("#%d %s (%d keys)", i, r.Timestamp.Local().Format(time.RFC3339), len(r.Data)+len(r.BinaryData)))
+	}
+
+	return ii
+}
+
+func (s *ConfigMap) pushVersion(cm *dao.ConfigMap, path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(path)
+	if err := cm.PushVersion(ctx, ns, n); err != nil {
+		s.App().Flash().Errf("Push version failed: %s", err)
+		return
+	}
+	s.App().Flash().Infof("Configmap %s version pushed", path)
+}
+
+func (s *ConfigMap) restoreVersion(cm *dao.ConfigMap, path string, index int) {
+	d := s.App().Styles.Dialog()
+	msg := fmt.Sprintf("Restore configmap %s data to revision #%d? The current data is pushed to the history first.", path, index)
+	dialog.ShowConfirm(&d, s.App().Content.Pages, "Restore Version", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		ns, n := client.Namespaced(path)
+		if err := cm.RestoreVersion(ctx, ns, n, index); err != nil {
+			s.App().Flash().Errf("Restore version failed: %s", err)
+			return
+		}
+		s.App().Flash().Infof("Configmap %s restored to revision #%d", path, index)
+	}, func() {})
+}
+
+func (s *ConfigMap) configMapDAO() (*dao.ConfigMap, error) {
+	res, err := dao.AccessorFor(s.App().factory, client.CmGVR)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := res.(*dao.ConfigMap)
+	if !ok {
+		return nil, fmt.Errorf("expecting a configmap resource but got %T", res)
+	}
+
+	return cm, nil
+}
+
+func (s *ConfigMap) showBinaryEntry(cm *dao.ConfigMap, path, key string) {
+	ns, n := client.Namespaced(path)
+	bb, err := cm.GetBinaryEntry(ns, n, key)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(s.App(), "Binary Data", path+":"+key, contentTXT, true).Update(tview.Escape(render.HexDump(bb)))
+	details.Actions().Add(ui.KeyX, ui.NewKeyAction("Export", func(*tcell.EventKey) *tcell.EventKey {
+		s.exportBinaryEntry(n, key, bb)
+		return nil
+	}, true))
+	if err := s.App().inject(details, false); err != nil {
+		s.App().Flash().Err(err)
+	}
+}
+
+func (s *ConfigMap) exportBinaryEntry(name, key string, bb []byte) {
+	dir := s.App().Config.K9s.ContextScreenDumpDir()
+	if err := ensureDir(dir); err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	path := filepath.Join(dir, data.SanitizeFileName(fmt.Sprintf("%s-%d-%s", name, time.Now().Unix(), key)))
+	if err := os.WriteFile(path, bb, 0600); err != nil {
+		s.App().Flash().Err(err)
+		return
+	}
+	s.App().Flash().Infof("Binary data saved to %s", path)
+
+	viewer := os.Getenv(viewerEnvVar)
+	if _, ok := dao.ImageType(bb); !ok || viewer == "" {
+		return
+	}
+	d := s.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, s.App().Content.Pages, "Open Image", fmt.Sprintf("Open %s with %s?", key, viewer), func() {
+		openWithViewer(s.App(), viewer, path)
+	}, func() {})
+}
+
+// openWithViewer opens a file with the given viewer command.
+func openWithViewer(a *App, viewer, path string) {
+	tokens := strings.Fields(viewer)
+	bin, err := exec.LookPath(tokens[0])
+	if err != nil {
+		a.Flash().Errf("Viewer %q not found: %s", tokens[0], err)
+		return
+	}
+	ok, errChan, _ := run(a, &shellOpts{
+		binary: bin,
+		args:   append(tokens[1:], path),
+	})
+	if !ok {
+		a.Flash().Errf("Unable to open %s", path)
+		return
+	}
+	for e := range errChan {
+		a.Flash().Err(e)
+	}
 }
 
 func (s *ConfigMap) refCmd(evt *tcell.EventKey) *tcell.EventKey {
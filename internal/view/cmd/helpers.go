@@ -43,7 +43,7 @@ func SuggestSubCommand(command string, namespaces client.NamespaceNames, context
 	p := NewInterpreter(command)
 	var suggests []string
 	switch {
-	case p.IsCowCmd(), p.IsHelpCmd(), p.IsAliasCmd(), p.IsBailCmd(), p.IsDirCmd():
+	case p.IsCowCmd(), p.IsHelpCmd(), p.IsAliasCmd(), p.IsBailCmd(), p.IsDirCmd(), p.IsSearchCmd(), p.IsDashboardCmd(), p.IsSplitCmd(), p.IsHelmDiffCmd(), p.IsDrainZoneCmd(), p.IsOrphanRSCmd(), p.IsGPUNodesCmd(), p.IsPlaybackCmd():
 		return nil
 
 	case p.IsXrayCmd():
@@ -108,6 +108,18 @@ func (c *Interpreter) IsXrayCmd() bool {
 	return xrayCmd.Has(c.cmd)
 }
 
+// IsDashboardCmd returns true if dashboard cmd is detected.
+func (c *Interpreter) IsDashboardCmd() bool {
+	return dashboardCmd.Has(c.cmd)
+}
+
+// IsNetpolMatrixCmd returns true if network policy matrix cmd is detected.
+func (c *Interpreter) IsNetpolMatrixCmd() bool {
+	ff := strings.Fields(c.line)
+
+	return len(ff) > 1 && netpolCmd.Has(c.cmd) && strings.ToLower(ff[1]) == matrixArg
+}
+
 // IsContextCmd returns true if context cmd is detected.
 func (c *Interpreter) IsContextCmd() bool {
 	return contextCmd.Has(c.cmd)
@@ -128,6 +140,41 @@ func (c *Interpreter) IsRBACCmd() bool {
 	return c.cmd == canCmd
 }
 
+// IsSearchCmd returns true if search cmd is detected.
+func (c *Interpreter) IsSearchCmd() bool {
+	return c.cmd == searchCmd
+}
+
+// IsSplitCmd returns true if split view cmd is detected.
+func (c *Interpreter) IsSplitCmd() bool {
+	return c.cmd == splitCmd
+}
+
+// IsHelmDiffCmd returns true if helm diff cmd is detected.
+func (c *Interpreter) IsHelmDiffCmd() bool {
+	return c.cmd == helmDiffCmd
+}
+
+// IsDrainZoneCmd returns true if zone drain cmd is detected.
+func (c *Interpreter) IsDrainZoneCmd() bool {
+	return c.cmd == drainZoneCmd
+}
+
+// IsOrphanRSCmd returns true if orphaned replicasets cmd is detected.
+func (c *Interpreter) IsOrphanRSCmd() bool {
+	return c.cmd == orphanRSCmd
+}
+
+// IsGPUNodesCmd returns true if GPU nodes cmd is detected.
+func (c *Interpreter) IsGPUNodesCmd() bool {
+	return c.cmd == gpuNodesCmd
+}
+
+// IsPlaybackCmd returns true if exec session playback cmd is detected.
+func (c *Interpreter) IsPlaybackCmd() bool {
+	return c.cmd == playbackCmd
+}
+
 // ContextArg returns context cmd arg.
 func (c *Interpreter) ContextArg() (string, bool) {
 	if !c.IsContextCmd() {
@@ -162,6 +209,83 @@ func (c *Interpreter) CowArg() (string, bool) {
 	return m, ok && m != ""
 }
 
+// SearchArg returns the search term.
+func (c *Interpreter) SearchArg() (string, bool) {
+	if !c.IsSearchCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	term := strings.Join(ff[1:], " ")
+
+	return term, term != ""
+}
+
+// SplitArg returns the split view context.
+func (c *Interpreter) SplitArg() (string, bool) {
+	if !c.IsSplitCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", false
+	}
+
+	return ff[1], true
+}
+
+// HelmDiffArg returns the helm diff release namespace and name.
+func (c *Interpreter) HelmDiffArg() (ns, n string, ok bool) {
+	if !c.IsHelmDiffCmd() {
+		return "", "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", "", false
+	}
+	ns, n = client.Namespaced(ff[1])
+
+	return ns, n, n != ""
+}
+
+// DrainZoneArg returns the zone to drain.
+func (c *Interpreter) DrainZoneArg() (string, bool) {
+	if !c.IsDrainZoneCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", false
+	}
+
+	return ff[1], true
+}
+
+// PlaybackArg returns the exec session recording to replay.
+func (c *Interpreter) PlaybackArg() (string, bool) {
+	if !c.IsPlaybackCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) != 2 {
+		return "", false
+	}
+
+	return ff[1], true
+}
+
+// NetpolMatrixArg returns the network policy matrix namespace if any.
+func (c *Interpreter) NetpolMatrixArg() (string, bool) {
+	if !c.IsNetpolMatrixCmd() {
+		return "", false
+	}
+	ff := strings.Fields(c.line)
+	if len(ff) < 3 {
+		return "", false
+	}
+
+	return ff[2], true
+}
+
 // RBACArgs returns the subject and topic is any.
 func (c *Interpreter) RBACArgs() (subject, verb string, ok bool) {
 	if !c.IsRBACCmd() {
@@ -475,3 +475,269 @@ func TestCowCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd  string
+		ok   bool
+		term string
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "search",
+		},
+		"term": {
+			cmd:  "search fred",
+			ok:   true,
+			term: "fred",
+		},
+		"upper": {
+			cmd:  "SEARCH  Fred  blee ",
+			ok:   true,
+			term: "Fred blee",
+		},
+		"toast": {
+			cmd: "searchy fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			term, ok := p.SearchArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.term, term)
+		})
+	}
+}
+
+func TestDashboardCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "dashboard",
+			ok:  true,
+		},
+		"short": {
+			cmd: "dash",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "dashy",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			assert.Equal(t, u.ok, p.IsDashboardCmd())
+		})
+	}
+}
+
+func TestSplitCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, ctx string
+		ok, sk   bool
+	}{
+		"empty": {},
+		"no-ctx": {
+			cmd: "split",
+			sk:  true,
+		},
+		"ctx": {
+			cmd: "split fred",
+			ctx: "fred",
+			ok:  true,
+			sk:  true,
+		},
+		"too-many": {
+			cmd: "split fred blee",
+			sk:  true,
+		},
+		"toast": {
+			cmd: "splits fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			assert.Equal(t, u.sk, p.IsSplitCmd())
+			ctx, ok := p.SplitArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.ctx, ctx)
+		})
+	}
+}
+
+func TestNetpolMatrixCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		ok, nk bool
+		ns     string
+	}{
+		"empty": {},
+		"plain": {
+			cmd: "netpol",
+		},
+		"matrix": {
+			cmd: "netpol matrix",
+			ok:  true,
+		},
+		"alias": {
+			cmd: "NP Matrix",
+			ok:  true,
+		},
+		"ns": {
+			cmd: "networkpolicies matrix fred",
+			ok:  true,
+			nk:  true,
+			ns:  "fred",
+		},
+		"toast": {
+			cmd: "pods matrix",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			assert.Equal(t, u.ok, p.IsNetpolMatrixCmd())
+			ns, ok := p.NetpolMatrixArg()
+			assert.Equal(t, u.nk, ok)
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
+func TestHelmDiffCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, ns, n string
+		ok         bool
+	}{
+		"empty": {},
+		"no-release": {
+			cmd: "helmdiff",
+		},
+		"release": {
+			cmd: "helmdiff fred",
+			n:   "fred",
+			ok:  true,
+		},
+		"fqn": {
+			cmd: "helmdiff ns1/fred",
+			ns:  "ns1",
+			n:   "fred",
+			ok:  true,
+		},
+		"toast": {
+			cmd: "helmdiff ns1/",
+			ns:  "ns1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			ns, n, ok := p.HelmDiffArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.ns, ns)
+			assert.Equal(t, u.n, n)
+		})
+	}
+}
+
+func TestPlaybackCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, path string
+		ok        bool
+	}{
+		"empty": {},
+		"no-file": {
+			cmd: "playback",
+		},
+		"file": {
+			cmd:  "playback /tmp/Fred.jsonl",
+			path: "/tmp/Fred.jsonl",
+			ok:   true,
+		},
+		"toast": {
+			cmd: "playback f1 f2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			path, ok := p.PlaybackArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+func TestDrainZoneCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, zone string
+		ok        bool
+	}{
+		"empty": {},
+		"no-zone": {
+			cmd: "drainzone",
+		},
+		"zone": {
+			cmd:  "drainzone us-east-1a",
+			zone: "us-east-1a",
+			ok:   true,
+		},
+		"toast": {
+			cmd: "drainzone us-east-1a us-east-1b",
+		},
+		"other": {
+			cmd: "drain us-east-1a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := cmd.NewInterpreter(u.cmd)
+			zone, ok := p.DrainZoneArg()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.zone, zone)
+		})
+	}
+}
+
+func TestOrphanRSCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+	}{
+		"empty": {},
+		"orphans": {
+			cmd: "orphanrs",
+			ok:  true,
+		},
+		"rs": {
+			cmd: "rs",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.ok, cmd.NewInterpreter(u.cmd).IsOrphanRSCmd())
+		})
+	}
+}
@@ -10,13 +10,21 @@ import (
 )
 
 const (
-	cowCmd      = "cow"
-	canCmd      = "can"
-	nsFlag      = "-n"
-	filterFlag  = "/"
-	labelFlag   = "="
-	fuzzyFlag   = "-f"
-	contextFlag = "@"
+	cowCmd       = "cow"
+	canCmd       = "can"
+	searchCmd    = "search"
+	splitCmd     = "split"
+	helmDiffCmd  = "helmdiff"
+	drainZoneCmd = "drainzone"
+	orphanRSCmd  = "orphanrs"
+	gpuNodesCmd  = "gpunodes"
+	playbackCmd  = "playback"
+	matrixArg    = "matrix"
+	nsFlag       = "-n"
+	filterFlag   = "/"
+	labelFlag    = "="
+	fuzzyFlag    = "-f"
+	contextFlag  = "@"
 )
 
 var (
@@ -61,4 +69,14 @@ var (
 		"xr",
 		"xray",
 	)
+	netpolCmd = sets.New(
+		"np",
+		"netpol",
+		"networkpolicy",
+		"networkpolicies",
+	)
+	dashboardCmd = sets.New(
+		"dash",
+		"dashboard",
+	)
 )
@@ -271,6 +271,48 @@ func (c *Command) specialCmd(p *cmd.Interpreter, pushCmd bool) bool {
 		}
 	case p.IsNamespaceCmd():
 		return c.namespaceCmd(p)
+	case p.IsSearchCmd():
+		if term, ok := p.SearchArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `search xxx`")
+		} else {
+			c.app.searchCmd(term, pushCmd)
+		}
+	case p.IsDashboardCmd():
+		c.app.dashboardCmd(pushCmd)
+	case p.IsSplitCmd():
+		if ctx, ok := p.SplitArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `split context`")
+		} else if err := c.app.splitCmd(ctx, pushCmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsHelmDiffCmd():
+		if ns, n, ok := p.HelmDiffArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `helmdiff [namespace/]release`")
+		} else if err := c.app.helmDiffCmd(ns, n, pushCmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsDrainZoneCmd():
+		if zone, ok := p.DrainZoneArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `drainzone zone`")
+		} else if err := c.app.drainZoneCmd(zone, pushCmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsOrphanRSCmd():
+		c.app.orphanRSCmd(pushCmd)
+	case p.IsGPUNodesCmd():
+		c.app.gpuNodesCmd(pushCmd)
+	case p.IsPlaybackCmd():
+		if path, ok := p.PlaybackArg(); !ok {
+			c.app.Flash().Errf("Invalid command. Use `playback file`")
+		} else if err := c.app.playbackCmd(path, pushCmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+	case p.IsNetpolMatrixCmd():
+		ns := c.app.Config.ActiveNamespace()
+		if cns, ok := p.NetpolMatrixArg(); ok {
+			ns = cns
+		}
+		c.app.netpolMatrixCmd(ns, pushCmd)
 	case p.IsDirCmd():
 		if a, ok := p.DirArg(); !ok {
 			c.app.Flash().Errf("Invalid command. Use `dir xxx`")
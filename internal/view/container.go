@@ -92,6 +92,7 @@ func (c *Container) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftI: ui.NewKeyAction("Sort Idx", c.GetTable().SortColCmd("IDX", true), false),
+		ui.KeyShiftW: ui.NewKeyAction(crashTitle, c.crashCmd, true),
 	})
 	aa.Merge(resourceSorters(c.GetTable()))
 }
@@ -171,6 +172,17 @@ func (c *Container) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) crashCmd(evt *tcell.EventKey) *tcell.EventKey {
+	co := c.GetTable().GetSelectedItem()
+	if co == "" {
+		return evt
+	}
+
+	go showCrashAnalysis(c.App(), c.GetTable().Path, co)
+
+	return nil
+}
+
 func (c *Container) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sel := c.GetTable().GetSelectedItem()
 	if sel == "" {
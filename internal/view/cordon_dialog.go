@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const cordonKey = "cordon"
+
+// CordonFunc represents a cordon callback function.
+type CordonFunc func(v ResourceViewer, sels []string, reason string)
+
+// ShowCordon pops a node cordon dialog prompting for a cordon reason.
+func ShowCordon(view ResourceViewer, sels []string, okFn CordonFunc) {
+	styles := view.App().Styles.Dialog()
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	var reason string
+	f.AddInputField("Reason:", "", 0, nil, func(v string) {
+		reason = v
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissCordon(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissCordon(view, pages)
+		okFn(view, sels, reason)
+	})
+
+	modal := tview.NewModalForm("<Cordon>", f)
+	msg := "Cordon "
+	if len(sels) == 1 {
+		msg += sels[0]
+	} else {
+		msg += fmt.Sprintf("(%d) nodes", len(sels))
+	}
+	msg += "?"
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		DismissCordon(view, pages)
+	})
+
+	pages.AddPage(cordonKey, modal, false, true)
+	pages.ShowPage(cordonKey)
+	view.App().SetFocus(pages.GetPrimitive(cordonKey))
+}
+
+// DismissCordon dismiss the cordon dialog.
+func DismissCordon(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(cordonKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
@@ -8,10 +8,14 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -21,17 +25,24 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 const (
 	suspendDialogKey     = "suspend"
 	lastScheduledCol     = "LAST_SCHEDULE"
+	missedCol            = "MISSED"
+	missedRunsTitle      = "Missed Runs"
 	defaultSuspendStatus = "true"
 )
 
 // CronJob represents a cronjob viewer.
 type CronJob struct {
 	ResourceViewer
+
+	mx        sync.RWMutex
+	missedIdx int
+	missed    map[string]struct{}
 }
 
 // NewCronJob returns a new viewer.
@@ -41,6 +52,8 @@ func NewCronJob(gvr *client.GVR) ResourceViewer {
 	)}
 	c.AddBindKeysFn(c.bindKeys)
 	c.GetTable().SetEnterFn(c.showJobs)
+	c.GetTable().SetDecorateFn(c.trackMissed)
+	c.GetTable().SetCellColorerFn(c.cellColor)
 
 	return &c
 }
@@ -82,10 +95,88 @@ func (c *CronJob) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyT:      ui.NewKeyAction("Trigger", c.triggerCmd, true),
 		ui.KeyS:      ui.NewKeyAction("Suspend/Resume", c.toggleSuspendCmd, true),
+		ui.KeyM:      ui.NewKeyAction(missedRunsTitle, c.missedRunsCmd, true),
 		ui.KeyShiftL: ui.NewKeyAction("Sort LastScheduled", c.GetTable().SortColCmd(lastScheduledCol, true), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Missed", c.GetTable().SortColCmd(missedCol, false), false),
 	})
 }
 
+// trackMissed records the cronjobs that missed scheduled runs.
+func (c *CronJob) trackMissed(td *model1.TableData) {
+	idx, _ := td.Header().IndexOf(missedCol, true)
+	missed := make(map[string]struct{})
+	if idx >= 0 {
+		td.RowsRange(func(_ int, re model1.RowEvent) bool {
+			if idx < len(re.Row.Fields) {
+				if f := re.Row.Fields[idx]; f != "0" && f != render.NAValue {
+					missed[re.Row.ID] = struct{}{}
+				}
+			}
+			return true
+		})
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.missedIdx, c.missed = idx, missed
+}
+
+// cellColor flags the missed runs count of cronjobs that skipped a run.
+func (c *CronJob) cellColor(id string, col int) (tcell.Color, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if col != c.missedIdx {
+		return tcell.ColorDefault, false
+	}
+	if _, ok := c.missed[id]; ok {
+		return tcell.ColorRed, true
+	}
+
+	return tcell.ColorDefault, false
+}
+
+func (c *CronJob) missedRunsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var cj dao.CronJob
+	cj.Init(c.App().factory, c.GVR())
+	ns, n := client.Namespaced(path)
+	go func() {
+		tt, err := cj.GetMissedRuns(context.Background(), ns, n)
+		c.App().QueueUpdateDraw(func() {
+			if err != nil {
+				c.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(c.App(), missedRunsTitle, path, contentTXT, true).Update(missedRunsInfo(tt, time.Now()))
+			if err := c.App().inject(details, false); err != nil {
+				c.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// missedRunsInfo lists the missed runs scheduled times, most recent first.
+func missedRunsInfo(tt []time.Time, now time.Time) string {
+	if len(tt) == 0 {
+		return "No missed runs"
+	}
+
+	ll := make([]string, 0, len(tt)+1)
+	ll = append(ll, fmt.Sprintf("%d missed run(s):", len(tt)))
+	for i := len(tt) - 1; i >= 0; i-- {
+		ll = append(ll, fmt.Sprintf("  %s (%s ago)", tt[i].Format(time.RFC3339), duration.HumanDuration(now.Sub(tt[i]))))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
 func (c *CronJob) triggerCmd(evt *tcell.EventKey) *tcell.EventKey {
 	fqns := c.GetTable().GetSelectedItems()
 	if len(fqns) == 0 {
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_missedRunsInfo(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	uu := map[string]struct {
+		tt []time.Time
+		e  string
+	}{
+		"none": {
+			e: "No missed runs",
+		},
+		"missed": {
+			tt: []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour)},
+			e: "2 missed run(s):\n" +
+				"  2025-01-01T11:00:00Z (60m ago)\n" +
+				"  2025-01-01T10:00:00Z (120m ago)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, missedRunsInfo(u.tt, now))
+		})
+	}
+}
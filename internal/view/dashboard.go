@@ -0,0 +1,352 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	dashboardTitle = "Dashboard"
+	nodePieRadius  = 4
+	readyColor     = "green"
+	notReadyColor  = "red"
+)
+
+// podPhases tracks the order pod phases are reported in.
+var podPhases = []v1.PodPhase{
+	v1.PodRunning,
+	v1.PodPending,
+	v1.PodSucceeded,
+	v1.PodFailed,
+	v1.PodUnknown,
+}
+
+// Dashboard presents an aggregate cluster health overview.
+type Dashboard struct {
+	*tview.Flex
+
+	app                   *App
+	actions               *ui.KeyActions
+	nodes, pods, pvcs     *tview.TextView
+	topCPU, topMEM, warns *tview.TextView
+	cancelFn              context.CancelFunc
+}
+
+// NewDashboard returns a new cluster dashboard.
+func NewDashboard() *Dashboard {
+	return &Dashboard{
+		Flex:    tview.NewFlex(),
+		actions: ui.NewKeyActions(),
+		nodes:   newDashboardPane("Nodes"),
+		pods:    newDashboardPane("Pods"),
+		pvcs:    newDashboardPane("Pending PVCs"),
+		topCPU:  newDashboardPane("Top CPU Nodes"),
+		topMEM:  newDashboardPane("Top MEM Nodes"),
+		warns:   newDashboardPane("Recent Warnings"),
+	}
+}
+
+func newDashboardPane(title string) *tview.TextView {
+	t := tview.NewTextView()
+	t.SetDynamicColors(true).SetWrap(false)
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetTitle(" " + title + " ")
+	t.SetText("Loading...")
+
+	return t
+}
+
+func (*Dashboard) SetCommand(*cmd.Interpreter)      {}
+func (*Dashboard) SetFilter(string)                 {}
+func (*Dashboard) SetLabelFilter(map[string]string) {}
+
+// Init initializes the view.
+func (d *Dashboard) Init(ctx context.Context) error {
+	var err error
+	if d.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	d.SetDirection(tview.FlexRow)
+	d.SetBorder(true)
+	d.SetTitle(fmt.Sprintf(" %s ", dashboardTitle))
+	d.SetBorderPadding(0, 0, 1, 1)
+	d.AddItem(tview.NewFlex().
+		AddItem(d.nodes, 0, 1, false).
+		AddItem(d.pods, 0, 1, false).
+		AddItem(d.pvcs, 0, 1, false), 2*nodePieRadius+4, 0, false)
+	d.AddItem(tview.NewFlex().
+		AddItem(d.topCPU, 0, 1, false).
+		AddItem(d.topMEM, 0, 1, false), dao.SummaryTopNodes+3, 0, false)
+	d.AddItem(d.warns, 0, 1, false)
+
+	d.app.Styles.AddListener(d)
+	d.StylesChanged(d.app.Styles)
+	d.bindKeys()
+	d.SetInputCapture(d.keyboard)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (d *Dashboard) StylesChanged(s *config.Styles) {
+	d.SetBackgroundColor(s.BgColor())
+	for _, t := range d.panes() {
+		t.SetBackgroundColor(s.BgColor())
+		t.SetTextColor(s.FgColor())
+		t.SetBorderColor(s.Frame().Border.FgColor.Color())
+		t.SetTitleColor(s.Frame().Title.FgColor.Color())
+	}
+}
+
+func (d *Dashboard) panes() []*tview.TextView {
+	return []*tview.TextView{d.nodes, d.pods, d.pvcs, d.topCPU, d.topMEM, d.warns}
+}
+
+func (d *Dashboard) bindKeys() {
+	d.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, false),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", d.refreshCmd, true),
+	})
+}
+
+func (d *Dashboard) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := d.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (d *Dashboard) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	go d.refresh(context.Background())
+
+	return nil
+}
+
+// Start starts the dashboard refresh loop.
+func (d *Dashboard) Start() {
+	d.Stop()
+
+	var ctx context.Context
+	ctx, d.cancelFn = context.WithCancel(context.Background())
+	go d.updater(ctx, time.Duration(d.app.Config.K9s.Dashboard.RefreshInterval)*time.Second)
+}
+
+// Stop terminates the refresh loop.
+func (d *Dashboard) Stop() {
+	if d.cancelFn == nil {
+		return
+	}
+	d.cancelFn()
+	d.cancelFn = nil
+}
+
+func (d *Dashboard) updater(ctx context.Context, every time.Duration) {
+	d.refresh(ctx)
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.refresh(ctx)
+		}
+	}
+}
+
+func (d *Dashboard) refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, d.app.Conn().Config().CallTimeout())
+	defer cancel()
+
+	s, err := dao.FetchClusterSummary(ctx, d.app.factory)
+	if err != nil {
+		slog.Warn("Cluster summary is incomplete", slogs.Error, err)
+	}
+	if ctx.Err() != nil && s == nil {
+		return
+	}
+	warnColor := d.app.Styles.Frame().Status.ErrorColor.String()
+	d.app.QueueUpdateDraw(func() {
+		d.nodes.SetText(nodePie(s.Nodes, nodePieRadius))
+		d.pods.SetText(podPhasesTable(s.PodPhases))
+		d.pvcs.SetText(pendingPVCsList(s.PendingPVCs))
+		d.topCPU.SetText(topNodesTable(s.TopCPUNodes, v1.ResourceCPU))
+		d.topMEM.SetText(topNodesTable(s.TopMEMNodes, v1.ResourceMemory))
+		d.warns.SetText(warningsTable(s.Warnings, warnColor))
+		if err != nil {
+			d.app.Flash().Warnf("Dashboard may be incomplete: %s", err)
+		}
+	})
+}
+
+// Name returns the component name.
+func (*Dashboard) Name() string {
+	return dashboardTitle
+}
+
+// InCmdMode checks if prompt is active.
+func (*Dashboard) InCmdMode() bool {
+	return false
+}
+
+// Hints returns menu hints.
+func (d *Dashboard) Hints() model.MenuHints {
+	return d.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*Dashboard) ExtraHints() map[string]string {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// nodePie renders the ready vs not ready nodes ratio as a text-art pie chart.
+func nodePie(h dao.NodeHealth, radius int) string {
+	if h.Total() == 0 {
+		return "No nodes found"
+	}
+
+	ready := float64(h.Ready) / float64(h.Total())
+	var buff strings.Builder
+	for y := -radius; y <= radius; y++ {
+		var last string
+		for x := -2 * radius; x <= 2*radius; x++ {
+			// Cells are about twice as tall as they are wide.
+			fx, fy := float64(x)/2, float64(y)
+			if fx*fx+fy*fy > float64(radius*radius) {
+				if last != "" {
+					buff.WriteString("[-]")
+					last = ""
+				}
+				buff.WriteByte(' ')
+				continue
+			}
+			// Slices start at 12 o'clock and go clockwise.
+			angle := math.Atan2(fx, -fy) / (2 * math.Pi)
+			if angle < 0 {
+				angle++
+			}
+			c := notReadyColor
+			if angle < ready || ready == 1 {
+				c = readyColor
+			}
+			if c != last {
+				buff.WriteString("[" + c + "]")
+				last = c
+			}
+			buff.WriteString("█")
+		}
+		if last != "" {
+			buff.WriteString("[-]")
+		}
+		buff.WriteByte('\n')
+	}
+	fmt.Fprintf(&buff, "[%s]█[-] Ready %d  [%s]█[-] NotReady %d  Cordoned %d",
+		readyColor, h.Ready, notReadyColor, h.NotReady, h.Cordoned)
+
+	return buff.String()
+}
+
+func podPhasesTable(mm map[v1.PodPhase]int) string {
+	var (
+		buff  bytes.Buffer
+		total int
+	)
+	w := tabwriter.NewWriter(&buff, 0, 0, 2, ' ', tabwriter.AlignRight)
+	for _, p := range podPhases {
+		total += mm[p]
+		_, _ = fmt.Fprintf(w, "%s\t%d\t\n", p, mm[p])
+	}
+	_, _ = fmt.Fprintf(w, "Total\t%d\t\n", total)
+	_ = w.Flush()
+
+	return strings.TrimSuffix(buff.String(), "\n")
+}
+
+func pendingPVCsList(pp []string) string {
+	if len(pp) == 0 {
+		return "No pending claims"
+	}
+
+	ll := make([]string, 0, len(pp))
+	for _, p := range pp {
+		ll = append(ll, tview.Escape(p))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func topNodesTable(uu []dao.NodeUsage, res v1.ResourceName) string {
+	if len(uu) == 0 {
+		return "No metrics available"
+	}
+
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 0, 2, ' ', 0)
+	for _, u := range uu {
+		usage := strconv.FormatInt(u.Usage, 10) + "m"
+		if res != v1.ResourceCPU {
+			usage = strconv.FormatInt(client.ToMB(u.Usage), 10) + "Mi"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", u.Name, usage, render.AsBar(u.Percent))
+	}
+	_ = w.Flush()
+
+	return tview.Escape(strings.TrimSuffix(buff.String(), "\n"))
+}
+
+func warningsTable(ee []*v1.Event, warnColor string) string {
+	if len(ee) == 0 {
+		return "No warnings found"
+	}
+
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "LAST SEEN\tOBJECT\tREASON\tMESSAGE")
+	for _, e := range ee {
+		last := e.LastTimestamp
+		if last.IsZero() {
+			last = e.CreationTimestamp
+		}
+		obj := strings.ToLower(e.InvolvedObject.Kind) + "/" + client.FQN(e.InvolvedObject.Namespace, e.InvolvedObject.Name)
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", render.ToAge(last), obj, e.Reason, strings.TrimSpace(e.Message))
+	}
+	_ = w.Flush()
+
+	ll := strings.Split(strings.TrimSuffix(buff.String(), "\n"), "\n")
+	for i := range ll {
+		ll[i] = tview.Escape(ll[i])
+		if i > 0 {
+			ll[i] = "[" + warnColor + "::]" + ll[i] + "[-::]"
+		}
+	}
+
+	return strings.Join(ll, "\n")
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func Test_nodePie(t *testing.T) {
+	uu := map[string]struct {
+		h            dao.NodeHealth
+		ready, notOK bool
+	}{
+		"all-ready": {
+			h:     dao.NodeHealth{Ready: 3},
+			ready: true,
+		},
+		"none-ready": {
+			h:     dao.NodeHealth{NotReady: 2},
+			notOK: true,
+		},
+		"mixed": {
+			h:     dao.NodeHealth{Ready: 3, NotReady: 1, Cordoned: 1},
+			ready: true,
+			notOK: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll := strings.Split(nodePie(u.h, 3), "\n")
+			assert.Len(t, ll, 2*3+2)
+			pie := strings.Join(ll[:len(ll)-1], "\n")
+			assert.Equal(t, u.ready, strings.Contains(pie, "["+readyColor+"]"))
+			assert.Equal(t, u.notOK, strings.Contains(pie, "["+notReadyColor+"]"))
+			assert.Contains(t, ll[len(ll)-1], "Cordoned")
+		})
+	}
+	assert.Equal(t, "No nodes found", nodePie(dao.NodeHealth{}, 3))
+}
+
+func Test_podPhasesTable(t *testing.T) {
+	ll := strings.Split(podPhasesTable(map[v1.PodPhase]int{
+		v1.PodRunning: 12,
+		v1.PodFailed:  1,
+	}), "\n")
+
+	assert.Len(t, ll, len(podPhases)+1)
+	assert.Contains(t, ll[0], "Running")
+	assert.Contains(t, ll[0], "12")
+	assert.Contains(t, ll[len(ll)-1], "13")
+}
+
+func Test_topNodesTable(t *testing.T) {
+	assert.Equal(t, "No metrics available", topNodesTable(nil, v1.ResourceCPU))
+
+	uu := []dao.NodeUsage{
+		{Name: "n1", Usage: 2 * 1024 * 1024 * 1024, Percent: 50},
+	}
+	assert.Contains(t, topNodesTable(uu, v1.ResourceMemory), "2048Mi")
+	uu = []dao.NodeUsage{
+		{Name: "n1", Usage: 1500, Percent: 75},
+	}
+	assert.Contains(t, topNodesTable(uu, v1.ResourceCPU), "1500m")
+}
+
+func Test_warningsTable(t *testing.T) {
+	assert.Equal(t, "No warnings found", warningsTable(nil, "red"))
+
+	ee := []*v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "fred", Name: "p1"},
+			Reason:         "BackOff",
+			Message:        "Back-off restarting failed container",
+		},
+	}
+	ll := strings.Split(warningsTable(ee, "red"), "\n")
+
+	assert.Len(t, ll, 2)
+	assert.Contains(t, ll[0], "OBJECT")
+	assert.True(t, strings.HasPrefix(ll[1], "[red::]"))
+	assert.Contains(t, ll[1], "pod/fred/p1")
+	assert.Contains(t, ll[1], "BackOff")
+}
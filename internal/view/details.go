@@ -22,6 +22,7 @@ const (
 	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
 	contentTXT      = "text"
 	contentYAML     = "yaml"
+	contentDiff     = "diff"
 )
 
 // Details represents a generic text viewer.
@@ -100,6 +101,8 @@ func (d *Details) TextChanged(lines []string) {
 	switch d.contentType {
 	case contentYAML:
 		d.text.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+	case contentDiff:
+		d.text.SetText(colorizeDiff(d.app.Styles.Frame().Status, strings.Join(lines, "\n")))
 	default:
 		d.text.SetText(strings.Join(lines, "\n"))
 	}
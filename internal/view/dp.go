@@ -4,11 +4,15 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -48,6 +52,73 @@ func (d *Deploy) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
 	})
+	if d.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyZ: ui.NewKeyActionWithOpts("Pause", d.pauseCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+		ui.KeyShiftZ: ui.NewKeyActionWithOpts("Resume", d.resumeCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
+	})
+}
+
+func (d *Deploy) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Pause deployment %s? Spec changes will not be rolled out until resumed.", path)
+	d.confirmRollout("Confirm Pause", msg, path, func(ctx context.Context, dp *dao.Deployment, ns, n string) error {
+		return dp.Pause(ctx, ns, n)
+	}, "paused")
+
+	return nil
+}
+
+func (d *Deploy) resumeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := d.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var dp dao.Deployment
+	dp.Init(d.App().factory, d.GVR())
+	msg := fmt.Sprintf("Resume deployment %s?", path)
+	if pending, err := dp.PendingChanges(path); err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	} else if pending {
+		msg = fmt.Sprintf("Resume deployment %s? Pending pod template changes will be rolled out.", path)
+	}
+	d.confirmRollout("Confirm Resume", msg, path, func(ctx context.Context, dp *dao.Deployment, ns, n string) error {
+		return dp.Resume(ctx, ns, n)
+	}, "resumed")
+
+	return nil
+}
+
+func (d *Deploy) confirmRollout(title, msg, path string, apply func(context.Context, *dao.Deployment, string, string) error, done string) {
+	dlg := d.App().Styles.Dialog()
+	dialog.ShowConfirm(&dlg, d.App().Content.Pages, title, msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), d.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var dp dao.Deployment
+		dp.Init(d.App().factory, d.GVR())
+		ns, n := client.Namespaced(path)
+		if err := apply(ctx, &dp, ns, n); err != nil {
+			d.App().Flash().Err(err)
+			return
+		}
+		d.App().Flash().Infof("Deployment %s %s", path, done)
+	}, func() {})
 }
 
 func (d *Deploy) logOptions(prev bool) (*dao.LogOptions, error) {
@@ -5,10 +5,15 @@ package view
 
 import (
 	"fmt"
+	"log/slog"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 )
@@ -61,12 +66,40 @@ func ShowDrain(view ResourceViewer, sels []string, opts dao.DrainOptions, okFn D
 	f.AddCheckbox("Disable Eviction:", opts.DisableEviction, func(_ string, v bool) {
 		opts.DisableEviction = v
 	})
+	f.AddCheckbox("Dry Run:", opts.DryRun, func(_ string, v bool) {
+		opts.DryRun = v
+	})
+	oo := dao.EvictionOrders()
+	orders := make([]string, 0, len(oo))
+	for _, o := range oo {
+		orders = append(orders, string(o))
+	}
+	f.AddDropDown("Eviction Order:", orders, max(slices.Index(oo, opts.EvictionOrder), 0), func(_ string, idx int) {
+		opts.EvictionOrder = oo[idx]
+	})
+	if dd, ok := f.GetFormItemByLabel("Eviction Order:").(*tview.DropDown); ok {
+		dd.SetListStyles(
+			styles.FgColor.Color(), styles.BgColor.Color(),
+			styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+		)
+	}
 
+	var (
+		leaders             []string
+		checked, ackLeaders bool
+	)
 	pages := view.App().Content.Pages
 	f.AddButton("Cancel", func() {
 		DismissDrain(view, pages)
 	})
 	f.AddButton("OK", func() {
+		if !checked {
+			view.App().Flash().Warn("Still checking the nodes leader elections...")
+			return
+		}
+		if !leadersAcked(view, leaders, ackLeaders) {
+			return
+		}
 		DismissDrain(view, pages)
 		okFn(view, sels, opts)
 	})
@@ -79,7 +112,31 @@ func ShowDrain(view ResourceViewer, sels []string, opts dao.DrainOptions, okFn D
 		path += fmt.Sprintf("(%d) nodes", len(sels))
 	}
 	path += "?"
-	modal.SetText(path)
+	modal.SetText(path + "\nSimulating drain...")
+	go func() {
+		sim, ll, unsafe := drainSimulation(view, sels), leaderElectionsOn(view, sels), unsafeToEvictPods(view, sels)
+		view.App().QueueUpdateDraw(func() {
+			leaders, checked = ll, true
+			if len(leaders) > 0 {
+				f.AddCheckbox("Ack Leaders:", false, func(_ string, v bool) {
+					ackLeaders = v
+				})
+				sim += "\nLeader elections held (Ack Leaders to drain anyway):\n" + strings.Join(leaders, "\n")
+			}
+			if len(unsafe) > 0 {
+				f.AddButton("Mark Safe & Drain", func() {
+					if !leadersAcked(view, leaders, ackLeaders) {
+						return
+					}
+					DismissDrain(view, pages)
+					opts.MarkSafeToEvict = true
+					okFn(view, sels, opts)
+				})
+				sim += "\nNot safe to evict (Mark Safe & Drain to evict anyway):\n" + strings.Join(unsafe, "\n")
+			}
+			modal.SetText(path + sim)
+		})
+	}()
 	modal.SetDoneFunc(func(int, string) {
 		DismissDrain(view, pages)
 	})
@@ -98,6 +155,88 @@ func DismissDrain(v ResourceViewer, p *ui.Pages) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// drainSimulation lists the PDBs protecting pods on the nodes about to be drained along with
+// the pods whose eviction would violate one when draining all the nodes.
+func drainSimulation(view ResourceViewer, sels []string) string {
+	var no dao.Node
+	no.Init(view.App().factory, client.NodeGVR)
+
+	sim, err := no.SimulateDrain(sels...)
+	if err != nil {
+		slog.Warn("Unable to simulate node drain", slogs.Error, err)
+		return ""
+	}
+
+	return drainSimulationText(sim)
+}
+
+func drainSimulationText(sim *dao.DrainSimulation) string {
+	if len(sim.PDBs) == 0 {
+		return ""
+	}
+	ll := make([]string, 0, len(sim.PDBs))
+	for _, pdb := range sim.PDBs {
+		ll = append(ll, fmt.Sprintf("%s (disruptionsAllowed: %d)", client.FQN(pdb.Namespace, pdb.Name), pdb.Status.DisruptionsAllowed))
+	}
+	text := "\nPDBs:\n" + strings.Join(ll, "\n")
+	if len(sim.BlockedPods) == 0 {
+		return text
+	}
+
+	ll = ll[:0]
+	for _, b := range sim.BlockedPods {
+		ll = append(ll, fmt.Sprintf("%s blocked by %s (disruptions: %d/%d)", b.Pod, b.PDBName, b.CurrentDisruptions, b.MaxDisruptions))
+	}
+
+	return text + fmt.Sprintf("\nPDB violations (%d pods can be evicted safely):\n", len(sim.SafePods)) + strings.Join(ll, "\n")
+}
+
+// leaderElectionsOn returns the leader elections held by the nodes about to be drained.
+func leaderElectionsOn(view ResourceViewer, sels []string) []string {
+	var no dao.Node
+	no.Init(view.App().factory, client.NodeGVR)
+
+	ee, err := no.CheckLeaderElections(sels...)
+	if err != nil {
+		slog.Warn("Unable to check nodes leader elections", slogs.Error, err)
+	}
+	ll := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ll = append(ll, e.Node+": "+e.String())
+	}
+	slices.Sort(ll)
+
+	return ll
+}
+
+// unsafeToEvictPods returns the pods annotated as not safe to evict on the nodes about to be drained.
+func unsafeToEvictPods(view ResourceViewer, sels []string) []string {
+	var no dao.Node
+	no.Init(view.App().factory, client.NodeGVR)
+
+	var fqns []string
+	for _, sel := range sels {
+		pp, err := no.GetUnsafeToEvictPods(sel)
+		if err != nil {
+			slog.Warn("Unable to check pods eviction safety", slogs.ResName, sel, slogs.Error, err)
+			continue
+		}
+		fqns = append(fqns, pp...)
+	}
+
+	return fqns
+}
+
+// leadersAcked checks the leader elections held by the drained nodes were acknowledged.
+func leadersAcked(view ResourceViewer, leaders []string, ack bool) bool {
+	if len(leaders) == 0 || ack {
+		return true
+	}
+	view.App().Flash().Warn("Leader elections would be disrupted. Check Ack Leaders to drain anyway")
+
+	return false
+}
+
 func asDurOpt(v string) (time.Duration, error) {
 	d, err := time.ParseDuration(v)
 	if err != nil {
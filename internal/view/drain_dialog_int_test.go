@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDrainSimulationText(t *testing.T) {
+	pdb := policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pdb1"},
+		Status:     policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 1},
+	}
+
+	uu := map[string]struct {
+		sim dao.DrainSimulation
+		e   string
+	}{
+		"none": {},
+		"safe": {
+			sim: dao.DrainSimulation{
+				SafePods: []string{"ns1/p1"},
+				PDBs:     []*policyv1.PodDisruptionBudget{&pdb},
+			},
+			e: "\nPDBs:\nns1/pdb1 (disruptionsAllowed: 1)",
+		},
+		"blocked": {
+			sim: dao.DrainSimulation{
+				SafePods: []string{"ns1/p1"},
+				BlockedPods: []dao.BlockedPod{
+					{Pod: "ns1/p2", PDBName: "ns1/pdb1", CurrentDisruptions: 1, MaxDisruptions: 1},
+				},
+				PDBs: []*policyv1.PodDisruptionBudget{&pdb},
+			},
+			e: "\nPDBs:\nns1/pdb1 (disruptionsAllowed: 1)\nPDB violations (1 pods can be evicted safely):\nns1/p2 blocked by ns1/pdb1 (disruptions: 1/1)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, drainSimulationText(&u.sim))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+)
+
+const helmDiffTitle = "Helm Diff"
+
+// helmReleaseDiff diffs all live resources of a release against the release rendered manifest.
+func helmReleaseDiff(f dao.Factory, ns, name string) (string, error) {
+	var h dao.HelmDAO
+	h.Init(f, client.HmGVR)
+
+	rel, err := h.GetRelease(ns, name)
+	if err != nil {
+		return "", err
+	}
+	oo, missing, err := h.LiveObjects(rel)
+	if err != nil {
+		return "", err
+	}
+
+	var buff strings.Builder
+	for _, m := range missing {
+		fmt.Fprintf(&buff, "# %s is missing from the cluster\n", m)
+	}
+	for _, o := range oo {
+		diff, err := h.DiffWithLive(rel, o)
+		if err != nil {
+			return "", err
+		}
+		buff.WriteString(diff)
+	}
+	if buff.Len() == 0 {
+		return fmt.Sprintf("No drift detected for release %s revision %d", client.FQN(rel.Namespace, rel.Name), rel.Revision), nil
+	}
+
+	return buff.String(), nil
+}
+
+func showHelmDiff(app *App, ns, name string) {
+	diff, err := helmReleaseDiff(app.factory, ns, name)
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		details := NewDetails(app, helmDiffTitle, client.FQN(ns, name), contentDiff, true).Update(diff)
+		if err := app.inject(details, false); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
+
+// colorizeDiff colors unified diff additions, deletions and hunk headers.
+func colorizeDiff(st config.Status, raw string) string {
+	lines := strings.Split(tview.Escape(raw), "\n")
+	for i, l := range lines {
+		var c config.Color
+		switch {
+		case strings.HasPrefix(l, "+++"), strings.HasPrefix(l, "---"):
+			c = st.HighlightColor
+		case strings.HasPrefix(l, "@@"):
+			c = st.PendingColor
+		case strings.HasPrefix(l, "+"):
+			c = st.AddColor
+		case strings.HasPrefix(l, "-"):
+			c = st.ErrorColor
+		case strings.HasPrefix(l, "#"):
+			c = st.KillColor
+		default:
+			continue
+		}
+		lines[i] = "[" + c.String() + "::]" + l + "[-::]"
+	}
+
+	return strings.Join(lines, "\n")
+}
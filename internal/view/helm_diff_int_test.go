@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_colorizeDiff(t *testing.T) {
+	st := config.NewStyles().Frame().Status
+	diff := "--- a\n+++ b\n@@ -1 +1 @@\n-x: [1]\n+x: 2\n same"
+
+	assert.Equal(t,
+		"["+st.HighlightColor.String()+"::]--- a[-::]\n"+
+			"["+st.HighlightColor.String()+"::]+++ b[-::]\n"+
+			"["+st.PendingColor.String()+"::]@@ -1 +1 @@[-::]\n"+
+			"["+st.ErrorColor.String()+"::]-x: [1[][-::]\n"+
+			"["+st.AddColor.String()+"::]+x: 2[-::]\n"+
+			" same",
+		colorizeDiff(st, diff),
+	)
+}
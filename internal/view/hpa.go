@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	scaleUpColor   = "green"
+	scaleDownColor = "orange"
+)
+
+// HorizontalPodAutoscaler represents a horizontal pod autoscaler viewer.
+type HorizontalPodAutoscaler struct {
+	ResourceViewer
+}
+
+// NewHorizontalPodAutoscaler returns a new viewer.
+func NewHorizontalPodAutoscaler(gvr *client.GVR) ResourceViewer {
+	h := HorizontalPodAutoscaler{
+		ResourceViewer: NewScaleExtender(NewOwnerExtender(NewBrowser(gvr))),
+	}
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *HorizontalPodAutoscaler) bindKeys(aa *ui.KeyActions) {
+	aa.Add(ui.KeyH, ui.NewKeyAction("Scale History", h.scaleHistoryCmd, true))
+}
+
+func (h *HorizontalPodAutoscaler) scaleHistoryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	res, err := dao.AccessorFor(h.App().factory, h.GVR())
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	hpa, ok := res.(*dao.HorizontalPodAutoscaler)
+	if !ok {
+		h.App().Flash().Errf("expecting a hpa resource but got %T", res)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), h.App().Conn().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(path)
+	ss, err := hpa.GetScaleHistory(ctx, ns, n)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+
+	details := NewDetails(h.App(), "Scale History", path, contentTXT, true).Update(scaleHistoryTable(ss))
+	if err := h.App().inject(details, false); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// scaleHistoryTable renders autoscaler scale events as a timeline.
+func scaleHistoryTable(ss []dao.ScaleEvent) string {
+	if len(ss) == 0 {
+		return "No scale events found"
+	}
+
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIME\tAGE\tREPLICAS\tCHANGE\tREASON")
+	for _, s := range ss {
+		from := "?"
+		if s.From != dao.UnknownReplicas {
+			from = strconv.Itoa(s.From)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s → %d\t%s\t%s\n",
+			s.Timestamp.Format(time.DateTime),
+			render.ToAge(metav1.Time{Time: s.Timestamp}),
+			from,
+			s.To,
+			scaleChange(s),
+			s.Reason,
+		)
+	}
+	_ = w.Flush()
+
+	ll := strings.Split(strings.TrimSuffix(buff.String(), "\n"), "\n")
+	for i := 1; i < len(ll); i++ {
+		ll[i] = tview.Escape(ll[i])
+		if c := scaleColor(ss[i-1]); c != "" {
+			ll[i] = "[" + c + "::]" + ll[i] + "[-::]"
+		}
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func scaleChange(s dao.ScaleEvent) string {
+	switch {
+	case s.From == dao.UnknownReplicas:
+		return ""
+	case s.To > s.From:
+		return "▲ +" + strconv.Itoa(s.To-s.From)
+	case s.To < s.From:
+		return "▼ -" + strconv.Itoa(s.From-s.To)
+	default:
+		return "="
+	}
+}
+
+func scaleColor(s dao.ScaleEvent) string {
+	switch {
+	case s.From == dao.UnknownReplicas:
+		return ""
+	case s.To > s.From:
+		return scaleUpColor
+	case s.To < s.From:
+		return scaleDownColor
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_scaleHistoryTable(t *testing.T) {
+	assert.Equal(t, "No scale events found", scaleHistoryTable(nil))
+
+	t0 := time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+	ss := []dao.ScaleEvent{
+		{Timestamp: t0, From: dao.UnknownReplicas, To: 2, Reason: "cpu above target"},
+		{Timestamp: t0.Add(time.Minute), From: 2, To: 5, Reason: "cpu above target"},
+		{Timestamp: t0.Add(time.Hour), From: 5, To: 3, Reason: "All metrics below target"},
+	}
+	ll := strings.Split(scaleHistoryTable(ss), "\n")
+
+	assert.Len(t, ll, 4)
+	assert.Contains(t, ll[0], "REPLICAS")
+	assert.Contains(t, ll[1], "2024-01-02 10:00:00")
+	assert.Contains(t, ll[1], "? → 2")
+	assert.NotContains(t, ll[1], "[")
+	assert.True(t, strings.HasPrefix(ll[2], "[green::]"))
+	assert.Contains(t, ll[2], "2 → 5")
+	assert.Contains(t, ll[2], "▲ +3")
+	assert.True(t, strings.HasPrefix(ll[3], "[orange::]"))
+	assert.Contains(t, ll[3], "▼ -2")
+}
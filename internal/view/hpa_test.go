@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHorizontalPodAutoscalerNew(t *testing.T) {
+	h := view.NewHorizontalPodAutoscaler(client.HpaV2GVR)
+
+	require.NoError(t, h.Init(makeCtx(t)))
+	assert.Equal(t, "HorizontalPodAutoscalers", h.Name())
+	assert.Len(t, h.Hints(), 8)
+}
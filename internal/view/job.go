@@ -4,11 +4,13 @@
 package view
 
 import (
+	"context"
 	"errors"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -29,12 +31,57 @@ func NewJob(gvr *client.GVR) ResourceViewer {
 			NewLogsExtender(NewBrowser(gvr), j.logOptions),
 		),
 	)
+	j.AddBindKeysFn(j.bindKeys)
 	j.GetTable().SetEnterFn(j.showPods)
 	j.GetTable().SetSortCol("AGE", true)
 
 	return &j
 }
 
+func (j *Job) bindKeys(aa *ui.KeyActions) {
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftL: ui.NewKeyAction("Aggregate Logs", j.aggregateLogsCmd(false), true),
+		ui.KeyShiftM: ui.NewKeyAction("Aggregate Logs Merged", j.aggregateLogsCmd(true), true),
+	})
+}
+
+// aggregateLogsCmd shows the logs of all the selected job pods. Merged logs are
+// interleaved by timestamp once all the pods logs are read.
+func (j *Job) aggregateLogsCmd(merge bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := j.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		d := NewDetails(j.App(), "Aggregated Logs", path, contentTXT, true)
+		if err := j.App().inject(d, false); err != nil {
+			j.App().Flash().Err(err)
+			return nil
+		}
+
+		var job dao.Job
+		job.Init(j.App().factory, client.JobGVR)
+		cfg := j.App().Config.K9s.Logger
+		opts := dao.LogOptions{
+			Lines:         cfg.TailCount,
+			SinceSeconds:  cfg.SinceSeconds,
+			ShowTimestamp: cfg.ShowTime,
+			Merge:         merge,
+		}
+		ns, n := client.Namespaced(path)
+		go func() {
+			err := job.AggregateLogs(context.Background(), ns, n, opts, queuedWriter{app: j.App(), w: d.GetWriter()})
+			if err != nil {
+				j.App().QueueUpdateDraw(func() {
+					j.App().Flash().Err(err)
+				})
+			}
+		}()
+
+		return nil
+	}
+}
+
 func (*Job) showPods(app *App, _ ui.Tabular, gvr *client.GVR, path string) {
 	o, err := app.factory.Get(gvr, path, true, labels.Everything())
 	if err != nil {
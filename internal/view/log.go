@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
@@ -35,6 +36,8 @@ const (
 	defaultFlushTimeout = 50 * time.Millisecond
 )
 
+var searchRegionRX = regexp.MustCompile(`\["search_\d+"\]|\[""\]`)
+
 // Log represents a generic log viewer.
 type Log struct {
 	*tview.Flex
@@ -49,6 +52,8 @@ type Log struct {
 	mx                sync.Mutex
 	follow            bool
 	requestOneRefresh bool
+	currentRegion     int
+	maxRegions        int
 }
 
 var _ model.Component = (*Log)(nil)
@@ -141,6 +146,7 @@ func (l *Log) LogResume() {
 func (l *Log) LogCleared() {
 	l.app.QueueUpdateDraw(func() {
 		l.logs.Clear()
+		l.resetSearch()
 	})
 }
 
@@ -169,6 +175,13 @@ func (l *Log) LogChanged(lines [][]byte) {
 
 // BufferCompleted indicates input was accepted.
 func (l *Log) BufferCompleted(text, _ string) {
+	if l.indicator.SearchMode() {
+		l.app.QueueUpdateDraw(func() {
+			l.search(text)
+			l.updateTitle()
+		})
+		return
+	}
 	l.model.Filter(text)
 	l.updateTitle()
 }
@@ -257,6 +270,9 @@ func (l *Log) bindKeys() {
 		ui.KeyShiftC:    ui.NewKeyAction("Clear", l.clearCmd, true),
 		ui.KeyM:         ui.NewKeyAction("Mark", l.markCmd, true),
 		ui.KeyS:         ui.NewKeyAction("Toggle AutoScroll", l.toggleAutoScrollCmd, true),
+		ui.KeyShiftS:    ui.NewKeyAction("Toggle Search", l.toggleSearchCmd, true),
+		ui.KeyN:         ui.NewKeyAction("Next Match", l.nextMatchCmd, false),
+		ui.KeyShiftN:    ui.NewKeyAction("Prev Match", l.prevMatchCmd, false),
 		ui.KeyF:         ui.NewKeyAction("Toggle FullScreen", l.toggleFullScreenCmd, true),
 		ui.KeyT:         ui.NewKeyAction("Toggle Timestamp", l.toggleTimestampCmd, true),
 		ui.KeyW:         ui.NewKeyAction("Toggle Wrap", l.toggleTextWrapCmd, true),
@@ -277,12 +293,113 @@ func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	l.logs.cmdBuff.Reset()
 	l.logs.cmdBuff.SetActive(false)
-	l.model.Filter(l.logs.cmdBuff.GetText())
+	if l.indicator.SearchMode() {
+		l.search(l.logs.cmdBuff.GetText())
+	} else {
+		l.model.Filter(l.logs.cmdBuff.GetText())
+	}
+	l.updateTitle()
+
+	return nil
+}
+
+// search highlights the log lines matching the given regex.
+func (l *Log) search(q string) {
+	text := searchRegionRX.ReplaceAllString(strings.TrimSuffix(l.logs.GetText(false), "\n"), "")
+	if q == "" {
+		l.logs.SetText(text)
+		l.resetSearch()
+		return
+	}
+	rx, err := regexp.Compile(`(?i)` + q)
+	if err != nil {
+		l.app.Flash().Err(err)
+		return
+	}
+	mm := dao.SearchLogs(rx, strings.Split(strings.TrimSuffix(l.logs.GetText(true), "\n"), "\n"))
+	lines, count := searchRegions(strings.Split(text, "\n"), mm)
+	l.logs.SetText(strings.Join(lines, "\n"))
+	l.currentRegion, l.maxRegions = 0, count
+	if count == 0 {
+		l.logs.Highlight()
+		l.indicator.SetSearchStatus("0/0")
+		return
+	}
+	l.follow = false
+	l.gotoRegion()
+}
+
+func (l *Log) resetSearch() {
+	l.currentRegion, l.maxRegions = 0, 0
+	l.logs.Highlight()
+	if l.indicator.SearchMode() {
+		l.indicator.SetSearchStatus("")
+	}
+}
+
+func (l *Log) gotoRegion() {
+	l.logs.Highlight(fmt.Sprintf("search_%d", l.currentRegion))
+	l.logs.ScrollToHighlight()
+	l.indicator.SetSearchStatus(fmt.Sprintf("%d/%d", l.currentRegion+1, l.maxRegions))
+}
+
+func (l *Log) nextMatchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() || l.maxRegions == 0 {
+		return evt
+	}
+	l.currentRegion++
+	if l.currentRegion >= l.maxRegions {
+		l.currentRegion = 0
+	}
+	l.gotoRegion()
+
+	return nil
+}
+
+func (l *Log) prevMatchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() || l.maxRegions == 0 {
+		return evt
+	}
+	l.currentRegion--
+	if l.currentRegion < 0 {
+		l.currentRegion = l.maxRegions - 1
+	}
+	l.gotoRegion()
+
+	return nil
+}
+
+func (l *Log) toggleSearchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+	l.indicator.ToggleSearchMode()
+	l.logs.cmdBuff.Reset()
+	l.resetSearch()
+	l.follow = l.indicator.AutoScroll()
+	l.model.ClearFilter()
 	l.updateTitle()
 
 	return nil
 }
 
+// searchRegions wraps each matching line in a search region and returns the
+// number of regions.
+func searchRegions(lines []string, mm []dao.LogMatch) ([]string, int) {
+	var count int
+	last := -1
+	for _, m := range mm {
+		if m.Line == last || m.Line >= len(lines) {
+			continue
+		}
+		last = m.Line
+		lines[m.Line] = fmt.Sprintf(`["search_%d"]%s[""]`, count, lines[m.Line])
+		count++
+	}
+
+	return lines, count
+}
+
 // SendStrokes (testing only!)
 func (l *Log) SendStrokes(s string) {
 	l.app.Prompt().SendStrokes(s)
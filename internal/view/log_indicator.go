@@ -25,6 +25,8 @@ type LogIndicator struct {
 	showTime                   bool
 	allContainers              bool
 	shouldDisplayAllContainers bool
+	searchMode                 bool
+	searchStatus               string
 }
 
 // NewLogIndicator returns a new indicator.
@@ -105,6 +107,24 @@ func (l *LogIndicator) ToggleAutoScroll() {
 	l.Refresh()
 }
 
+// SearchMode reports the current search mode.
+func (l *LogIndicator) SearchMode() bool {
+	return l.searchMode
+}
+
+// ToggleSearchMode toggles the search mode.
+func (l *LogIndicator) ToggleSearchMode() {
+	l.searchMode = !l.searchMode
+	l.searchStatus = ""
+	l.Refresh()
+}
+
+// SetSearchStatus updates the search matches status.
+func (l *LogIndicator) SetSearchStatus(s string) {
+	l.searchStatus = s
+	l.Refresh()
+}
+
 // ToggleAllContainers toggles the all-containers mode.
 func (l *LogIndicator) ToggleAllContainers() {
 	l.allContainers = !l.allContainers
@@ -158,5 +178,13 @@ func (l *LogIndicator) Refresh() {
 		l.indicator = append(l.indicator, fmt.Sprintf(toggleOffFmt, "Wrap", "")...)
 	}
 
+	if l.searchMode {
+		status := l.searchStatus
+		if status == "" {
+			status = "On"
+		}
+		l.indicator = append(l.indicator, fmt.Sprintf(spacer+toggleFmt+string(l.styles.K9s.Views.Log.Indicator.ToggleOnColor)+"::b]%s[-::]", "Search", status)...)
+	}
+
 	_, _ = l.Write(l.indicator)
 }
@@ -34,6 +34,32 @@ func TestLogIndicatorRefresh(t *testing.T) {
 	}
 }
 
+func TestLogIndicatorSearch(t *testing.T) {
+	defaults := config.NewStyles()
+	uu := map[string]struct {
+		status string
+		e      string
+	}{
+		"on": {
+			e: "[::b]Autoscroll:[limegreen::b]On[-::]      [::b]FullScreen:[gray::d]Off[-::]     [::b]Timestamps:[gray::d]Off[-::]     [::b]Wrap:[gray::d]Off[-::]     [::b]Search:[limegreen::b]On[-::]\n",
+		},
+		"matches": {
+			status: "2/5",
+			e:      "[::b]Autoscroll:[limegreen::b]On[-::]      [::b]FullScreen:[gray::d]Off[-::]     [::b]Timestamps:[gray::d]Off[-::]     [::b]Wrap:[gray::d]Off[-::]     [::b]Search:[limegreen::b]2/5[-::]\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			li := view.NewLogIndicator(config.NewConfig(nil), defaults, false)
+			li.ToggleSearchMode()
+			li.SetSearchStatus(u.status)
+			assert.Equal(t, u.e, li.GetText(false))
+		})
+	}
+}
+
 func BenchmarkLogIndicatorRefresh(b *testing.B) {
 	defaults := config.NewStyles()
 	v := view.NewLogIndicator(config.NewConfig(nil), defaults, true)
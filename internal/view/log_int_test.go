@@ -27,7 +27,7 @@ func TestLogAutoScroll(t *testing.T) {
 	v.GetModel().Set(ii)
 	v.GetModel().Notify()
 
-	assert.Len(t, v.Hints(), 16)
+	assert.Len(t, v.Hints(), 19)
 
 	v.toggleAutoScrollCmd(nil)
 	assert.Equal(t, "Autoscroll:Off     FullScreen:Off     Timestamps:Off     Wrap:Off", v.Indicator().GetText(true))
@@ -141,3 +141,39 @@ func (*logList) LogStop()          {}
 func (*logList) LogResume()        {}
 func (l *logList) LogCleared()     { l.clear++ }
 func (l *logList) LogFailed(error) { l.fail++ }
+
+func TestLogSearch(t *testing.T) {
+	opts := dao.LogOptions{
+		Path:      "fred/p1",
+		Container: "blee",
+	}
+	v := NewLog(client.PodGVR, &opts)
+	require.NoError(t, v.Init(makeContext(t)))
+	v.Logs().SetText("starting\nERROR boom\nok\nerror again")
+
+	v.toggleSearchCmd(nil)
+	v.search("error")
+	assert.Equal(t, 2, v.maxRegions)
+	assert.Equal(t, []string{"search_0"}, v.Logs().GetHighlights())
+	assert.Contains(t, v.Indicator().GetText(true), "Search:1/2")
+
+	v.nextMatchCmd(nil)
+	assert.Equal(t, []string{"search_1"}, v.Logs().GetHighlights())
+	v.nextMatchCmd(nil)
+	assert.Equal(t, []string{"search_0"}, v.Logs().GetHighlights())
+	v.prevMatchCmd(nil)
+	assert.Contains(t, v.Indicator().GetText(true), "Search:2/2")
+
+	v.search("")
+	assert.Equal(t, 0, v.maxRegions)
+	assert.Equal(t, "starting\nERROR boom\nok\nerror again\n", v.Logs().GetText(false))
+}
+
+func TestSearchRegions(t *testing.T) {
+	lines := []string{"a", "b", "c"}
+	mm := []dao.LogMatch{{Line: 0}, {Line: 0, Start: 1}, {Line: 2}, {Line: 5}}
+
+	ll, count := searchRegions(lines, mm)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, []string{`["search_0"]a[""]`, "b", `["search_1"]c[""]`}, ll)
+}
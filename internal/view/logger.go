@@ -38,7 +38,7 @@ func (l *Logger) Init(_ context.Context) error {
 	if l.title != "" {
 		l.SetBorder(true)
 	}
-	l.SetScrollable(true).SetWrap(true)
+	l.SetScrollable(true).SetWrap(true).SetRegions(true)
 	l.SetDynamicColors(true)
 	l.SetHighlightColor(tcell.ColorOrange)
 	l.SetTitleColor(tcell.ColorAqua)
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Maintenance presents scheduled node maintenance windows.
+type Maintenance struct {
+	ResourceViewer
+}
+
+// NewMaintenance returns a new viewer.
+func NewMaintenance(gvr *client.GVR) ResourceViewer {
+	m := Maintenance{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetBorderFocusColor(tcell.ColorPaleGreen)
+	m.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorSeaGreen).Attributes(tcell.AttrNone))
+	m.GetTable().SetSortCol("SCHEDULED", true)
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *Maintenance) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlW)
+	aa.Add(ui.KeyX, ui.NewKeyAction("Cancel", m.cancelCmd, true))
+}
+
+func (m *Maintenance) cancelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := m.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Cancel maintenance of node %s?", sels[0])
+	if len(sels) > 1 {
+		msg = fmt.Sprintf("Cancel maintenance of %d nodes?", len(sels))
+	}
+	d := m.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, m.App().Content.Pages, "Confirm Cancel", msg, func() {
+		for _, sel := range sels {
+			if err := dao.CancelMaintenanceWindow(sel); err != nil {
+				m.App().Flash().Err(err)
+				continue
+			}
+			m.App().Flash().Infof("Maintenance of node %s canceled", sel)
+		}
+		m.GetTable().ClearMarks()
+		m.GetTable().Refresh()
+	}, func() {})
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	maintenanceKey = "maintenance"
+
+	maintenanceTimeFmt  = "2006-01-02 15:04"
+	maintenanceClockFmt = "15:04"
+
+	defaultMaintenanceDelay = time.Hour
+)
+
+// MaintenanceFunc represents a maintenance scheduling callback function.
+type MaintenanceFunc func(v ResourceViewer, sels []string, at time.Time)
+
+// ShowMaintenance pops a dialog prompting for a node maintenance window.
+func ShowMaintenance(view ResourceViewer, sels []string, okFn MaintenanceFunc) {
+	styles := view.App().Styles.Dialog()
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	at := time.Now().Add(defaultMaintenanceDelay).Truncate(time.Minute)
+	f.AddInputField("At:", at.Format(maintenanceTimeFmt), 0, nil, func(v string) {
+		t, err := parseMaintenanceTime(v, time.Now())
+		if err != nil {
+			view.App().Flash().Err(err)
+			return
+		}
+		view.App().Flash().Clear()
+		at = t
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissMaintenance(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissMaintenance(view, pages)
+		okFn(view, sels, at)
+	})
+
+	modal := tview.NewModalForm("<Schedule Maintenance>", f)
+	msg := "Cordon and drain "
+	if len(sels) == 1 {
+		msg += sels[0]
+	} else {
+		msg += fmt.Sprintf("(%d) nodes", len(sels))
+	}
+	msg += " at (time or delay)?"
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		DismissMaintenance(view, pages)
+	})
+
+	pages.AddPage(maintenanceKey, modal, false, true)
+	pages.ShowPage(maintenanceKey)
+	view.App().SetFocus(pages.GetPrimitive(maintenanceKey))
+}
+
+// DismissMaintenance dismiss the maintenance dialog.
+func DismissMaintenance(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(maintenanceKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseMaintenanceTime parses either a delay (ie 90m) or a local time
+// formatted as YYYY-MM-DD HH:MM or HH:MM. Bare times already past refer to the next day.
+func parseMaintenanceTime(v string, now time.Time) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if d, err := time.ParseDuration(strings.TrimPrefix(v, "+")); err == nil {
+		return now.Add(d), nil
+	}
+	if t, err := time.ParseInLocation(maintenanceTimeFmt, v, now.Location()); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation(maintenanceClockFmt, v, now.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid maintenance time %q. Expecting a delay, HH:MM or %s", v, maintenanceTimeFmt)
+	}
+	at := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+	if !at.After(now) {
+		at = at.AddDate(0, 0, 1)
+	}
+
+	return at, nil
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceNew(t *testing.T) {
+	m := view.NewMaintenance(client.MwGVR)
+
+	require.NoError(t, m.Init(makeCtx(t)))
+	assert.Equal(t, "Maintenance", m.Name())
+	assert.Len(t, m.Hints(), 4)
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	policyMatrixTitle = "NetworkPolicy Matrix"
+	policyAllowCell   = "✔"
+	policyDenyCell    = "✘"
+)
+
+// PolicyMatrix presents a namespace pod to pod network policy connectivity grid.
+type PolicyMatrix struct {
+	*tview.Table
+
+	app      *App
+	ns       string
+	actions  *ui.KeyActions
+	matrix   *dao.PolicyMatrix
+	cancelFn context.CancelFunc
+}
+
+// NewPolicyMatrix returns a new network policy matrix view.
+func NewPolicyMatrix(ns string) *PolicyMatrix {
+	return &PolicyMatrix{
+		Table:   tview.NewTable(),
+		ns:      ns,
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func (*PolicyMatrix) SetCommand(*cmd.Interpreter)      {}
+func (*PolicyMatrix) SetFilter(string)                 {}
+func (*PolicyMatrix) SetLabelFilter(map[string]string) {}
+
+// Init initializes the view.
+func (p *PolicyMatrix) Init(ctx context.Context) error {
+	var err error
+	if p.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	p.SetBorder(true)
+	p.SetBorderPadding(0, 0, 1, 1)
+	p.SetTitle(fmt.Sprintf(" %s(%s) [%s]: from ⟶ to ", policyMatrixTitle, p.ns, policyAllowCell+" allowed "+policyDenyCell+" denied"))
+	p.SetFixed(1, 1)
+	p.SetSelectable(true, true)
+	p.SetSelectionChangedFunc(p.selectionChanged)
+	p.app.Styles.AddListener(p)
+	p.StylesChanged(p.app.Styles)
+	p.bindKeys()
+	p.SetInputCapture(p.keyboard)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (p *PolicyMatrix) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.BgColor())
+	p.SetBorderColor(s.Frame().Border.FgColor.Color())
+	p.SetTitleColor(s.Frame().Title.FgColor.Color())
+}
+
+func (p *PolicyMatrix) bindKeys() {
+	p.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.app.PrevCmd, false),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", p.refreshCmd, true),
+	})
+}
+
+func (p *PolicyMatrix) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := p.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (p *PolicyMatrix) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	p.Start()
+
+	return nil
+}
+
+// Start loads the connectivity matrix.
+func (p *PolicyMatrix) Start() {
+	p.Stop()
+
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithTimeout(context.Background(), p.app.Conn().Config().CallTimeout())
+	go p.load(ctx)
+}
+
+// Stop cancels any pending load.
+func (p *PolicyMatrix) Stop() {
+	if p.cancelFn == nil {
+		return
+	}
+	p.cancelFn()
+	p.cancelFn = nil
+}
+
+func (p *PolicyMatrix) load(ctx context.Context) {
+	m, err := p.connectivityMatrix(ctx)
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	p.app.QueueUpdateDraw(func() {
+		if err != nil {
+			p.app.Flash().Err(err)
+			return
+		}
+		p.matrix = m
+		p.render()
+		if len(m.Pods) == 0 {
+			p.app.Flash().Warnf("No pods found in namespace %q", m.Namespace)
+		}
+	})
+}
+
+func (p *PolicyMatrix) connectivityMatrix(ctx context.Context) (*dao.PolicyMatrix, error) {
+	res, err := dao.AccessorFor(p.app.factory, client.NpGVR)
+	if err != nil {
+		return nil, err
+	}
+	np, ok := res.(*dao.NetworkPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expecting a network policy resource but got %T", res)
+	}
+
+	return np.ConnectivityMatrix(ctx, p.ns)
+}
+
+func (p *PolicyMatrix) render() {
+	p.Clear()
+	fg, bg := p.app.Styles.Table().Header.FgColor.Color(), p.app.Styles.Table().Header.BgColor.Color()
+	p.SetCell(0, 0, tview.NewTableCell("FROM \\ TO").
+		SetTextColor(fg).SetBackgroundColor(bg).SetSelectable(false))
+	for i, po := range p.matrix.Pods {
+		p.SetCell(0, i+1, tview.NewTableCell(strconv.Itoa(i+1)).
+			SetTextColor(fg).SetBackgroundColor(bg).SetAlign(tview.AlignCenter).SetSelectable(false))
+		p.SetCell(i+1, 0, tview.NewTableCell(strconv.Itoa(i+1)+" "+po).
+			SetTextColor(fg).SetBackgroundColor(bg).SetSelectable(false))
+	}
+	for from := range p.matrix.Pods {
+		for to := range p.matrix.Pods {
+			p.SetCell(from+1, to+1, policyCell(p.matrix.Allows(from, to)))
+		}
+	}
+	if len(p.matrix.Pods) > 0 {
+		p.Select(1, 1)
+	}
+}
+
+func policyCell(allowed bool) *tview.TableCell {
+	c := tview.NewTableCell(policyDenyCell).SetTextColor(tcell.ColorOrangeRed)
+	if allowed {
+		c = tview.NewTableCell(policyAllowCell).SetTextColor(tcell.ColorGreen)
+	}
+
+	return c.SetAlign(tview.AlignCenter)
+}
+
+func (p *PolicyMatrix) selectionChanged(r, c int) {
+	if p.matrix == nil {
+		return
+	}
+	from, to := r-1, c-1
+	if from < 0 || to < 0 || from >= len(p.matrix.Pods) || to >= len(p.matrix.Pods) {
+		return
+	}
+	state := "denied"
+	if p.matrix.Allows(from, to) {
+		state = "allowed"
+	}
+	p.app.Flash().Infof("%s ⟶ %s: %s", p.matrix.Pods[from], p.matrix.Pods[to], state)
+}
+
+// Name returns the component name.
+func (*PolicyMatrix) Name() string {
+	return policyMatrixTitle
+}
+
+// InCmdMode checks if prompt is active.
+func (*PolicyMatrix) InCmdMode() bool {
+	return false
+}
+
+// Hints returns menu hints.
+func (p *PolicyMatrix) Hints() model.MenuHints {
+	return p.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*PolicyMatrix) ExtraHints() map[string]string {
+	return nil
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyMatrixNew(t *testing.T) {
+	m := view.NewPolicyMatrix("fred")
+
+	require.NoError(t, m.Init(makeCtx(t)))
+	assert.Equal(t, "NetworkPolicy Matrix", m.Name())
+	assert.Len(t, m.Hints(), 2)
+}
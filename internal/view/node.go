@@ -4,30 +4,95 @@
 package view
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"maps"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/export"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/fatih/color"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/yaml"
 )
 
 // Node represents a node view.
 type Node struct {
 	ResourceViewer
+
+	mx       sync.Mutex
+	cancelFn context.CancelFunc
+	changes  *render.NodeChanges
+	ages     *render.NodeAges
+	archs    *render.NodeArchs
+	gpuOnly  bool
+	// labelText tracks the filter text the cached selector was parsed from.
+	labelText string
+	labelSel  labels.Selector
+}
+
+const (
+	// powerCycleTimeout tracks how long to wait for a power cycled node replacement.
+	powerCycleTimeout = 15 * time.Minute
+
+	// topPodsCount tracks how many pods are listed in the node top pods view.
+	topPodsCount = 10
+
+	// systemPodsColor tracks the color of kube-system and DaemonSet pods.
+	systemPodsColor = "aqua"
+)
+
+// nodeRowRenderer renders single node rows on watch updates.
+type nodeRowRenderer interface {
+	model1.Renderer
+
+	MergeLiveFields(h model1.Header, old, r *model1.Row)
+}
+
+// nodeVerbKeys tracks the node actions requiring a given access verb.
+var nodeVerbKeys = map[string][]tcell.Key{
+	client.GetVerb:    {ui.KeyY, ui.KeyX},
+	client.PatchVerb:  {ui.KeyC, ui.KeyU, ui.KeyI, ui.KeyShiftU, ui.KeyR, ui.KeyL, ui.KeyM},
+	client.DeleteVerb: {tcell.KeyCtrlD, tcell.KeyCtrlK, ui.KeyP},
 }
 
 // NewNode returns a new node view.
 func NewNode(gvr *client.GVR) ResourceViewer {
 	n := Node{
 		ResourceViewer: NewBrowser(gvr),
+		changes:        render.NewNodeChanges(render.DefaultHighlightDuration),
+		ages:           render.NewNodeAges(config.DefaultNodeAgeWarn, config.DefaultNodeAgeCrit),
+		archs:          render.NewNodeArchs(),
 	}
 	n.AddBindKeysFn(n.bindKeys)
 	n.GetTable().SetEnterFn(n.showPods)
@@ -36,10 +101,283 @@ func NewNode(gvr *client.GVR) ResourceViewer {
 	return &n
 }
 
+// NewGPUNode returns a new node view listing GPU nodes only.
+func NewGPUNode(gvr *client.GVR) ResourceViewer {
+	v := NewNode(gvr)
+	if n, ok := v.(*Node); ok {
+		n.gpuOnly = true
+	}
+
+	return v
+}
+
+// Start initializes the view updates, node watch and readiness notifications.
+func (n *Node) Start() {
+	dao.NodeMetricsHistory.SetDepth(n.App().Config.K9s.UI.SparklinesHistoryDepth())
+	n.ages = render.NewNodeAges(n.App().Config.K9s.UI.NodeAgeLimits())
+	n.GetTable().SetDecorateFn(n.trackChanges)
+	n.GetTable().SetCellColorerFn(n.cellColor)
+	n.GetTable().SetColGroup(model1.NetMXGroup, n.networkMetrics())
+	n.GetTable().SetColGroup(model1.CertGroup, n.certExpiry())
+	n.GetTable().SetColGroup(model1.CVEGroup, client.KernelCVEFeed() != nil)
+	n.GetTable().CmdBuff().AddListener(n)
+	n.restoreLabelFilter()
+	n.ResourceViewer.Start()
+	n.watchReadiness()
+}
+
+// Stop terminates the view updates, node watch and readiness notifications.
+func (n *Node) Stop() {
+	n.mx.Lock()
+	if n.cancelFn != nil {
+		n.cancelFn()
+		n.cancelFn = nil
+	}
+	n.mx.Unlock()
+	n.GetTable().CmdBuff().RemoveListener(n)
+	n.ResourceViewer.Stop()
+}
+
+func (n *Node) watchReadiness() {
+	if !n.App().ConOK() {
+		return
+	}
+	nd, err := n.nodeDAO()
+	if err != nil {
+		slog.Error("Node readiness watch failed", slogs.Error, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	n.mx.Lock()
+	if n.cancelFn != nil {
+		n.cancelFn()
+	}
+	n.cancelFn = cancel
+	n.mx.Unlock()
+
+	n.watchNodes(ctx)
+	events := make(chan dao.NodeReadinessEvent)
+	go func() {
+		if err := nd.WatchReadinessChanges(ctx, events); err != nil {
+			slog.Warn("Node readiness watch failed", slogs.Error, err)
+		}
+	}()
+	go func() {
+		for evt := range events {
+			slog.Info("Node readiness changed",
+				slogs.ResName, evt.Name,
+				slogs.Ready, evt.NewReady,
+				slogs.Timestamp, evt.Timestamp,
+			)
+			n.App().QueueUpdateDraw(func() {
+				if evt.NewReady {
+					n.App().Flash().Infof("Node %s is now Ready (%s)", evt.Name, evt.Timestamp.Format(time.TimeOnly))
+					return
+				}
+				n.App().Flash().Warnf("Node %s is now NotReady (%s)", evt.Name, evt.Timestamp.Format(time.TimeOnly))
+			})
+		}
+	}()
+}
+
+// watchNodes updates modified node rows in place in between refresh cycles
+// using the shared node informer.
+func (n *Node) watchNodes(ctx context.Context) {
+	inf, err := n.App().factory.CanForResource(client.ClusterScope, client.NodeGVR, client.ListAccess)
+	if err != nil || inf == nil {
+		slog.Warn("Node watch failed", slogs.Error, err)
+		return
+	}
+	reg, err := inf.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(prev, cur any) {
+			no, ok := cur.(*unstructured.Unstructured)
+			if !ok {
+				return
+			}
+			if o, ok := prev.(*unstructured.Unstructured); ok && o.GetResourceVersion() == no.GetResourceVersion() {
+				return
+			}
+			n.App().QueueUpdateDraw(func() {
+				n.updateNodeRow(no)
+			})
+		},
+	})
+	if err != nil {
+		slog.Warn("Node watch failed", slogs.Error, err)
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		if err := inf.Informer().RemoveEventHandler(reg); err != nil {
+			slog.Warn("Node watch removal failed", slogs.Error, err)
+		}
+	}()
+}
+
+// updateNodeRow re-renders a modified node row without rebuilding the table.
+// Metrics and pods columns are retained until the next refresh cycle.
+func (n *Node) updateNodeRow(no *unstructured.Unstructured) {
+	t := n.GetTable()
+	if vs := t.GetViewSetting(); vs != nil && !vs.IsBlank() {
+		return
+	}
+	old := t.GetSelectedRow(client.FQN("", no.GetName()))
+	if old == nil {
+		return
+	}
+
+	re := n.nodeRenderer()
+	r := model1.NewRow(len(old.Fields))
+	nwm := render.NodeWithMetrics{Raw: no.DeepCopy(), PodCount: -1}
+	if err := re.Render(&nwm, client.ClusterScope, &r); err != nil {
+		slog.Error("Unable to render node", slogs.ResName, no.GetName(), slogs.Error, err)
+		return
+	}
+	h := re.Header(client.ClusterScope)
+	re.MergeLiveFields(h, old, &r)
+	t.UpdateRow(model1.RowEvent{Kind: model1.EventUpdate, Row: r}, h)
+}
+
+func (n *Node) trackChanges(td *model1.TableData) {
+	if n.App().Config.K9s.UI.HighlightChanges {
+		n.changes.Update(td, time.Now())
+	}
+	n.ages.Update(td)
+	n.archs.Update(td)
+	n.GetTable().SetColGroup(model1.GPUGroup, render.HasGPUNodes(td))
+}
+
+// nodeRenderer returns the renderer matching the accessibility setting.
+func (n *Node) nodeRenderer() nodeRowRenderer {
+	if n.App().Config.K9s.IsA11y() {
+		return new(render.AccessibleNodeRenderer)
+	}
+
+	return new(render.Node)
+}
+
+// cellColor flags recently changed cells, falling back to the node age and architecture colors.
+// Cells are not colored in accessibility mode.
+func (n *Node) cellColor(id string, col int) (tcell.Color, bool) {
+	if n.App().Config.K9s.IsA11y() {
+		return tcell.ColorDefault, false
+	}
+	if n.App().Config.K9s.UI.HighlightChanges && n.changes.Changed(id, col, time.Now()) {
+		return render.ChangedColor, true
+	}
+
+	if c, ok := n.ages.Color(id, col); ok {
+		return c, true
+	}
+
+	return n.archs.Color(id, col)
+}
+
 func (n *Node) nodeContext(ctx context.Context) context.Context {
+	if sel := n.labelSelector(); sel != nil {
+		ctx = context.WithValue(ctx, internal.KeyNodeSelector, sel)
+	}
+	ctx = context.WithValue(ctx, internal.KeyNetworkMetrics, n.networkMetrics())
+	ctx = context.WithValue(ctx, internal.KeyCertExpiry, n.certExpiry())
+	if n.gpuOnly {
+		ctx = context.WithValue(ctx, internal.KeyGPUNodes, true)
+	}
+
 	return context.WithValue(ctx, internal.KeyPodCounting, !n.App().Config.K9s.DisablePodCounting)
 }
 
+// restoreLabelFilter restores the persisted node label filter into the filter bar.
+func (n *Node) restoreLabelFilter() {
+	if !n.GetTable().CmdBuff().Empty() {
+		return
+	}
+	if sel := n.App().Config.NodeLabelFilter(); sel != "" {
+		n.GetTable().CmdBuff().SetText("-l "+sel, "")
+		n.GetTable().GetModel().SetLabelFilter(sel)
+	}
+}
+
+// labelSelector returns the cached filter bar label selector scoping this view node listings.
+// Returns nil when no valid selector is set.
+func (n *Node) labelSelector() labels.Selector {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+
+	return n.labelSel
+}
+
+// BufferCompleted parses and persists the node label selector when the filter changes.
+func (n *Node) BufferCompleted(text, _ string) {
+	n.mx.Lock()
+	if text == n.labelText {
+		n.mx.Unlock()
+		return
+	}
+	n.labelText = text
+	sel, strSel, err := parseNodeLabelFilter(text)
+	n.labelSel = sel
+	n.mx.Unlock()
+
+	if err != nil {
+		n.App().Flash().Errf("Invalid node label selector %q: %s", strSel, err)
+		return
+	}
+	if sel != nil {
+		n.App().Config.SetNodeLabelFilter(strSel)
+	}
+}
+
+// BufferChanged indicates the buffer was changed.
+func (*Node) BufferChanged(_, _ string) {}
+
+// BufferActive indicates the buff activity changed.
+func (*Node) BufferActive(bool, model.BufferKind) {}
+
+// parseNodeLabelFilter parses a filter bar label selector.
+// Returns a nil selector when the filter is not a label selector.
+func parseNodeLabelFilter(text string) (labels.Selector, string, error) {
+	if !internal.IsLabelSelector(text) {
+		return nil, "", nil
+	}
+	strSel := ui.TrimLabelSelector(text)
+	sel, err := labels.Parse(strSel)
+	if err != nil {
+		return nil, strSel, err
+	}
+
+	return sel, strSel, nil
+}
+
+// clearLabelFilterCmd drops the persisted node label filter before resetting the filter bar.
+func (n *Node) clearLabelFilterCmd(reset ui.ActionHandler) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if internal.IsLabelSelector(n.GetTable().CmdBuff().GetText()) {
+			n.App().Config.SetNodeLabelFilter("")
+		}
+
+		return reset(evt)
+	}
+}
+
+func (n *Node) networkMetrics() bool {
+	ct, err := n.App().Config.K9s.ActiveContext()
+	if err != nil {
+		return false
+	}
+
+	return ct.FeatureGates.NodeNetworkMetrics
+}
+
+func (n *Node) certExpiry() bool {
+	ct, err := n.App().Config.K9s.ActiveContext()
+	if err != nil {
+		return false
+	}
+
+	return ct.FeatureGates.NodeCertExpiry
+}
+
 func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyC: ui.NewKeyActionWithOpts(
@@ -58,6 +396,22 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Dangerous: true,
 			},
 		),
+		ui.KeyI: ui.NewKeyActionWithOpts(
+			"Isolate",
+			n.isolateCmd(true),
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		),
+		ui.KeyShiftU: ui.NewKeyActionWithOpts(
+			"Deisolate",
+			n.isolateCmd(false),
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		),
 		ui.KeyR: ui.NewKeyActionWithOpts(
 			"Drain",
 			n.drainCmd,
@@ -66,6 +420,30 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 				Dangerous: true,
 			},
 		),
+		ui.KeyL: ui.NewKeyActionWithOpts(
+			"Labels",
+			n.labelsCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		),
+		ui.KeyM: ui.NewKeyActionWithOpts(
+			"Schedule Maintenance",
+			n.maintenanceCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		),
+		ui.KeyP: ui.NewKeyActionWithOpts(
+			"Power Cycle",
+			n.powerCycleCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			},
+		),
 	})
 	ct, err := n.App().Config.K9s.ActiveContext()
 	if err != nil {
@@ -75,6 +453,9 @@ func (n *Node) bindDangerousKeys(aa *ui.KeyActions) {
 	if ct.FeatureGates.NodeShell {
 		aa.Add(ui.KeyS, ui.NewKeyAction("Shell", n.sshCmd, true))
 	}
+	if ct.SSH != nil {
+		aa.Add(ui.KeyH, ui.NewKeyAction("SSH", n.nodeSSHCmd, true))
+	}
 }
 
 func (n *Node) bindKeys(aa *ui.KeyActions) {
@@ -84,15 +465,96 @@ func (n *Node) bindKeys(aa *ui.KeyActions) {
 
 	aa.Bulk(ui.KeyMap{
 		ui.KeyY:      ui.NewKeyAction(yamlAction, n.yamlCmd, true),
-		ui.KeyShiftR: ui.NewKeyAction("Sort ROLE", n.GetTable().SortColCmd("ROLE", true), false),
-		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
-		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
-		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
+		ui.KeyShiftA: ui.NewKeyAction("Sort Age", n.GetTable().AddSortColCmd(ageCol, false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort ROLE", n.GetTable().AddSortColCmd("ROLE", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().AddSortColCmd(cpuCol, false), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().AddSortColCmd(memCol, false), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Pods", n.GetTable().AddSortColCmd("PODS", false), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort OS-IMAGE", n.GetTable().AddSortColCmd("OS-IMAGE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Reset Sort", n.GetTable().ResetSortCmd, false),
+		ui.KeyShiftE: ui.NewKeyAction("Events", n.eventsCmd, true),
+		ui.KeyF:      ui.NewKeyAction("Filter Labels", n.labelFilterCmd, true),
+		ui.KeyA:      ui.NewKeyAction("Annotation Diff", n.annotationDiffCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Trends", n.trendsCmd, true),
+		ui.KeyO:      ui.NewKeyAction("Top Pods", n.topPodsCmd, true),
+		ui.KeyShiftL: ui.NewKeyAction("Allocatable", n.allocatableCmd, true),
+		ui.KeyShiftD: ui.NewKeyAction("Reset Columns", n.resetColumnsCmd, true),
+		ui.KeyShiftX: ui.NewKeyAction("Cycle Arch", n.cycleArchCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Snapshot", n.snapshotCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Export CSV", n.exportCmd, true),
 	})
+	if client.KernelCVEFeed() != nil {
+		aa.Add(ui.KeyShiftV, ui.NewKeyAction("Kernel CVEs", n.kernelCVEsCmd, true))
+	}
+	if a, ok := aa.Get(tcell.KeyEscape); ok {
+		a.Action = n.clearLabelFilterCmd(a.Action)
+		aa.Add(tcell.KeyEscape, a)
+	}
+	n.checkPermissions()
+}
+
+// checkPermissions disables node actions the current user is not allowed to perform
+// and flags the missing permissions in the view title. The access reviews run in the background.
+func (n *Node) checkPermissions() {
+	if !n.App().ConOK() {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+		defer cancel()
+		perms, err := dao.NodePermissions(ctx, n.App().factory)
+		if err != nil {
+			slog.Warn("Node permissions check failed", slogs.Error, err)
+			return
+		}
+		n.App().QueueUpdateDraw(func() {
+			for verb, kk := range nodeVerbKeys {
+				if !perms.Can(verb) {
+					n.Actions().Delete(kk...)
+				}
+			}
+			n.GetTable().Warning = ""
+			if mm := perms.Missing(); len(mm) > 0 {
+				n.GetTable().Warning = "no " + strings.Join(mm, ",")
+			}
+			n.GetTable().UpdateTitle()
+			if top := n.App().Content.Top(); top != nil && top.Name() == n.Name() {
+				n.App().Menu().HydrateMenu(n.Hints())
+			}
+		})
+	}()
 }
 
 func (n *Node) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
-	showPods(a, n.GetTable().GetSelectedItem(), client.BlankNamespace, "spec.nodeName="+path)
+	showNodePods(a, n.GetTable().GetSelectedItem())
+}
+
+// showNodePods shows the pods running on a node, allowing to cycle through pod phase filters.
+func showNodePods(app *App, node string) {
+	v := NewPod(client.PodGVR)
+
+	var filter atomic.Int32
+	ctxFn := podCtx(app, node, "spec.nodeName="+node)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctxFn(ctx), internal.KeyPodPhases, dao.PodPhaseFilter(filter.Load()).Phases())
+	})
+	v.AddBindKeysFn(func(aa *ui.KeyActions) {
+		aa.Add(ui.KeyShiftH, ui.NewKeyAction("Cycle Phases", func(*tcell.EventKey) *tcell.EventKey {
+			f := dao.PodPhaseFilter(filter.Load()).Next()
+			filter.Store(int32(f))
+			app.Flash().Infof("Showing %s pods on node %s", f, node)
+			v.Start()
+
+			return nil
+		}, true))
+	})
+
+	if err := app.Config.SetActiveNamespace(client.BlankNamespace); err != nil {
+		slog.Error("Unable to set active namespace during show pods", slogs.Error, err)
+	}
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
 }
 
 func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -101,133 +563,1478 @@ func (n *Node) drainCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return evt
 	}
 
-	opts := dao.DrainOptions{
-		GracePeriodSeconds: -1,
-		Timeout:            5 * time.Second,
+	ShowDrain(n, sels, drainOptions(n.App().Config.K9s.DrainOptions), drainNode)
+
+	return nil
+}
+
+func (n *Node) maintenanceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := n.GetTable().GetSelectedItems()
+	if len(sels) == 0 {
+		return evt
 	}
-	ShowDrain(n, sels, opts, drainNode)
+	ShowMaintenance(n, sels, n.scheduleMaintenance)
 
 	return nil
 }
 
-func drainNode(v ResourceViewer, sels []string, opts dao.DrainOptions) {
-	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+func (n *Node) powerCycleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := n.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	msg := fmt.Sprintf("Drain and delete node %s, then wait for its replacement to register?", sel)
+	d := n.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, n.App().Content.Pages, "Confirm Power Cycle", msg, func() {
+		n.powerCycle(sel)
+	}, func() {})
+
+	return nil
+}
+
+func (n *Node) powerCycle(sel string) {
+	no, err := n.nodeDAO()
 	if err != nil {
-		v.App().Flash().Err(err)
+		n.App().Flash().Err(err)
 		return
 	}
-	m, ok := res.(dao.NodeMaintainer)
-	if !ok {
-		v.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", v.GVR()))
+	d := NewDetails(n.App(), "Power Cycle Progress", sel, contentYAML, true)
+	if err := n.App().inject(d, false); err != nil {
+		n.App().Flash().Err(err)
 		return
 	}
 
-	v.Stop()
-	defer v.Start()
-	{
-		d := NewDetails(v.App(), "Drain Progress", "nodes", contentYAML, true)
-		if err := v.App().inject(d, false); err != nil {
-			v.App().Flash().Err(err)
-		}
-		for _, sel := range sels {
-			if err := m.Drain(sel, opts, d.GetWriter()); err != nil {
-				v.App().Flash().Err(err)
-			}
-		}
-		v.Refresh()
-	}
-}
-
-func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
-	return func(evt *tcell.EventKey) *tcell.EventKey {
-		sels := n.GetTable().GetSelectedItems()
-		if len(sels) == 0 {
-			return evt
-		}
-
-		title, msg := "Confirm ", ""
-		if cordon {
-			title, msg = title+"Cordon", "Cordon "
-		} else {
-			title, msg = title+"Uncordon", "Uncordon "
-		}
-		if len(sels) == 1 {
-			msg += sels[0] + "?"
-		} else {
-			msg += fmt.Sprintf("(%d) marked %s?", len(sels), n.GVR().R())
-		}
-		d := n.App().Styles.Dialog()
-		dialog.ShowConfirm(&d, n.App().Content.Pages, title, msg, func() {
-			res, err := dao.AccessorFor(n.App().factory, n.GVR())
+	opts := drainOptions(n.App().Config.K9s.DrainOptions)
+	w := queuedWriter{app: n.App(), w: d.GetWriter()}
+	go func() {
+		err := no.PowerCycleWithProgress(context.Background(), sel, opts, powerCycleTimeout, w)
+		n.App().QueueUpdateDraw(func() {
 			if err != nil {
+				_, _ = fmt.Fprintf(d.GetWriter(), "[%s] Power cycle failed: %s\n", sel, err)
 				n.App().Flash().Err(err)
 				return
 			}
-			m, ok := res.(dao.NodeMaintainer)
-			if !ok {
-				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
-				return
-			}
-			for _, s := range sels {
-				if err := m.ToggleCordon(s, cordon); err != nil {
-					n.App().Flash().Err(err)
-				}
-			}
-			n.Refresh()
+			n.App().Flash().Infof("Node %s power cycled", sel)
+		})
+	}()
+}
+
+// benchmarkCmd stresses the node using a temporary pod and reports the node CPU impact.
+func (n *Node) benchmarkCmd(path string) ui.ActionHandler {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		msg := fmt.Sprintf("Run a %s cpu/io stress test on node %s?", dao.DefaultBenchmarkDuration, path)
+		d := n.App().Styles.Dialog()
+		dialog.ShowConfirm(&d, n.App().Content.Pages, "Confirm Benchmark", msg, func() {
+			n.benchmark(path)
 		}, func() {})
 
 		return nil
 	}
 }
 
-func (n *Node) sshCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := n.GetTable().GetSelectedItem()
-	if path == "" {
-		return evt
+func (n *Node) benchmark(sel string) {
+	no, err := n.nodeDAO()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return
+	}
+	d := NewDetails(n.App(), "Benchmark", sel, contentTXT, true)
+	if err := n.App().inject(d, false); err != nil {
+		n.App().Flash().Err(err)
+		return
 	}
 
-	n.Stop()
-	defer n.Start()
-	_, node := client.Namespaced(path)
-	launchNodeShell(n, n.App(), node)
-
-	return nil
+	opts := dao.BenchmarkOptions{
+		Namespace: n.App().Config.K9s.ShellPod.Namespace,
+		Out:       queuedWriter{app: n.App(), w: d.GetWriter()},
+	}
+	_, _ = fmt.Fprintf(d.GetWriter(), "[%s] Stressing node with %s...\n", sel, dao.DefaultBenchmarkImage)
+	go func() {
+		res, err := no.Benchmark(sel, opts)
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				_, _ = fmt.Fprintf(d.GetWriter(), "[%s] Benchmark failed: %s\n", sel, err)
+				n.App().Flash().Err(err)
+				return
+			}
+			_, _ = fmt.Fprintln(d.GetWriter(), benchmarkReport(res))
+			n.App().Flash().Infof("Node %s benchmark completed", sel)
+		})
+	}()
 }
 
-func (n *Node) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := n.GetTable().GetSelectedItem()
-	if path == "" {
-		return evt
+func (n *Node) scheduleMaintenance(v ResourceViewer, sels []string, at time.Time) {
+	no, err := n.nodeDAO()
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
 	}
 
-	n.Stop()
-	defer n.Start()
-	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
-	defer cancel()
+	opts := drainOptions(v.App().Config.K9s.DrainOptions)
+	var count int
+	for _, sel := range sels {
+		if _, err := no.ScheduleMaintenanceWindow(sel, at, opts); err != nil {
+			v.App().Flash().Err(err)
+			continue
+		}
+		count++
+	}
+	v.GetTable().ClearMarks()
+	if count > 0 {
+		v.App().Flash().Infof("Scheduled maintenance of %d node(s) at %s. Use :maintenance to review", count, at.Format(time.DateTime))
+	}
+}
 
-	sel := n.GetTable().GetSelectedItem()
-	gvr := n.GVR().GVR()
-	dial, err := n.App().factory.Client().DynDial()
+// drainOptions converts the configured drain defaults to drain options.
+func drainOptions(dd *config.DrainOptions) dao.DrainOptions {
+	return dao.DrainOptions{
+		GracePeriodSeconds:  dd.GracePeriodSeconds,
+		Timeout:             dd.Timeout,
+		DeleteEmptyDirData:  dd.DeleteEmptyDirData,
+		IgnoreAllDaemonSets: dd.IgnoreAllDaemonSets,
+		EvictionOrder:       dao.EvictionOrder(dd.EvictionOrder),
+		ChunkSize:           dd.ChunkSize,
+		InterChunkDelay:     dd.InterChunkDelay,
+		PreHooks:            dd.PreHooks,
+		PostHooks:           dd.PostHooks,
+		HookTimeout:         dd.HookTimeout,
+	}
+}
+
+func drainNode(v ResourceViewer, sels []string, opts dao.DrainOptions) {
+	m, err := nodeMaintainer(v)
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+
+	d := NewDetails(v.App(), "Drain Progress", "nodes", contentYAML, true)
+	if err := v.App().inject(d, false); err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+
+	if opts.DryRun {
+		go func() {
+			for _, sel := range sels {
+				var buff bytes.Buffer
+				fqns, errs := m.DrainDryRun(sel, opts, &buff)
+				v.App().QueueUpdateDraw(func() {
+					_, _ = d.GetWriter().Write(buff.Bytes())
+					_, _ = fmt.Fprintf(d.GetWriter(), "Node %s dry run: %d pod(s) would be evicted, %d warning(s)\n", sel, len(fqns), len(errs))
+				})
+			}
+		}()
+		return
+	}
+
+	v.Stop()
+	go func() {
+		defer v.Start()
+		for _, sel := range sels {
+			if err := runDrainHooks(v, d, dao.PreDrainHook, opts.PreHooks, opts.HookTimeout, sel); err != nil {
+				v.App().QueueUpdateDraw(func() {
+					v.App().Flash().Errf("Drain of node %s aborted: %s", sel, err)
+				})
+				continue
+			}
+			events, done := make(chan dao.DrainEvent), make(chan struct{})
+			mode := opts.Mode()
+			go func() {
+				defer close(done)
+				for evt := range events {
+					if evt.Phase == dao.DrainEvictionUnavailable {
+						mode = dao.DrainModeDeletion
+					}
+					v.App().QueueUpdateDraw(func() {
+						_, _ = fmt.Fprintln(d.GetWriter(), evt)
+					})
+				}
+			}()
+			err := m.DrainWithEvents(sel, opts, events)
+			<-done
+			v.App().QueueUpdateDraw(func() {
+				if err != nil {
+					v.App().Flash().Err(err)
+					return
+				}
+				_, _ = fmt.Fprintf(d.GetWriter(), "Node %s drained (%s)!\n", sel, mode)
+			})
+			if err != nil {
+				continue
+			}
+			if err := runDrainHooks(v, d, dao.PostDrainHook, opts.PostHooks, opts.HookTimeout, sel); err != nil {
+				v.App().QueueUpdateDraw(func() {
+					v.App().Flash().Err(err)
+				})
+			}
+		}
+		v.Refresh()
+	}()
+}
+
+// drainZone cordons all the nodes in a zone and drains them sequentially, halting on
+// disruption budget violations.
+func drainZone(app *App, zone string, opts dao.DrainOptions) {
+	d := NewDetails(app, "Zone Drain", zone, contentYAML, true)
+	if err := app.inject(d, false); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var n dao.Node
+	n.Init(app.factory, client.NodeGVR)
+	go func() {
+		err := n.ZoneAwareDrain(zone, opts, queuedWriter{app: app, w: d.GetWriter()})
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("Zone %s drained", zone)
+		})
+	}()
+}
+
+// runDrainHooks runs the given drain hooks and reports their output in the drain details.
+func runDrainHooks(v ResourceViewer, d *Details, kind string, hooks []string, timeout time.Duration, sel string) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+	var buff bytes.Buffer
+	_, name := client.Namespaced(sel)
+	var n dao.Node
+	n.Init(v.App().factory, client.NodeGVR)
+	err := n.RunDrainHooks(context.Background(), kind, hooks, name, timeout, &buff)
+	v.App().QueueUpdateDraw(func() {
+		_, _ = d.GetWriter().Write(buff.Bytes())
+	})
+
+	return err
+}
+
+func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		sels := n.GetTable().GetSelectedItems()
+		if len(sels) == 0 {
+			return evt
+		}
+		if cordon {
+			ShowCordon(n, sels, cordonNodes)
+			return nil
+		}
+
+		title, msg := "Confirm Uncordon", "Uncordon "
+		if len(sels) == 1 {
+			msg += sels[0] + "?"
+		} else {
+			msg += fmt.Sprintf("(%d) marked %s?", len(sels), n.GVR().R())
+		}
+		d := n.App().Styles.Dialog()
+		dialog.ShowConfirm(&d, n.App().Content.Pages, title, msg, func() {
+			m, err := nodeMaintainer(n)
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			reportCordon(n, "Uncordoned", sels, m.BatchToggleCordon(sels, false))
+		}, func() {})
+
+		return nil
+	}
+}
+
+// isolateCmd cordons and taints the selected nodes or reverses their isolation.
+func (n *Node) isolateCmd(isolate bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		sels := n.GetTable().GetSelectedItems()
+		if len(sels) == 0 {
+			return evt
+		}
+
+		title, action, msg := "Confirm Isolate", "Isolated", "Cordon and taint "
+		if !isolate {
+			title, action, msg = "Confirm Deisolate", "Deisolated", "Untaint "
+		}
+		if len(sels) == 1 {
+			msg += sels[0]
+		} else {
+			msg += fmt.Sprintf("(%d) marked %s", len(sels), n.GVR().R())
+		}
+		msg += " with " + dao.DefaultIsolateTaint.ToString() + "?"
+		if isolate {
+			msg += " New pods not tolerating the taint won't be scheduled. Running pods are kept, drain the node to evict them."
+		} else {
+			msg += " Nodes cordoned prior to their isolation stay cordoned."
+		}
+		d := n.App().Styles.Dialog()
+		dialog.ShowConfirm(&d, n.App().Content.Pages, title, msg, func() {
+			m, err := nodeMaintainer(n)
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			fn := m.Deisolate
+			if isolate {
+				fn = m.Isolate
+			}
+			var errs []error
+			for _, sel := range sels {
+				ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+				if err := fn(ctx, sel, dao.DefaultIsolateTaint); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", sel, err))
+				}
+				cancel()
+			}
+			reportCordon(n, action, sels, errs)
+		}, func() {})
+
+		return nil
+	}
+}
+
+func cordonNodes(v ResourceViewer, sels []string, reason string) {
+	m, err := nodeMaintainer(v)
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+	reportCordon(v, "Cordoned", sels, m.BatchCordonWithReason(sels, reason))
+}
+
+func reportCordon(v ResourceViewer, action string, sels []string, errs []error) {
+	switch {
+	case len(errs) == 0:
+		v.App().Flash().Infof("%s %d node(s)", action, len(sels))
+	case len(sels) == 1:
+		v.App().Flash().Err(errs[0])
+	default:
+		v.App().Flash().Errf("%s %d node(s), %d failed -- %s", action, len(sels)-len(errs), len(errs), errs[0])
+	}
+	v.Refresh()
+}
+
+func nodeMaintainer(v ResourceViewer) (dao.NodeMaintainer, error) {
+	res, err := dao.AccessorFor(v.App().factory, v.GVR())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(dao.NodeMaintainer)
+	if !ok {
+		return nil, fmt.Errorf("expecting a maintainer for %q", v.GVR())
+	}
+
+	return m, nil
+}
+
+func (n *Node) sshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	n.Stop()
+	defer n.Start()
+	_, node := client.Namespaced(path)
+	launchNodeShell(n, n.App(), node)
+
+	return nil
+}
+
+func (n *Node) nodeSSHCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ct, err := n.App().Config.K9s.ActiveContext()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	if ct.SSH == nil {
+		n.App().Flash().Errf("No ssh configuration found for context %q", n.App().Config.K9s.ActiveContextName())
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	no, err := dao.FetchNode(ctx, n.App().factory, path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+
+	n.Stop()
+	defer n.Start()
+	if err := nodeSSH(n.App(), no, ct.SSH); err != nil {
+		n.App().Flash().Errf("SSH into node %s failed: %s", path, err)
+	}
+
+	return nil
+}
+
+func (n *Node) yamlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	n.Stop()
+	defer n.Start()
+
+	info, raw, err := n.nodeYAML(path)
 	if err != nil {
 		n.App().Flash().Err(err)
 		return nil
 	}
-	o, err := dial.Resource(gvr).Get(ctx, sel, metav1.GetOptions{})
+
+	details := NewDetails(n.App(), yamlAction, path, contentYAML, true).Update(info + raw)
+	if !n.App().Config.IsReadOnly() {
+		details.Actions().Bulk(ui.KeyMap{
+			ui.KeyE: ui.NewKeyActionWithOpts("Edit", n.editYAMLCmd(details, path),
+				ui.ActionOpts{
+					Visible:   true,
+					Dangerous: true,
+				}),
+			ui.KeyB: ui.NewKeyActionWithOpts("Benchmark", n.benchmarkCmd(path),
+				ui.ActionOpts{
+					Visible:   true,
+					Dangerous: true,
+				}),
+		})
+	}
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	n.loadNodeDetails(details, path, info, raw)
+
+	return nil
+}
+
+// loadNodeDetails fetches the node details sections in the background and
+// shows them ahead of the node manifest once available.
+func (n *Node) loadNodeDetails(d *Details, path, info, raw string) {
+	go func() {
+		extra := n.nodeDetailsInfo(path)
+		if extra == "" {
+			return
+		}
+		n.App().QueueUpdateDraw(func() {
+			d.Update(info + extra + raw)
+		})
+	}()
+}
+
+// nodeDetailsInfo returns the given node filesystems, kubelet certificate and condition history sections.
+func (n *Node) nodeDetailsInfo(path string) string {
+	nd, err := n.nodeDAO()
+	if err != nil {
+		return ""
+	}
+	var info string
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	if ff, err := nd.GetDiskPressureDetails(ctx, path); err != nil {
+		slog.Debug("Unable to fetch node filesystems usage", slogs.ResName, path, slogs.Error, err)
+	} else if fs, err := nodeFilesystemsInfo(ff); err == nil {
+		info += fs
+	}
+	if cs := nd.KubeletCertExpiry(path); cs != nil {
+		info += kubeletCertInfo(cs)
+	}
+	if cc, err := nd.GetConditionHistory(path, ""); err != nil {
+		slog.Debug("Unable to fetch node condition history", slogs.ResName, path, slogs.Error, err)
+	} else {
+		info += nodeConditionHistoryInfo(cc)
+	}
+
+	return info
+}
+
+// nodeYAML returns the given node system info and manifest.
+func (n *Node) nodeYAML(path string) (info, raw string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+
+	dial, err := n.App().factory.Client().DynDial()
+	if err != nil {
+		return "", "", err
+	}
+	o, err := dial.Resource(n.GVR().GVR()).Get(ctx, path, metav1.GetOptions{})
 	if err != nil {
-		n.App().Flash().Errf("Unable to get resource %q -- %s", n.GVR(), err)
+		return "", "", fmt.Errorf("unable to get resource %q -- %w", n.GVR(), err)
+	}
+	if raw, err = dao.ToYAML(o, false); err != nil {
+		return "", "", fmt.Errorf("unable to marshal resource %w", err)
+	}
+	if info, err = nodeSystemInfo(o.Object); err != nil {
+		return "", "", fmt.Errorf("unable to marshal system info %w", err)
+	}
+
+	return info, raw, nil
+}
+
+// editYAMLCmd edits the node manifest in the configured editor and patches the changes.
+func (n *Node) editYAMLCmd(d *Details, path string) ui.ActionHandler {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		nd, err := n.nodeDAO()
+		if err != nil {
+			n.App().Flash().Err(err)
+			return nil
+		}
+		_, raw, err := n.nodeYAML(path)
+		if err != nil {
+			n.App().Flash().Err(err)
+			return nil
+		}
+		bb, err := editFile(n.App(), "k9s-node-*.yaml", []byte(raw))
+		if err != nil {
+			n.App().Flash().Errf("Edit failed on node %s: %s", path, err)
+			return nil
+		}
+		patch, err := dao.NodeEditPatch([]byte(raw), bb)
+		if err != nil {
+			n.App().Flash().Errf("Edit failed on node %s: %s", path, err)
+			return nil
+		}
+		if string(patch) == "{}" {
+			n.App().Flash().Infof("No changes on node %s", path)
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := nd.EditNode(ctx, path, []byte(raw), bb); err != nil {
+			var ce *dao.NodeEditConflictError
+			if errors.As(err, &ce) {
+				n.showEditConflict(path, ce)
+				return nil
+			}
+			n.App().Flash().Errf("Edit failed on node %s: %s", path, err)
+			return nil
+		}
+		n.App().Flash().Infof("Node %s updated", path)
+		if info, raw, err := n.nodeYAML(path); err == nil {
+			d.Update(info + raw)
+			n.loadNodeDetails(d, path, info, raw)
+		}
+
 		return nil
 	}
+}
+
+// showEditConflict shows the latest node manifest against the conflicting edits.
+func (n *Node) showEditConflict(path string, ce *dao.NodeEditConflictError) {
+	details := NewDetails(n.App(), "Edit Conflict", path, contentDiff, true).Update(ce.Diff)
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+		return
+	}
+	n.App().Flash().Errf("Edit conflict on node %s. Review the latest changes and edit again", path)
+}
+
+func (n *Node) eventsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
 
-	raw, err := dao.ToYAML(o, false)
+	no, err := n.nodeDAO()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	_, name := client.Namespaced(path)
+	ee, err := no.GetEvents(ctx, name)
 	if err != nil {
-		n.App().Flash().Errf("Unable to marshal resource %s", err)
+		n.App().Flash().Err(err)
 		return nil
 	}
 
-	details := NewDetails(n.App(), yamlAction, sel, contentYAML, true).Update(raw)
+	details := NewDetails(n.App(), "Events", path, contentTXT, true).
+		Update(nodeEventsTable(ee, n.App().Styles.Frame().Status.ErrorColor.String()))
 	if err := n.App().inject(details, false); err != nil {
 		n.App().Flash().Err(err)
 	}
 
 	return nil
 }
+
+func (n *Node) labelFilterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n.App().InCmdMode() {
+		return evt
+	}
+	n.App().ResetPrompt(n.GetTable().CmdBuff())
+	n.GetTable().CmdBuff().SetText("-l ", "")
+
+	return nil
+}
+
+// cycleArchCmd cycles the node listing through the cluster cpu architectures.
+func (n *Node) cycleArchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n.App().InCmdMode() {
+		return evt
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	aa, err := dao.NodeArchitectures(ctx, n.App().factory)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+
+	filter := n.GetTable().CmdBuff().GetText()
+	arch := nextNodeArch(aa, filter)
+	sel := withNodeArch(filter, arch)
+	n.App().Config.SetNodeLabelFilter(sel)
+	if sel == "" {
+		n.GetTable().CmdBuff().SetText("", "")
+	} else {
+		n.GetTable().CmdBuff().SetText("-l "+sel, "")
+	}
+	n.GetTable().GetModel().SetLabelFilter(sel)
+	if arch == "" {
+		n.App().Flash().Info("Showing nodes of all architectures")
+	} else {
+		n.App().Flash().Infof("Showing %s nodes", arch)
+	}
+	n.Refresh()
+
+	return nil
+}
+
+// nextNodeArch returns the architecture following the one selected by the given filter
+// or blank once all architectures were cycled through.
+func nextNodeArch(aa []string, filter string) string {
+	idx := -1
+	if arch, _ := splitArchFilter(filter); arch != "" {
+		idx = slices.Index(aa, arch)
+	}
+	if idx+1 < len(aa) {
+		return aa[idx+1]
+	}
+
+	return ""
+}
+
+// withNodeArch returns the given label filter selector scoped to the given architecture.
+// Other filter terms are preserved and a blank architecture drops the architecture term.
+func withNodeArch(filter, arch string) string {
+	_, rr := splitArchFilter(filter)
+	if arch != "" {
+		if r, err := labels.NewRequirement(v1.LabelArchStable, selection.Equals, []string{arch}); err == nil {
+			rr = append(rr, *r)
+		}
+	}
+
+	return labels.NewSelector().Add(rr...).String()
+}
+
+// splitArchFilter splits a label filter into its architecture and remaining requirements.
+func splitArchFilter(filter string) (string, []labels.Requirement) {
+	if !internal.IsLabelSelector(filter) {
+		return "", nil
+	}
+	sel, err := labels.Parse(ui.TrimLabelSelector(filter))
+	if err != nil {
+		return "", nil
+	}
+	rr, _ := sel.Requirements()
+	var (
+		arch string
+		res  = make([]labels.Requirement, 0, len(rr))
+	)
+	for _, r := range rr {
+		if r.Key() == v1.LabelArchStable && r.Operator() == selection.Equals {
+			arch, _ = r.Values().PopAny()
+			continue
+		}
+		res = append(res, r)
+	}
+
+	return arch, res
+}
+
+func (n *Node) labelsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	nd, err := n.nodeDAO()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	no, err := dao.FetchNode(ctx, n.App().factory, path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	ll, ok, err := editLabels(n.App(), no.Labels)
+	if err != nil {
+		n.App().Flash().Errf("Label edit failed on node %s: %s", path, err)
+		return nil
+	}
+	if !ok {
+		n.App().Flash().Infof("No label changes on node %s", path)
+		return nil
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	if err := nd.PatchNodeLabels(ctx, path, ll); err != nil {
+		n.App().Flash().Errf("Label update failed on node %s: %s", path, err)
+		return nil
+	}
+	n.App().Flash().Infof("Labels updated on node %s", path)
+
+	return nil
+}
+
+func (n *Node) annotationDiffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	nd, err := n.nodeDAO()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(n.App(), "Annotation Diff", path, contentTXT, true).
+		Update(annotationDiffTable(nd.AnnotationChanges(path)))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (n *Node) trendsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	_, name := client.Namespaced(path)
+	details := NewDetails(n.App(), "Trends", path, contentTXT, true).
+		Update(nodeTrends(dao.NodeMetricsHistory.Samples(n.App().Conn().ActiveContext(), name)))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (n *Node) allocatableCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	no, err := dao.CachedFetchNode(context.Background(), n.App().factory, path, dao.DefaultNodeCacheTTL)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(n.App(), "Allocatable", path, contentTXT, true).
+		Update(allocatableTable(no.Status.Allocatable, no.Status.Capacity))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (n *Node) kernelCVEsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	no, err := dao.CachedFetchNode(context.Background(), n.App().factory, path, dao.DefaultNodeCacheTTL)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	n.App().Flash().Infof("Checking node %s kernel CVEs...", path)
+	go func() {
+		var dn dao.Node
+		dn.Init(n.App().factory, client.NodeGVR)
+		cc, err := dn.CheckKernelCVEs(path, client.KernelCVEFeed())
+		n.App().QueueUpdateDraw(func() {
+			if err != nil {
+				n.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(n.App(), "Kernel CVEs", path, contentTXT, true).
+				Update(kernelCVETable(no.Status.NodeInfo.KernelVersion, cc))
+			if err := n.App().inject(details, false); err != nil {
+				n.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (n *Node) topPodsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	details := NewDetails(n.App(), "Top Pods", path, contentTXT, true)
+	sortBy := func(f dao.ResourceField) ui.ActionHandler {
+		return func(*tcell.EventKey) *tcell.EventKey {
+			if err := n.updateTopPods(details, path, f); err != nil {
+				n.App().Flash().Err(err)
+			}
+			return nil
+		}
+	}
+	if err := n.updateTopPods(details, path, dao.ResourceCPU); err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details.Actions().Bulk(ui.KeyMap{
+		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", sortBy(dao.ResourceCPU), true),
+		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", sortBy(dao.ResourceMEM), true),
+	})
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// updateTopPods refreshes the details view with the node top pods ranked by the given resource.
+func (n *Node) updateTopPods(d *Details, path string, sortBy dao.ResourceField) error {
+	nd, err := n.nodeDAO()
+	if err != nil {
+		return err
+	}
+	pp, err := nd.TopPods(path, n.App().Config.ActiveNamespace(), topPodsCount, sortBy)
+	if err != nil {
+		return err
+	}
+	no, err := dao.CachedFetchNode(context.Background(), n.App().factory, path, dao.DefaultNodeCacheTTL)
+	if err != nil {
+		return err
+	}
+	sp, err := nd.GetSystemPods(path)
+	if err != nil {
+		return err
+	}
+	d.Update(topPodsTable(pp, no.Status.Allocatable, sortBy) + systemPodsTable(sp))
+
+	return nil
+}
+
+func (n *Node) resetColumnsCmd(*tcell.EventKey) *tcell.EventKey {
+	if err := render.SetNodeColumns(nil); err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	n.GetTable().Refresh()
+	n.App().Flash().Infof("Node columns reset to %s", strings.Join(render.DefaultNodeColumns(), ","))
+
+	return nil
+}
+
+func (n *Node) snapshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	no, err := n.nodeDAO()
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	dir := n.App().Config.K9s.ContextScreenDumpDir()
+	if err := ensureDir(dir); err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	_, name := client.Namespaced(path)
+	fpath := filepath.Join(dir, snapshotFileName(name, time.Now()))
+	ctx, cancel := context.WithTimeout(context.Background(), n.App().Conn().Config().CallTimeout())
+	defer cancel()
+	if err := no.Snapshot(ctx, name, fpath); err != nil {
+		n.App().Flash().Errf("Snapshot of node %s failed: %s", name, err)
+		return nil
+	}
+	n.App().Flash().Infof("Node %s snapshot saved to %s", name, fpath)
+
+	return nil
+}
+
+func (n *Node) exportCmd(*tcell.EventKey) *tcell.EventKey {
+	dir := n.App().Config.K9s.ContextScreenDumpDir()
+	fName := data.SanitizeFileName(fmt.Sprintf("nodes-%d.csv", time.Now().Unix()))
+	ShowSaveFile(n, "Save CSV", filepath.Join(dir, fName), n.exportNodes)
+
+	return nil
+}
+
+func (n *Node) exportNodes(path string) {
+	t := n.GetTable()
+	data := t.GetFilteredData()
+	h := data.Header()
+	ids := t.RowIDs()
+	rr := make([]model1.Row, 0, len(ids))
+	for _, id := range ids {
+		if re, ok := data.FindRow(id); ok {
+			rr = append(rr, re.Row)
+		}
+	}
+	if err := writeNodesCSV(path, h, rr, t.VisibleColumns(h), t.ColumnWidths()); err != nil {
+		n.App().Flash().Err(err)
+		return
+	}
+	n.App().Flash().Infof("Nodes saved to %s", path)
+}
+
+func writeNodesCSV(path string, h model1.Header, rr []model1.Row, cols []int, ww map[string]int) error {
+	if err := ensureDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			slog.Error("Closing file failed",
+				slogs.Path, path,
+				slogs.Error, err,
+			)
+		}
+	}()
+
+	return export.ExportTableCSV(out, h, rr, cols, ww)
+}
+
+func (n *Node) nodeDAO() (*dao.Node, error) {
+	res, err := dao.AccessorFor(n.App().factory, n.GVR())
+	if err != nil {
+		return nil, err
+	}
+	no, ok := res.(*dao.Node)
+	if !ok {
+		return nil, fmt.Errorf("expecting a node accessor for %q but got %T", n.GVR(), res)
+	}
+
+	return no, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+var systemInfoKeys = []string{
+	"osImage",
+	"kernelVersion",
+	"operatingSystem",
+	"architecture",
+	"containerRuntimeVersion",
+	"kubeletVersion",
+}
+
+// nodeSystemInfo renders the node OS and runtime information as a standalone YAML document.
+func nodeSystemInfo(o map[string]any) (string, error) {
+	ni, _, err := unstructured.NestedStringMap(o, "status", "nodeInfo")
+	if err != nil {
+		return "", err
+	}
+	info := make(map[string]string, len(systemInfoKeys))
+	for _, k := range systemInfoKeys {
+		info[k] = ni[k]
+	}
+	bb, err := yaml.Marshal(map[string]any{"systemInfo": info})
+	if err != nil {
+		return "", err
+	}
+
+	return "# System Info\n" + string(bb) + "---\n", nil
+}
+
+// fsInfo represents a node filesystem usage section entry.
+type fsInfo struct {
+	Name        string `json:"name"`
+	Capacity    string `json:"capacity"`
+	Used        string `json:"used"`
+	Available   string `json:"available"`
+	Usage       string `json:"usage"`
+	InodesUsage string `json:"inodesUsage"`
+}
+
+func nodeFilesystemsInfo(ff []dao.FilesystemUsage) (string, error) {
+	ii := make([]fsInfo, 0, len(ff))
+	for _, f := range ff {
+		ii = append(ii, fsInfo{
+			Name:        f.Name,
+			Capacity:    render.ToHumanBytes(f.Capacity),
+			Used:        render.ToHumanBytes(f.Used),
+			Available:   render.ToHumanBytes(f.Available),
+			Usage:       strconv.Itoa(f.UsedPercent()) + "%",
+			InodesUsage: strconv.Itoa(f.InodesPercent()) + "%",
+		})
+	}
+	bb, err := yaml.Marshal(map[string]any{"filesystems": ii})
+	if err != nil {
+		return "", err
+	}
+
+	return "# Filesystems\n" + string(bb) + "---\n", nil
+}
+
+// conditionInfo represents a node condition history section entry.
+type conditionInfo struct {
+	Time      string `json:"time"`
+	Age       string `json:"age"`
+	Condition string `json:"condition"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason"`
+}
+
+// nodeConditionHistoryInfo renders the node condition transitions as a timeline, most recent first.
+func nodeConditionHistoryInfo(cc []dao.ConditionEvent) string {
+	if len(cc) == 0 {
+		return ""
+	}
+	ii := make([]conditionInfo, 0, len(cc))
+	for _, c := range cc {
+		ii = append(ii, conditionInfo{
+			Time:      c.Timestamp.Format(time.DateTime),
+			Age:       render.ToAge(metav1.Time{Time: c.Timestamp}),
+			Condition: string(c.Condition),
+			Status:    string(c.Status),
+			Reason:    c.Reason,
+		})
+	}
+	bb, err := yaml.Marshal(map[string]any{"conditionHistory": ii})
+	if err != nil {
+		return ""
+	}
+
+	return "# Condition History\n" + string(bb) + "---\n"
+}
+
+func kubeletCertInfo(cs *dao.CertStatus) string {
+	bb, err := yaml.Marshal(map[string]any{"kubeletCertificate": map[string]any{
+		"expiresAt":     cs.ExpiresAt.Format(time.DateTime),
+		"daysRemaining": cs.DaysRemaining,
+	}})
+	if err != nil {
+		return ""
+	}
+
+	return "# Kubelet Certificate\n" + string(bb) + "---\n"
+}
+
+func snapshotFileName(node string, t time.Time) string {
+	return data.SanitizeFileName(fmt.Sprintf("node-snapshot-%s-%d.yaml", node, t.Unix()))
+}
+
+// nodeSSH opens an ssh session to the given node in the current terminal.
+func nodeSSH(a *App, no *v1.Node, cfg *data.SSH) error {
+	host, err := nodeAddress(no, v1.NodeAddressType(cfg.AddressType))
+	if err != nil {
+		return err
+	}
+	bin, err := exec.LookPath("ssh")
+	if err != nil {
+		return fmt.Errorf("ssh command is not in your path: %w", err)
+	}
+
+	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	suspended, errChan, stChan := run(a, &shellOpts{
+		clear:  true,
+		binary: bin,
+		banner: c.Sprintf("<<K9s-SSH>> Node: %s (%s)", no.Name, host),
+		args:   sshArgs(cfg, host),
+	})
+	if !suspended {
+		return errors.New("unable to run ssh command")
+	}
+	for v := range stChan {
+		slog.Debug("stdout", slogs.Line, v)
+	}
+	var errs error
+	for e := range errChan {
+		errs = errors.Join(errs, e)
+	}
+
+	return errs
+}
+
+// nodeAddress returns the node address of the given type.
+func nodeAddress(no *v1.Node, kind v1.NodeAddressType) (string, error) {
+	aa := make([]string, 0, len(no.Status.Addresses))
+	for _, a := range no.Status.Addresses {
+		if a.Type == kind && a.Address != "" {
+			return a.Address, nil
+		}
+		aa = append(aa, string(a.Type))
+	}
+	if len(aa) == 0 {
+		return "", fmt.Errorf("node %s does not report any addresses", no.Name)
+	}
+
+	return "", fmt.Errorf("node %s has no %s address (available: %s). Check the ssh addressType setting", no.Name, kind, strings.Join(aa, ","))
+}
+
+func sshArgs(cfg *data.SSH, host string) []string {
+	args := []string{"-p", strconv.Itoa(cfg.Port)}
+	if cfg.KeyPath != "" {
+		args = append(args, "-i", cfg.KeyPath)
+	}
+	if cfg.User != "" {
+		host = cfg.User + "@" + host
+	}
+
+	return append(args, host)
+}
+
+// editLabels opens the given labels in an editor and returns the edited set
+// along with whether it differs from the original one.
+func editLabels(a *App, ll map[string]string) (map[string]string, bool, error) {
+	bb, err := yaml.Marshal(ll)
+	if err != nil {
+		return nil, false, err
+	}
+	if bb, err = editFile(a, "k9s-node-labels-*.yaml", bb); err != nil {
+		return nil, false, err
+	}
+
+	return parseLabels(bb, ll)
+}
+
+// editFile opens the given content in a temporary file using the configured editor
+// and returns the edited content.
+func editFile(a *App, pattern string, bb []byte) ([]byte, error) {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if e := os.Remove(f.Name()); e != nil {
+			slog.Warn("Unable to remove edit file", slogs.Path, f.Name(), slogs.Error, e)
+		}
+	}()
+	if _, err := f.Write(bb); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if !edit(a, &shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, errors.New("editor exited with errors")
+	}
+
+	return os.ReadFile(f.Name())
+}
+
+func parseLabels(bb []byte, old map[string]string) (map[string]string, bool, error) {
+	var ll map[string]string
+	if err := yaml.UnmarshalStrict(bb, &ll); err != nil {
+		return nil, false, err
+	}
+	if ll == nil {
+		ll = make(map[string]string)
+	}
+
+	return ll, !maps.Equal(ll, old), nil
+}
+
+// nodeTrends renders cpu/mem utilization sparklines, oldest samples first.
+func nodeTrends(ss []dao.MetricsSample) string {
+	if len(ss) == 0 {
+		return "No metrics collected yet"
+	}
+
+	cpu, mem := make([]int, 0, len(ss)), make([]int, 0, len(ss))
+	for _, s := range ss {
+		cpu, mem = append(cpu, s.CPU), append(mem, s.MEM)
+	}
+	last := ss[len(ss)-1]
+
+	return fmt.Sprintf("%%CPU %s %3d%%\n%%MEM %s %3d%%\n\n%d sample(s)",
+		render.Sparkline(cpu), last.CPU,
+		render.Sparkline(mem), last.MEM,
+		len(ss),
+	)
+}
+
+// kernelCVETable renders the CVEs affecting a kernel, most severe first.
+func kernelCVETable(kernel string, cc []client.CVE) string {
+	const idHdr, scoreHdr, sevHdr, linkHdr = "CVE", "SCORE", "SEVERITY", "LINK"
+
+	ll := make([]string, 0, len(cc)+2)
+	ll = append(ll, fmt.Sprintf("Kernel %s (release %s): %d known CVEs", kernel, client.KernelRelease(kernel), len(cc)))
+	if len(cc) == 0 {
+		return ll[0]
+	}
+	iw := len(idHdr)
+	for _, c := range cc {
+		iw = max(iw, len(c.ID))
+	}
+	ll = append(ll, fmt.Sprintf("%-*s  %5s  %-8s  %s", iw, idHdr, scoreHdr, sevHdr, linkHdr))
+	for _, c := range cc {
+		score, sev := render.NAValue, render.NAValue
+		if c.Severity != "" {
+			score, sev = strconv.FormatFloat(c.Score, 'f', 1, 64), c.Severity
+		}
+		sev = fmt.Sprintf("%-8s", sev)
+		switch c.Severity {
+		case "CRITICAL", "HIGH":
+			sev = "[red::]" + sev + "[-::]"
+		case "MEDIUM":
+			sev = "[orange::]" + sev + "[-::]"
+		}
+		ll = append(ll, fmt.Sprintf("%-*s  %5s  %s  %s", iw, c.ID, score, sev, c.URL))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+// allocatableTable renders the node allocatable resources relative to its capacity.
+// Standard resources come first followed by extended resources, alphabetically.
+func allocatableTable(alloc, capacity v1.ResourceList) string {
+	const (
+		resHdr, allocHdr, capHdr, barHdr = "RESOURCE", "ALLOCATABLE", "CAPACITY", "%ALLOC"
+		warnPerc, critPerc               = 75, 90
+	)
+
+	rr := []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, v1.ResourceEphemeralStorage, v1.ResourcePods}
+	extended := make([]v1.ResourceName, 0, len(capacity))
+	for r := range capacity {
+		if !slices.Contains(rr, r) {
+			extended = append(extended, r)
+		}
+	}
+	slices.Sort(extended)
+	rr = append(rr, extended...)
+
+	rw := len(resHdr)
+	for _, r := range rr {
+		rw = max(rw, len(r))
+	}
+	ll := make([]string, 0, len(rr)+2)
+	ll = append(ll, "Allocatable Resources", fmt.Sprintf("%-*s  %11s  %11s  %s", rw, resHdr, allocHdr, capHdr, barHdr))
+	for _, r := range rr {
+		a, c := resourceValue(alloc, r), resourceValue(capacity, r)
+		bar := render.NAValue
+		if c > 0 {
+			perc := client.ToPercentage(a, c)
+			bar = render.AsBar(perc)
+			switch {
+			case perc > critPerc:
+				bar = "[red::]" + bar + "[-::]"
+			case perc > warnPerc:
+				bar = "[yellow::]" + bar + "[-::]"
+			}
+		}
+		ll = append(ll, fmt.Sprintf("%-*s  %11s  %11s  %s", rw, r, formatResource(r, a), formatResource(r, c), bar))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+// resourceValue returns a resource quantity, cpu in millicores.
+func resourceValue(rl v1.ResourceList, r v1.ResourceName) int64 {
+	q, ok := rl[r]
+	if !ok {
+		return 0
+	}
+	if r == v1.ResourceCPU {
+		return q.MilliValue()
+	}
+
+	return q.Value()
+}
+
+func formatResource(r v1.ResourceName, v int64) string {
+	switch r {
+	case v1.ResourceCPU:
+		return strconv.FormatInt(v, 10) + "m"
+	case v1.ResourceMemory, v1.ResourceEphemeralStorage:
+		return strconv.FormatInt(client.ToMB(v), 10) + "Mi"
+	default:
+		return strconv.FormatInt(v, 10)
+	}
+}
+
+// topPodsTable renders pods usage along with a bar graph of the ranking resource usage
+// relative to the node allocatable.
+func topPodsTable(pp []*dao.PodWithMetrics, alloc v1.ResourceList, sortBy dao.ResourceField) string {
+	if len(pp) == 0 {
+		return "No pods found"
+	}
+
+	const nameHdr, cpuHdr, memHdr = "NAME", "CPU(m)", "MEM(Mi)"
+	nw := len(nameHdr)
+	for _, p := range pp {
+		nw = max(nw, len(client.FQN(p.Pod.Namespace, p.Pod.Name)))
+	}
+
+	barCol, total := "%CPU/A", alloc.Cpu().MilliValue()
+	if sortBy == dao.ResourceMEM {
+		barCol, total = "%MEM/A", alloc.Memory().Value()
+	}
+	ll := make([]string, 0, len(pp)+1)
+	ll = append(ll, fmt.Sprintf("%-*s  %8s  %8s  %s", nw, nameHdr, cpuHdr, memHdr, barCol))
+	for _, p := range pp {
+		bar := render.NAValue
+		if p.MX != nil && total > 0 {
+			v := p.CPU
+			if sortBy == dao.ResourceMEM {
+				v = p.MEM
+			}
+			bar = render.AsBar(client.ToPercentage(v, total))
+		}
+		ll = append(ll, fmt.Sprintf("%-*s  %8d  %8d  %s",
+			nw, client.FQN(p.Pod.Namespace, p.Pod.Name),
+			p.CPU, client.ToMB(p.MEM),
+			bar,
+		))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+// systemPodsTable renders the node kube-system and DaemonSet pods section.
+// System pods are colored apart from the user workload pods.
+func systemPodsTable(pp []*v1.Pod) string {
+	if len(pp) == 0 {
+		return ""
+	}
+
+	const nameHdr, phaseHdr, ownerHdr = "NAME", "PHASE", "OWNER"
+	nw, pw := len(nameHdr), len(phaseHdr)
+	for _, p := range pp {
+		nw, pw = max(nw, len(client.FQN(p.Namespace, p.Name))), max(pw, len(p.Status.Phase))
+	}
+	ll := make([]string, 0, len(pp)+2)
+	ll = append(ll,
+		"\n\n["+systemPodsColor+"::b]System Pods[-::-]",
+		fmt.Sprintf("%-*s  %-*s  %s", nw, nameHdr, pw, phaseHdr, ownerHdr),
+	)
+	for _, p := range pp {
+		owner := render.MissingValue
+		if ref := metav1.GetControllerOf(p); ref != nil {
+			owner = ref.Kind + "/" + ref.Name
+		}
+		ll = append(ll, fmt.Sprintf("[%s::]%-*s  %-*s  %s[-::]",
+			systemPodsColor,
+			nw, client.FQN(p.Namespace, p.Name),
+			pw, p.Status.Phase,
+			owner,
+		))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+// benchmarkReport renders a node benchmark CPU impact.
+func benchmarkReport(r *dao.BenchmarkResult) string {
+	return fmt.Sprintf("\nNode:     %s\nDuration: %s\nSamples:  %d\nBaseline: %dm\nCPU Impact (min/max/avg): %dm/%dm/%dm",
+		r.Node, r.Duration.Round(time.Second), r.Samples, r.BaselineCPU,
+		r.MinCPU, r.MaxCPU, r.AvgCPU,
+	)
+}
+
+// annotationDiffTable renders annotation changes side by side, removed values in red and added ones in green.
+func annotationDiffTable(cc []dao.AnnotationChange) string {
+	if len(cc) == 0 {
+		return "No annotation changes made by k9s"
+	}
+
+	const (
+		keyCol, beforeCol, afterCol = "KEY", "BEFORE", "AFTER"
+		removedColor, addedColor    = "red", "green"
+	)
+	kw, bw := len(keyCol), len(beforeCol)
+	for _, c := range cc {
+		kw, bw = max(kw, len(c.Key)), max(bw, len(c.OldValue), len(render.MissingValue))
+	}
+
+	ll := make([]string, 0, len(cc)+1)
+	ll = append(ll, fmt.Sprintf("%-*s  %-*s  %s", kw, keyCol, bw, beforeCol, afterCol))
+	for _, c := range cc {
+		before, after := render.MissingValue, render.MissingValue
+		if c.Op != dao.AnnotationAdd {
+			before = c.OldValue
+		}
+		if c.Op != dao.AnnotationRemove {
+			after = c.NewValue
+		}
+		before, after = tview.Escape(fmt.Sprintf("%-*s", bw, before)), tview.Escape(after)
+		if c.Op != dao.AnnotationAdd {
+			before = "[" + removedColor + "::]" + before + "[-::]"
+		}
+		if c.Op != dao.AnnotationRemove {
+			after = "[" + addedColor + "::]" + after + "[-::]"
+		}
+		ll = append(ll, fmt.Sprintf("%-*s  %s  %s", kw, tview.Escape(c.Key), before, after))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func nodeEventsTable(ee []*v1.Event, warnColor string) string {
+	if len(ee) == 0 {
+		return "No events found"
+	}
+
+	var buff bytes.Buffer
+	w := tabwriter.NewWriter(&buff, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tCOUNT\tMESSAGE")
+	for _, e := range ee {
+		last := e.LastTimestamp
+		if last.IsZero() {
+			last = e.CreationTimestamp
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\n",
+			render.ToAge(last), e.Type, e.Reason, e.Count, strings.TrimSpace(e.Message))
+	}
+	_ = w.Flush()
+
+	ll := strings.Split(strings.TrimSuffix(buff.String(), "\n"), "\n")
+	for i := 1; i < len(ll); i++ {
+		if ee[i-1].Type == v1.EventTypeWarning {
+			ll[i] = "[" + warnColor + "::]" + tview.Escape(ll[i]) + "[-::]"
+			continue
+		}
+		ll[i] = tview.Escape(ll[i])
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+// queuedWriter forwards writes to the underlying writer on the UI thread.
+type queuedWriter struct {
+	app *App
+	w   io.Writer
+}
+
+// Write queues a copy of the given bytes for writing.
+func (q queuedWriter) Write(bb []byte) (int, error) {
+	cp := bytes.Clone(bb)
+	q.app.QueueUpdateDraw(func() {
+		_, _ = q.w.Write(cp)
+	})
+
+	return len(bb), nil
+}
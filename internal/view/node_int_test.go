@@ -0,0 +1,592 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func Test_nodeEventsTable(t *testing.T) {
+	uu := map[string]struct {
+		ee    []*v1.Event
+		lines int
+		warns int
+	}{
+		"none": {
+			lines: 1,
+		},
+		"mixed": {
+			ee: []*v1.Event{
+				{Type: v1.EventTypeWarning, Reason: "NodeNotReady", Message: "Node is not ready"},
+				{Type: v1.EventTypeNormal, Reason: "NodeReady", Message: "Node is ready"},
+			},
+			lines: 3,
+			warns: 1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := nodeEventsTable(u.ee, "red")
+			assert.Len(t, strings.Split(s, "\n"), u.lines)
+			assert.Equal(t, u.warns, strings.Count(s, "[red::]"))
+		})
+	}
+}
+
+func Test_parseLabels(t *testing.T) {
+	uu := map[string]struct {
+		raw     string
+		old     map[string]string
+		e       map[string]string
+		changed bool
+		err     bool
+	}{
+		"unchanged": {
+			raw: "a: \"1\"\n",
+			old: map[string]string{"a": "1"},
+			e:   map[string]string{"a": "1"},
+		},
+		"changed": {
+			raw:     "a: \"1\"\nb: fred\n",
+			old:     map[string]string{"a": "1"},
+			e:       map[string]string{"a": "1", "b": "fred"},
+			changed: true,
+		},
+		"cleared": {
+			old:     map[string]string{"a": "1"},
+			e:       map[string]string{},
+			changed: true,
+		},
+		"toast": {
+			raw: "a: [1, 2]\n",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ll, changed, err := parseLabels([]byte(u.raw), u.old)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, ll)
+			assert.Equal(t, u.changed, changed)
+		})
+	}
+}
+
+func Test_nodeAddress(t *testing.T) {
+	no := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1"},
+		Status: v1.NodeStatus{
+			Addresses: []v1.NodeAddress{
+				{Type: v1.NodeInternalIP, Address: "10.0.0.1"},
+				{Type: v1.NodeHostName, Address: "n1"},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		no   v1.Node
+		kind v1.NodeAddressType
+		e    string
+		err  string
+	}{
+		"internal": {
+			no:   no,
+			kind: v1.NodeInternalIP,
+			e:    "10.0.0.1",
+		},
+		"missing": {
+			no:   no,
+			kind: v1.NodeExternalIP,
+			err:  "node n1 has no ExternalIP address (available: InternalIP,Hostname). Check the ssh addressType setting",
+		},
+		"none": {
+			no:   v1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n2"}},
+			kind: v1.NodeInternalIP,
+			err:  "node n2 does not report any addresses",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, err := nodeAddress(&u.no, u.kind)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, a)
+		})
+	}
+}
+
+func Test_sshArgs(t *testing.T) {
+	uu := map[string]struct {
+		cfg data.SSH
+		e   []string
+	}{
+		"plain": {
+			cfg: data.SSH{Port: 22},
+			e:   []string{"-p", "22", "10.0.0.1"},
+		},
+		"full": {
+			cfg: data.SSH{User: "fred", Port: 2222, KeyPath: "/tmp/id"},
+			e:   []string{"-p", "2222", "-i", "/tmp/id", "fred@10.0.0.1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, sshArgs(&u.cfg, "10.0.0.1"))
+		})
+	}
+}
+
+func Test_annotationDiffTable(t *testing.T) {
+	uu := map[string]struct {
+		cc []dao.AnnotationChange
+		e  string
+	}{
+		"none": {
+			e: "No annotation changes made by k9s",
+		},
+		"changes": {
+			cc: []dao.AnnotationChange{
+				{Key: "a", NewValue: "1", Op: dao.AnnotationAdd},
+				{Key: "bb", OldValue: "2", Op: dao.AnnotationRemove},
+				{Key: "c", OldValue: "3", NewValue: "4", Op: dao.AnnotationUpdate},
+			},
+			e: "KEY  BEFORE  AFTER\n" +
+				"a    <none>  [green::]1[-::]\n" +
+				"bb   [red::]2     [-::]  <none>\n" +
+				"c    [red::]3     [-::]  [green::]4[-::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, annotationDiffTable(u.cc))
+		})
+	}
+}
+
+func Test_nodeTrends(t *testing.T) {
+	uu := map[string]struct {
+		ss []dao.MetricsSample
+		e  string
+	}{
+		"none": {
+			e: "No metrics collected yet",
+		},
+		"samples": {
+			ss: []dao.MetricsSample{{CPU: 10, MEM: 100}, {CPU: 50, MEM: 100}},
+			e:  "%CPU ⣠  50%\n%MEM ⣿ 100%\n\n2 sample(s)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nodeTrends(u.ss))
+		})
+	}
+}
+
+func Test_topPodsTable(t *testing.T) {
+	pod := func(n string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: n}}
+	}
+	alloc := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("1"),
+		v1.ResourceMemory: resource.MustParse("1000Mi"),
+	}
+	pp := []*dao.PodWithMetrics{
+		{Pod: pod("p1"), MX: new(mv1beta1.PodMetrics), CPU: 500, MEM: 100 * 1024 * 1024},
+		{Pod: pod("p2")},
+	}
+
+	uu := map[string]struct {
+		pp     []*dao.PodWithMetrics
+		sortBy dao.ResourceField
+		e      []string
+	}{
+		"none": {
+			e: []string{"No pods found"},
+		},
+		"cpu": {
+			pp:     pp,
+			sortBy: dao.ResourceCPU,
+			e: []string{
+				"NAME      CPU(m)   MEM(Mi)  %CPU/A",
+				"ns1/p1       500       100  50% ▰▰▰▰▰▱▱▱▱▱",
+				"ns1/p2         0         0  n/a",
+			},
+		},
+		"mem": {
+			pp:     pp,
+			sortBy: dao.ResourceMEM,
+			e: []string{
+				"NAME      CPU(m)   MEM(Mi)  %MEM/A",
+				"ns1/p1       500       100  10% ▰▱▱▱▱▱▱▱▱▱",
+				"ns1/p2         0         0  n/a",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, strings.Split(topPodsTable(u.pp, alloc, u.sortBy), "\n"))
+		})
+	}
+}
+
+func Test_systemPodsTable(t *testing.T) {
+	ctrl := true
+	pp := []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "dns"},
+			Status:     v1.PodStatus{Phase: v1.PodRunning},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "fluentd-x",
+				OwnerReferences: []metav1.OwnerReference{
+					{Kind: "DaemonSet", Name: "fluentd", Controller: &ctrl},
+				},
+			},
+			Status: v1.PodStatus{Phase: v1.PodPending},
+		},
+	}
+
+	assert.Empty(t, systemPodsTable(nil))
+	assert.Equal(t, []string{
+		"",
+		"",
+		"[aqua::b]System Pods[-::-]",
+		"NAME               PHASE    OWNER",
+		"[aqua::]kube-system/dns    Running  <none>[-::]",
+		"[aqua::]default/fluentd-x  Pending  DaemonSet/fluentd[-::]",
+	}, strings.Split(systemPodsTable(pp), "\n"))
+}
+
+func Test_benchmarkReport(t *testing.T) {
+	r := dao.BenchmarkResult{
+		Node:        "n1",
+		Duration:    31200 * time.Millisecond,
+		Samples:     6,
+		BaselineCPU: 250,
+		MinCPU:      800,
+		MaxCPU:      1900,
+		AvgCPU:      1500,
+	}
+
+	assert.Equal(t, "\nNode:     n1\nDuration: 31s\nSamples:  6\nBaseline: 250m\nCPU Impact (min/max/avg): 800m/1900m/1500m", benchmarkReport(&r))
+}
+
+func Test_allocatableTable(t *testing.T) {
+	alloc := v1.ResourceList{
+		v1.ResourceCPU:              resource.MustParse("3500m"),
+		v1.ResourceMemory:           resource.MustParse("900Mi"),
+		v1.ResourcePods:             resource.MustParse("110"),
+		"nvidia.com/gpu":            resource.MustParse("1"),
+		v1.ResourceEphemeralStorage: resource.MustParse("50Mi"),
+	}
+	capacity := v1.ResourceList{
+		v1.ResourceCPU:              resource.MustParse("4"),
+		v1.ResourceMemory:           resource.MustParse("1000Mi"),
+		v1.ResourcePods:             resource.MustParse("110"),
+		"nvidia.com/gpu":            resource.MustParse("2"),
+		v1.ResourceEphemeralStorage: resource.MustParse("100Mi"),
+	}
+
+	e := []string{
+		"Allocatable Resources",
+		"RESOURCE           ALLOCATABLE     CAPACITY  %ALLOC",
+		"cpu                      3500m        4000m  [yellow::]87% ▰▰▰▰▰▰▰▰▱▱[-::]",
+		"memory                   900Mi       1000Mi  [yellow::]90% ▰▰▰▰▰▰▰▰▰▱[-::]",
+		"ephemeral-storage         50Mi        100Mi  50% ▰▰▰▰▰▱▱▱▱▱",
+		"pods                       110          110  [red::]100% ▰▰▰▰▰▰▰▰▰▰[-::]",
+		"nvidia.com/gpu               1            2  50% ▰▰▰▰▰▱▱▱▱▱",
+	}
+	assert.Equal(t, e, strings.Split(allocatableTable(alloc, capacity), "\n"))
+}
+
+func Test_kernelCVETable(t *testing.T) {
+	cc := []client.CVE{
+		{ID: "CVE-2023-12345", Score: 9.8, Severity: "CRITICAL", URL: "https://nvd/CVE-2023-12345"},
+		{ID: "CVE-2023-1", Score: 5.5, Severity: "MEDIUM", URL: "https://nvd/CVE-2023-1"},
+		{ID: "CVE-2022-2", Score: 2.1, Severity: "LOW", URL: "https://nvd/CVE-2022-2"},
+		{ID: "CVE-2021-3", URL: "https://nvd/CVE-2021-3"},
+	}
+
+	e := []string{
+		"Kernel 5.15.0-1034-aws (release 5.15.0): 4 known CVEs",
+		"CVE             SCORE  SEVERITY  LINK",
+		"CVE-2023-12345    9.8  [red::]CRITICAL[-::]  https://nvd/CVE-2023-12345",
+		"CVE-2023-1        5.5  [orange::]MEDIUM  [-::]  https://nvd/CVE-2023-1",
+		"CVE-2022-2        2.1  LOW       https://nvd/CVE-2022-2",
+		"CVE-2021-3        n/a  n/a       https://nvd/CVE-2021-3",
+	}
+	assert.Equal(t, e, strings.Split(kernelCVETable("5.15.0-1034-aws", cc), "\n"))
+	assert.Equal(t, "Kernel 6.1.0 (release 6.1.0): 0 known CVEs", kernelCVETable("6.1.0", nil))
+}
+
+func Test_snapshotFileName(t *testing.T) {
+	uu := map[string]struct {
+		node string
+		e    string
+	}{
+		"plain": {
+			node: "n1",
+			e:    "node-snapshot-n1-1700000000.yaml",
+		},
+		"sanitized": {
+			node: "ip-10-0-0-1:fred",
+			e:    "node-snapshot-ip-10-0-0-1-fred-1700000000.yaml",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, snapshotFileName(u.node, time.Unix(1700000000, 0)))
+		})
+	}
+}
+
+func Test_nodeSystemInfo(t *testing.T) {
+	uu := map[string]struct {
+		o map[string]any
+		e string
+	}{
+		"empty": {
+			o: map[string]any{},
+			e: "# System Info\nsystemInfo:\n  architecture: \"\"\n  containerRuntimeVersion: \"\"\n  kernelVersion: \"\"\n  kubeletVersion: \"\"\n  operatingSystem: \"\"\n  osImage: \"\"\n---\n",
+		},
+		"full": {
+			o: map[string]any{
+				"status": map[string]any{
+					"nodeInfo": map[string]any{
+						"osImage":                 "Ubuntu 22.04.4 LTS",
+						"kernelVersion":           "5.15.0-1057-aws",
+						"operatingSystem":         "linux",
+						"architecture":            "amd64",
+						"containerRuntimeVersion": "containerd://1.7.2",
+						"kubeletVersion":          "v1.30.1",
+						"machineID":               "fred",
+					},
+				},
+			},
+			e: "# System Info\nsystemInfo:\n  architecture: amd64\n  containerRuntimeVersion: containerd://1.7.2\n  kernelVersion: 5.15.0-1057-aws\n  kubeletVersion: v1.30.1\n  operatingSystem: linux\n  osImage: Ubuntu 22.04.4 LTS\n---\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := nodeSystemInfo(u.o)
+			require.NoError(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func Test_parseMaintenanceTime(t *testing.T) {
+	now := time.Date(2024, 5, 10, 14, 30, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		v   string
+		e   time.Time
+		err bool
+	}{
+		"delay": {
+			v: "90m",
+			e: now.Add(90 * time.Minute),
+		},
+		"plus-delay": {
+			v: "+2h",
+			e: now.Add(2 * time.Hour),
+		},
+		"date-time": {
+			v: "2024-05-11 02:00",
+			e: time.Date(2024, 5, 11, 2, 0, 0, 0, time.UTC),
+		},
+		"later-today": {
+			v: "16:15",
+			e: time.Date(2024, 5, 10, 16, 15, 0, 0, time.UTC),
+		},
+		"tomorrow": {
+			v: "09:00",
+			e: time.Date(2024, 5, 11, 9, 0, 0, 0, time.UTC),
+		},
+		"toast": {
+			v:   "fred",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			at, err := parseMaintenanceTime(u.v, now)
+			if u.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, u.e, at)
+		})
+	}
+}
+
+func Test_nodeFilesystemsInfo(t *testing.T) {
+	ff := []dao.FilesystemUsage{
+		{Name: dao.NodeFs, Capacity: 100 * 1024 * 1024 * 1024, Used: 90 * 1024 * 1024 * 1024, Available: 10 * 1024 * 1024 * 1024, Inodes: 100, InodesUsed: 5},
+	}
+	s, err := nodeFilesystemsInfo(ff)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(s, "# Filesystems\nfilesystems:\n"))
+	assert.Contains(t, s, "name: nodefs")
+	assert.Contains(t, s, "capacity: 100.0Gi")
+	assert.Contains(t, s, "usage: 90%")
+	assert.Contains(t, s, "inodesUsage: 5%")
+	assert.True(t, strings.HasSuffix(s, "---\n"))
+}
+
+func Test_nodeConditionHistoryInfo(t *testing.T) {
+	assert.Empty(t, nodeConditionHistoryInfo(nil))
+
+	cc := []dao.ConditionEvent{
+		{Condition: v1.NodeReady, Timestamp: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), Status: v1.ConditionFalse, Reason: "NodeNotReady"},
+	}
+	s := nodeConditionHistoryInfo(cc)
+
+	assert.True(t, strings.HasPrefix(s, "# Condition History\nconditionHistory:\n"))
+	assert.Contains(t, s, "time: \"2024-01-01 10:00:00\"")
+	assert.Contains(t, s, "condition: Ready")
+	assert.Contains(t, s, "status: \"False\"")
+	assert.Contains(t, s, "reason: NodeNotReady")
+	assert.True(t, strings.HasSuffix(s, "---\n"))
+}
+
+func Test_nextNodeArch(t *testing.T) {
+	aa := []string{"amd64", "arm64"}
+	uu := map[string]struct {
+		aa     []string
+		filter string
+		e      string
+	}{
+		"none":     {aa: aa, e: "amd64"},
+		"first":    {aa: aa, filter: "-l kubernetes.io/arch=amd64", e: "arm64"},
+		"last":     {aa: aa, filter: "-l kubernetes.io/arch=arm64"},
+		"unknown":  {aa: aa, filter: "-l kubernetes.io/arch=s390x", e: "amd64"},
+		"other":    {aa: aa, filter: "-l zone=us-east-1", e: "amd64"},
+		"merged":   {aa: aa, filter: "-l zone=us-east-1,kubernetes.io/arch=amd64", e: "arm64"},
+		"no-archs": {filter: "-l kubernetes.io/arch=amd64"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, nextNodeArch(u.aa, u.filter))
+		})
+	}
+}
+
+func Test_withNodeArch(t *testing.T) {
+	uu := map[string]struct {
+		filter, arch, e string
+	}{
+		"none":     {arch: "amd64", e: "kubernetes.io/arch=amd64"},
+		"fuzzy":    {filter: "fred", arch: "amd64", e: "kubernetes.io/arch=amd64"},
+		"merge":    {filter: "-l zone=us-east-1", arch: "amd64", e: "kubernetes.io/arch=amd64,zone=us-east-1"},
+		"replace":  {filter: "-l zone=us-east-1,kubernetes.io/arch=amd64", arch: "arm64", e: "kubernetes.io/arch=arm64,zone=us-east-1"},
+		"drop":     {filter: "-l zone=us-east-1,kubernetes.io/arch=arm64", e: "zone=us-east-1"},
+		"drop-all": {filter: "-l kubernetes.io/arch=arm64"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, withNodeArch(u.filter, u.arch))
+		})
+	}
+}
+
+func Test_parseNodeLabelFilter(t *testing.T) {
+	uu := map[string]struct {
+		filter, sel, e string
+		err            bool
+	}{
+		"empty":   {},
+		"fuzzy":   {filter: "fred"},
+		"valid":   {filter: "-l zone=us-east-1", sel: "zone=us-east-1", e: "zone=us-east-1"},
+		"invalid": {filter: "-l zone in (a", sel: "zone in (a", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sel, strSel, err := parseNodeLabelFilter(u.filter)
+			assert.Equal(t, u.sel, strSel)
+			if u.err {
+				require.Error(t, err)
+				assert.Nil(t, sel)
+				return
+			}
+			require.NoError(t, err)
+			if u.e == "" {
+				assert.Nil(t, sel)
+				return
+			}
+			assert.Equal(t, u.e, sel.String())
+		})
+	}
+}
+
+func Test_writeNodesCSV(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "TAINTS"},
+	}
+	rr := []model1.Row{
+		{ID: "n3", Fields: model1.Fields{"n3", "a=b", "dedicated=gpu:NoSchedule"}},
+		{ID: "n1", Fields: model1.Fields{"n1", "c=d", "<none>"}},
+	}
+	path := filepath.Join(t.TempDir(), "nodes", "nodes.csv")
+
+	require.NoError(t, writeNodesCSV(path, h, rr, []int{0, 2}, map[string]int{"TAINTS": 10}))
+	bb, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "NAME,TAINTS\nn3,dedicated…\nn1,<none>\n", string(bb))
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// NodePool presents nodes aggregated by pool.
+type NodePool struct {
+	ResourceViewer
+}
+
+// NewNodePool returns a new viewer.
+func NewNodePool(gvr *client.GVR) ResourceViewer {
+	p := NodePool{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetEnterFn(p.showNodes)
+	p.SetContextFn(p.poolContext)
+
+	return &p
+}
+
+func (p *NodePool) poolContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyNodePoolLabel, p.poolLabel())
+}
+
+func (p *NodePool) poolLabel() string {
+	if l := p.App().Config.K9s.NodePoolLabel; l != "" {
+		return l
+	}
+
+	return config.DefaultNodePoolLabel
+}
+
+func (p *NodePool) showNodes(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	v := NewNode(client.NodeGVR)
+	v.SetLabelFilter(map[string]string{p.poolLabel(): path})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePoolNew(t *testing.T) {
+	p := view.NewNodePool(client.NplGVR)
+
+	require.NoError(t, p.Init(makeCtx(t)))
+	assert.Equal(t, "NodePools", p.Name())
+	assert.Len(t, p.Hints(), 5)
+}
@@ -4,8 +4,12 @@
 package view
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
@@ -35,6 +39,7 @@ func NewNamespace(gvr *client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyQ:      ui.NewKeyAction("Quotas", n.quotasCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
 	})
 }
@@ -54,6 +59,54 @@ func (n *Namespace) useNsCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (n *Namespace) quotasCmd(*tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	v := newNamespaceQuotas(ns, n.App().Config.ActiveNamespace())
+	if err := n.App().inject(v, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// namespaceQuotas lists a namespace quotas. The namespace is only active while
+// the quotas are displayed.
+type namespaceQuotas struct {
+	ResourceViewer
+
+	ns, prevNS string
+}
+
+func newNamespaceQuotas(ns, prevNS string) *namespaceQuotas {
+	return &namespaceQuotas{
+		ResourceViewer: NewQuotaUsage(client.QuoGVR),
+		ns:             ns,
+		prevNS:         prevNS,
+	}
+}
+
+// Init initializes the view scoped to the quotas namespace.
+func (q *namespaceQuotas) Init(ctx context.Context) error {
+	if err := q.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	q.GetTable().GetModel().SetNamespace(q.ns)
+
+	return nil
+}
+
+// Stop terminates the view and restores the namespace active prior to showing the quotas.
+func (q *namespaceQuotas) Stop() {
+	q.ResourceViewer.Stop()
+	if err := q.App().switchNS(q.prevNS); err != nil {
+		slog.Error("Unable to restore namespace", slogs.Error, err)
+	}
+}
+
 func (n *Namespace) useNamespace(fqn string) {
 	_, ns := client.Namespaced(fqn)
 	if client.CleanseNamespace(n.App().Config.ActiveNamespace()) == ns {
@@ -13,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/port"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
@@ -128,16 +129,25 @@ func ensurePodPortFwdAllowed(factory dao.Factory, podName string) error {
 	return nil
 }
 
-func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForwarder) {
+func runForward(v ResourceViewer, pf *dao.PortForwarder, f *portforward.PortForwarder) {
 	v.App().factory.AddForwarder(pf)
+	key := dao.PortForwards.Register(pf, f)
 
 	v.App().QueueUpdateDraw(func() {
 		DismissPortForwards(v, v.App().Content.Pages)
 	})
 
+	done := make(chan struct{})
+	go trackForwardReady(key, f.Ready, done)
+
 	pf.SetActive(true)
-	if err := f.ForwardPorts(); err != nil {
+	err := f.ForwardPorts()
+	close(done)
+	if err != nil {
 		v.App().Flash().Err(err)
+		dao.PortForwards.SetStatus(key, render.PortForwardFailed, err)
+	} else {
+		dao.PortForwards.Unregister(key)
 	}
 	v.App().QueueUpdateDraw(func() {
 		v.App().factory.DeleteForwarder(pf.ID())
@@ -145,6 +155,14 @@ func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForward
 	})
 }
 
+func trackForwardReady(key dao.PortForwardKey, ready <-chan struct{}, done <-chan struct{}) {
+	select {
+	case <-ready:
+		dao.PortForwards.SetStatus(key, render.PortForwardActive, nil)
+	case <-done:
+	}
+}
+
 func startFwdCB(v ResourceViewer, path string, pts port.PortTunnels) error {
 	if err := pts.CheckAvailable(); err != nil {
 		return err
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/tview"
+	"github.com/fatih/color"
+)
+
+// playbackMaxDelay caps idle pauses while replaying a recorded session.
+const playbackMaxDelay = 2 * time.Second
+
+// Playback replays a recorded pod shell session.
+type Playback struct {
+	*Details
+
+	records []dao.ExecRecord
+	cancel  context.CancelFunc
+}
+
+func newPlayback(app *App, path string, rr []dao.ExecRecord) *Playback {
+	return &Playback{
+		Details: NewDetails(app, "Playback", filepath.Base(path), contentTXT, false),
+		records: rr,
+	}
+}
+
+// Start starts the session replay.
+func (p *Playback) Start() {
+	p.Stop()
+
+	var ctx context.Context
+	ctx, p.cancel = context.WithCancel(context.Background())
+	go p.play(ctx)
+}
+
+// Stop terminates the session replay.
+func (p *Playback) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+		p.cancel = nil
+	}
+	p.Details.Stop()
+}
+
+func (p *Playback) play(ctx context.Context) {
+	p.app.QueueUpdateDraw(func() {
+		p.text.Clear()
+	})
+	st := p.app.Styles.Views().Log
+	w := tview.ANSIWriter(p.text, st.FgColor.String(), st.BgColor.String())
+	err := dao.PlaybackExec(ctx, p.records, playbackWriter{app: p.app, w: w}, playbackMaxDelay)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		p.app.Flash().Errf("Playback failed: %s", err)
+	}
+}
+
+// playbackWriter writes replayed chunks on the UI thread.
+type playbackWriter struct {
+	app *App
+	w   io.Writer
+}
+
+func (p playbackWriter) Write(b []byte) (int, error) {
+	s := string(b)
+	p.app.QueueUpdateDraw(func() {
+		if _, err := io.WriteString(p.w, s); err != nil {
+			slog.Warn("Playback write failed", slogs.Error, err)
+		}
+	})
+
+	return len(b), nil
+}
+
+func loadExecRecording(path string) ([]dao.ExecRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open exec recording: %w", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			slog.Warn("Exec recording close failed", slogs.Error, err)
+		}
+	}()
+	rr, err := dao.ReadExecRecording(f)
+	if err != nil {
+		return nil, err
+	}
+	if len(rr) == 0 {
+		return nil, fmt.Errorf("exec recording %s is empty", path)
+	}
+
+	return rr, nil
+}
+
+// execRecordingName returns a shell session recording file name.
+func execRecordingName(fqn, co string, t time.Time) string {
+	ns, n := client.Namespaced(fqn)
+
+	return fmt.Sprintf("%s_%s_%s_%s.jsonl", ns, n, co, t.Format("20060102-150405"))
+}
+
+// recordingNotice warns the session is recorded and whether typed input is captured.
+func recordingNotice(path string, input bool) string {
+	if input {
+		return fmt.Sprintf("WARNING: this session input and output are recorded to %s. Typed passwords will be captured!", path)
+	}
+
+	return fmt.Sprintf("This session output is recorded to %s.", path)
+}
+
+// recordShellIn shells into a pod container while recording the session.
+func recordShellIn(a *App, fqn, co, podOS, dir string) error {
+	path := filepath.Join(dir, execRecordingName(fqn, co, time.Now()))
+	input := a.Config.K9s.Audit.ExecRecordInput
+	var po dao.Pod
+	po.Init(a.factory, client.PodGVR)
+
+	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	var err error
+	a.Halt()
+	defer a.Resume()
+	ok := a.Suspend(func() {
+		clearScreen()
+		fmt.Println(c.Sprintf(bannerFmt, fqn, co))
+		fmt.Println(recordingNotice(path, input))
+		err = po.RecordExec(context.Background(), fqn, co, shellCommand(podOS), path, input)
+		clearScreen()
+	})
+	if !ok {
+		return errors.New("unable to run command")
+	}
+	if err != nil {
+		return err
+	}
+	a.Flash().Infof("Shell session recorded to %s", path)
+
+	return nil
+}
@@ -45,11 +45,13 @@ const (
 // Pod represents a pod viewer.
 type Pod struct {
 	ResourceViewer
+
+	restarts *dao.PodRestartTracker
 }
 
 // NewPod returns a new viewer.
 func NewPod(gvr *client.GVR) ResourceViewer {
-	var p Pod
+	p := Pod{restarts: dao.NewPodRestartTracker(dao.DefaultRestartWindow)}
 	p.ResourceViewer = NewPortForwardExtender(
 		NewOwnerExtender(
 			NewVulnerabilityExtender(
@@ -62,10 +64,22 @@ func NewPod(gvr *client.GVR) ResourceViewer {
 	p.AddBindKeysFn(p.bindKeys)
 	p.GetTable().SetEnterFn(p.showContainers)
 	p.GetTable().SetDecorateFn(p.portForwardIndicator)
+	p.SetContextFn(nil)
 
 	return &p
 }
 
+// SetContextFn sets the view custom context while retaining the pod restarts tracking.
+func (p *Pod) SetContextFn(f ContextFunc) {
+	p.ResourceViewer.SetContextFn(func(ctx context.Context) context.Context {
+		if f != nil {
+			ctx = f(ctx)
+		}
+
+		return context.WithValue(ctx, internal.KeyPodRestarts, p.restarts)
+	})
+}
+
 func (p *Pod) portForwardIndicator(data *model1.TableData) {
 	ff := p.App().factory.Forwarders()
 
@@ -120,6 +134,13 @@ func (p *Pod) bindDangerousKeys(aa *ui.KeyActions) {
 				Visible:   true,
 				Dangerous: true,
 			}),
+		ui.KeyShiftD: ui.NewKeyActionWithOpts(
+			"Debug",
+			p.debugCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}),
 	})
 }
 
@@ -135,6 +156,8 @@ func (p *Pod) bindKeys(aa *ui.KeyActions) {
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
+		ui.KeyShiftU: ui.NewKeyAction("VPA", p.vpaCmd, true),
+		ui.KeyShiftW: ui.NewKeyAction(crashTitle, p.crashCmd, true),
 	})
 	aa.Merge(resourceSorters(p.GetTable()))
 }
@@ -243,6 +266,23 @@ func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) debugCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	ShowDebug(p, path, p.App().Config.K9s.Debug.Images, func(image string) {
+		launchDebugSidecar(p, path, image)
+	})
+
+	return nil
+}
+
 func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -403,6 +443,12 @@ func shellIn(a *App, fqn, co string) {
 	if err != nil {
 		slog.Warn("OS detect failed", slogs.Error, err)
 	}
+	if dir := a.Config.K9s.Audit.ExecRecordDir; dir != "" {
+		if err := recordShellIn(a, fqn, co, os, dir); err != nil {
+			a.Flash().Errf("Shell exec failed: %s", err)
+		}
+		return
+	}
 	args := computeShellArgs(fqn, co, a.Conn().Config().Flags().KubeConfig, os)
 
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
@@ -460,10 +506,17 @@ func attachIn(a *App, path, co string) {
 
 func computeShellArgs(path, co string, kcfg *string, os string) []string {
 	args := buildShellArgs("exec", path, co, kcfg)
+
+	return append(append(args, "--"), shellCommand(os)...)
+}
+
+// shellCommand returns the shell command for the given pod os.
+func shellCommand(os string) []string {
 	if os == windowsOS {
-		return append(args, "--", powerShell)
+		return []string{powerShell}
 	}
-	return append(args, "--", "sh", "-c", shellCheck)
+
+	return []string{"sh", "-c", shellCheck}
 }
 
 func buildShellArgs(cmd, path, co string, kcfg *string) []string {
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tcell/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const crashTitle = "Crash Analysis"
+
+func (p *Pod) crashCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	go showCrashAnalysis(p.App(), path, "")
+
+	return nil
+}
+
+func showCrashAnalysis(app *App, path, co string) {
+	var po dao.Pod
+	po.Init(app.factory, client.PodGVR)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(path)
+	a, err := po.AnalyzeCrashLoop(ctx, ns, n, co)
+	var bb []byte
+	if err == nil {
+		bb, err = yaml.Marshal(a)
+	}
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		details := NewDetails(app, crashTitle, path, contentYAML, true).Update(string(bb))
+		if err := app.inject(details, false); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+)
+
+const debugKey = "debug"
+
+// ShowDebug pops a pod debug sidecar image selection dialog.
+func ShowDebug(view ResourceViewer, path string, images []string, okFn func(image string)) {
+	styles := view.App().Styles.Dialog()
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	var image string
+	if len(images) > 0 {
+		image = images[0]
+	}
+	f.AddDropDown("Image:", images, 0, func(opt string, _ int) {
+		image = opt
+	})
+	if dd, ok := f.GetFormItemByLabel("Image:").(*tview.DropDown); ok {
+		dd.SetListStyles(
+			styles.FgColor.Color(), styles.BgColor.Color(),
+			styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+		)
+	}
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissDebug(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissDebug(view, pages)
+		okFn(image)
+	})
+
+	modal := tview.NewModalForm("<Debug>", f)
+	modal.SetText(fmt.Sprintf("Attach an ephemeral debug container to pod %s?", path))
+	modal.SetDoneFunc(func(int, string) {
+		DismissDebug(view, pages)
+	})
+
+	pages.AddPage(debugKey, modal, false, true)
+	pages.ShowPage(debugKey)
+	view.App().SetFocus(pages.GetPrimitive(debugKey))
+}
+
+// DismissDebug dismiss the debug dialog.
+func DismissDebug(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(debugKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+// launchDebugSidecar attaches a debug container to a pod and shells into it once running.
+func launchDebugSidecar(v ResourceViewer, path, image string) {
+	a := v.App()
+	msg := fmt.Sprintf("Launching debug container %s in pod %s...", image, path)
+	d := a.Styles.Dialog()
+	dialog.ShowPrompt(&d, a.Content.Pages, "Debugging", msg, func(ctx context.Context) {
+		var po dao.Pod
+		po.Init(a.factory, client.PodGVR)
+		ns, n := client.Namespaced(path)
+		co, err := po.AttachDebugSidecar(ctx, ns, n, image)
+		if err != nil {
+			if !errors.Is(err, context.Canceled) {
+				a.Flash().Errf("Debug container launch failed: %s", err)
+			}
+			return
+		}
+
+		go resumeShellIn(a, v, path, co)
+	}, func() {})
+}
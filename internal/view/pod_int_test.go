@@ -7,7 +7,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestComputeShellArgs(t *testing.T) {
@@ -106,3 +109,47 @@ func TestComputeShellArgs(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func Test_vpaDiff(t *testing.T) {
+	rec := dao.VPARecommendation{
+		VPA:       "ns1/v1",
+		Namespace: "ns1",
+		Kind:      "Deployment",
+		Name:      "dp1",
+		Containers: []dao.ContainerRecommendation{
+			{
+				Container: "c1",
+				Requests: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("100m"),
+					v1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+				Target: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("250m"),
+					v1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+				LowerBound: v1.ResourceList{v1.ResourceCPU: resource.MustParse("200m")},
+				UpperBound: v1.ResourceList{v1.ResourceCPU: resource.MustParse("1")},
+			},
+			{
+				Container: "c2",
+				Target:    v1.ResourceList{v1.ResourceMemory: resource.MustParse("64Mi")},
+			},
+		},
+	}
+
+	e := []string{
+		"# Deployment ns1/dp1 recommendations from VPA ns1/v1",
+		"--- c1 requests",
+		"+++ c1 target",
+		"-cpu: 100m",
+		"+cpu: 250m",
+		" memory: 128Mi",
+		"@@ bounds cpu=200m..1 memory=n/a..n/a @@",
+		"--- c2 requests",
+		"+++ c2 target",
+		"-memory: n/a",
+		"+memory: 64Mi",
+		"@@ bounds memory=n/a..n/a @@",
+	}
+	assert.Equal(t, e, strings.Split(vpaDiff(&rec), "\n"))
+}
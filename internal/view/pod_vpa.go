@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+const vpaTitle = "VPA Recommendations"
+
+func (p *Pod) vpaCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	go showVPARecommendation(p.App(), path)
+
+	return nil
+}
+
+func showVPARecommendation(app *App, path string) {
+	var po dao.Pod
+	po.Init(app.factory, client.PodGVR)
+	ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(path)
+	rec, err := po.GetVPARecommendation(ctx, ns, n)
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		details := NewDetails(app, vpaTitle, path, contentDiff, true).Update(vpaDiff(rec))
+		if !app.Config.IsReadOnly() {
+			details.Actions().Add(ui.KeyA, ui.NewKeyActionWithOpts("Apply", applyVPACmd(app, rec),
+				ui.ActionOpts{
+					Visible:   true,
+					Dangerous: true,
+				}))
+		}
+		if err := app.inject(details, false); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
+
+func applyVPACmd(app *App, rec *dao.VPARecommendation) ui.ActionHandler {
+	return func(*tcell.EventKey) *tcell.EventKey {
+		fqn := client.FQN(rec.Namespace, rec.Name)
+		msg := fmt.Sprintf("Set %s %s containers requests to the VPA %s targets? This rolls out new pods.", rec.Kind, fqn, rec.VPA)
+		d := app.Styles.Dialog()
+		dialog.ShowConfirm(&d, app.Content.Pages, "Apply VPA Recommendation", msg, func() {
+			var po dao.Pod
+			po.Init(app.factory, client.PodGVR)
+			ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+			defer cancel()
+			if err := po.ApplyVPARecommendation(ctx, rec); err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("VPA recommendation applied to %s %s", rec.Kind, fqn)
+		}, func() {})
+
+		return nil
+	}
+}
+
+// vpaDiff renders a VPA recommendation as a diff against the current containers requests.
+func vpaDiff(rec *dao.VPARecommendation) string {
+	ll := []string{fmt.Sprintf("# %s %s recommendations from VPA %s", rec.Kind, client.FQN(rec.Namespace, rec.Name), rec.VPA)}
+	for _, c := range rec.Containers {
+		ll = append(ll, "--- "+c.Container+" requests", "+++ "+c.Container+" target")
+		rr := make([]v1.ResourceName, 0, len(c.Target)+len(c.Requests))
+		for r := range c.Requests {
+			rr = append(rr, r)
+		}
+		for r := range c.Target {
+			if _, ok := c.Requests[r]; !ok {
+				rr = append(rr, r)
+			}
+		}
+		slices.Sort(rr)
+		bb := make([]string, 0, len(rr))
+		for _, r := range rr {
+			curr, target := vpaQuantity(c.Requests, r), vpaQuantity(c.Target, r)
+			if curr == target {
+				ll = append(ll, fmt.Sprintf(" %s: %s", r, curr))
+			} else {
+				ll = append(ll, fmt.Sprintf("-%s: %s", r, curr), fmt.Sprintf("+%s: %s", r, target))
+			}
+			bb = append(bb, fmt.Sprintf("%s=%s..%s", r, vpaQuantity(c.LowerBound, r), vpaQuantity(c.UpperBound, r)))
+		}
+		if len(bb) > 0 {
+			ll = append(ll, "@@ bounds "+strings.Join(bb, " ")+" @@")
+		}
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func vpaQuantity(rl v1.ResourceList, r v1.ResourceName) string {
+	q, ok := rl[r]
+	if !ok {
+		return render.NAValue
+	}
+
+	return q.String()
+}
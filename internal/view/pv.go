@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	v1 "k8s.io/api/core/v1"
+)
+
+const reclaimPolicyCol = "RECLAIM POLICY"
+
+// PersistentVolume represents a PV custom viewer.
+type PersistentVolume struct {
+	ResourceViewer
+}
+
+// NewPersistentVolume returns a new viewer.
+func NewPersistentVolume(gvr *client.GVR) ResourceViewer {
+	v := PersistentVolume{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+
+	return &v
+}
+
+func (p *PersistentVolume) bindKeys(aa *ui.KeyActions) {
+	if !p.App().Config.IsReadOnly() {
+		aa.Add(ui.KeyR, ui.NewKeyActionWithOpts("Reclaim Policy", p.reclaimPolicyCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}))
+	}
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Reclaim Policy", p.GetTable().SortColCmd(reclaimPolicyCol, true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Capacity", p.GetTable().SortColCmd("CAPACITY", true), false),
+	})
+}
+
+// reclaimPolicyCmd toggles the selected volume reclaim policy between Retain and Delete.
+func (p *PersistentVolume) reclaimPolicyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	idx, ok := p.GetTable().HeaderIndex(reclaimPolicyCol)
+	if !ok {
+		p.App().Flash().Errf("no %s column found", reclaimPolicyCol)
+		return nil
+	}
+	policy := toggleReclaimPolicy(v1.PersistentVolumeReclaimPolicy(p.GetTable().GetSelectedCell(idx)))
+
+	title, msg := "Confirm Reclaim Policy", fmt.Sprintf("Set persistent volume %s reclaim policy to %s?", path, policy)
+	override := policy == v1.PersistentVolumeReclaimDelete
+	if override {
+		msg = fmt.Sprintf("Warning! Switching persistent volume %s to Delete removes the underlying storage once released. "+
+			"This is irreversible without manual intervention. Type the volume name to confirm.", path)
+	}
+	dialog.ShowConfirmAck(p.App().App, p.App().Content.Pages, path, override, title, msg, func() {
+		var pv dao.PersistentVolume
+		pv.Init(p.App().factory, client.PvGVR)
+		ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := pv.SetReclaimPolicy(ctx, path, policy); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Persistent volume %s reclaim policy set to %s", path, policy)
+	}, func() {})
+
+	return nil
+}
+
+// toggleReclaimPolicy returns the reclaim policy to switch to from the given one.
+func toggleReclaimPolicy(p v1.PersistentVolumeReclaimPolicy) v1.PersistentVolumeReclaimPolicy {
+	if p == v1.PersistentVolumeReclaimRetain {
+		return v1.PersistentVolumeReclaimDelete
+	}
+
+	return v1.PersistentVolumeReclaimRetain
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.MetaAccess.RegisterMeta(client.PvGVR.String(), &metav1.APIResource{
+		Name:         "persistentvolumes",
+		SingularName: "persistentvolume",
+		Kind:         "PersistentVolumes",
+		Verbs:        []string{"get", "list", "watch", "delete", "patch"},
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestPVNew(t *testing.T) {
+	v := view.NewPersistentVolume(client.PvGVR)
+
+	require.NoError(t, v.Init(makeCtx(t)))
+	assert.Equal(t, "PersistentVolumes", v.Name())
+	assert.Len(t, v.Hints(), 9)
+}
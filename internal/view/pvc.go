@@ -4,11 +4,18 @@
 package view
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 )
 
+const migrateTitle = "Migrate StorageClass"
+
 // PersistentVolumeClaim represents a PVC custom viewer.
 type PersistentVolumeClaim struct {
 	ResourceViewer
@@ -25,6 +32,13 @@ func NewPersistentVolumeClaim(gvr *client.GVR) ResourceViewer {
 }
 
 func (p *PersistentVolumeClaim) bindKeys(aa *ui.KeyActions) {
+	if !p.App().Config.IsReadOnly() {
+		aa.Add(ui.KeyM, ui.NewKeyActionWithOpts(migrateTitle, p.migrateCmd,
+			ui.ActionOpts{
+				Visible:   true,
+				Dangerous: true,
+			}))
+	}
 	aa.Bulk(ui.KeyMap{
 		ui.KeyU:      ui.NewKeyAction("UsedBy", p.refCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd("STATUS", true), false),
@@ -37,3 +51,83 @@ func (p *PersistentVolumeClaim) bindKeys(aa *ui.KeyActions) {
 func (p *PersistentVolumeClaim) refCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return scanRefs(evt, p.App(), p.GetTable(), client.PvcGVR)
 }
+
+func (p *PersistentVolumeClaim) migrateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	pvc, err := p.pvcDAO()
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+	defer cancel()
+	scs, err := pvc.StorageClassNames(ctx)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	if len(scs) == 0 {
+		p.App().Flash().Warn("No storage classes found")
+		return nil
+	}
+
+	picker := NewPicker()
+	picker.populate(scs)
+	picker.SetSelectedFunc(func(_ int, sc, _ string, _ rune) {
+		p.App().PrevCmd(nil)
+		p.confirmMigrate(pvc, path, sc)
+	})
+	if err := p.App().inject(picker, false); err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	picker.SetTitle(" [aqua::b]StorageClass Picker ")
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) confirmMigrate(pvc *dao.PersistentVolumeClaim, path, sc string) {
+	msg := fmt.Sprintf("Migrate claim %s to storage class %s? Workloads mounting the claim are scaled down until the data is copied.", path, sc)
+	d := p.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, p.App().Content.Pages, migrateTitle, msg, func() {
+		p.migrate(pvc, path, sc)
+	}, func() {})
+}
+
+func (p *PersistentVolumeClaim) migrate(pvc *dao.PersistentVolumeClaim, path, sc string) {
+	d := NewDetails(p.App(), migrateTitle, path, contentTXT, true)
+	if err := p.App().inject(d, false); err != nil {
+		p.App().Flash().Err(err)
+		return
+	}
+
+	w := queuedWriter{app: p.App(), w: d.GetWriter()}
+	go func() {
+		err := pvc.MigrateStorageClass(context.Background(), path, sc, w)
+		p.App().QueueUpdateDraw(func() {
+			if err != nil {
+				_, _ = fmt.Fprintf(d.GetWriter(), "Migration failed: %s\n", err)
+				p.App().Flash().Err(err)
+				return
+			}
+			p.App().Flash().Infof("Claim %s migrated to storage class %s", path, sc)
+		})
+	}()
+}
+
+func (p *PersistentVolumeClaim) pvcDAO() (*dao.PersistentVolumeClaim, error) {
+	res, err := dao.AccessorFor(p.App().factory, client.PvcGVR)
+	if err != nil {
+		return nil, err
+	}
+	pvc, ok := res.(*dao.PersistentVolumeClaim)
+	if !ok {
+		return nil, fmt.Errorf("expecting a pvc resource but got %T", res)
+	}
+
+	return pvc, nil
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+)
+
+// QuotaUsage presents namespace resource quotas usage.
+type QuotaUsage struct {
+	ResourceViewer
+}
+
+// NewQuotaUsage returns a new viewer.
+func NewQuotaUsage(gvr *client.GVR) ResourceViewer {
+	q := QuotaUsage{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	q.GetTable().SetDecorateFn(q.decorate)
+
+	return &q
+}
+
+// decorate colors the usage bars using the cpu/mem severity thresholds.
+func (q *QuotaUsage) decorate(td *model1.TableData) {
+	resCol, _ := td.Header().IndexOf("RESOURCE", true)
+	percCol, _ := td.Header().IndexOf("%USED", true)
+	if resCol < 0 || percCol < 0 {
+		return
+	}
+	th := q.App().Config.K9s.Thresholds
+	td.RowsRange(func(i int, re model1.RowEvent) bool {
+		p, _, _ := strings.Cut(re.Row.Fields[percCol], "%")
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return true
+		}
+		check := config.MEM
+		if strings.Contains(re.Row.Fields[resCol], config.CPU) {
+			check = config.CPU
+		}
+		n = min(n, 100)
+		if th.LevelFor(check, n) == config.SeverityLow {
+			return true
+		}
+		re.Row.Fields[percCol] = "[" + th.SeverityColor(check, n) + "::b]" + re.Row.Fields[percCol]
+		td.SetRow(i, re)
+
+		return true
+	})
+}
@@ -0,0 +1,21 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaUsageNew(t *testing.T) {
+	q := view.NewQuotaUsage(client.QuoGVR)
+
+	require.NoError(t, q.Init(makeCtx(t)))
+	assert.Equal(t, "QuotaUsages", q.Name())
+	assert.Len(t, q.Hints(), 5)
+}
@@ -12,6 +12,7 @@ func loadCustomViewers() MetaViewers {
 	coreViewers(m)
 	miscViewers(m)
 	appsViewers(m)
+	autoscalingViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
 	crdViewers(m)
@@ -54,6 +55,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.SaGVR] = MetaViewer{
 		viewerFn: NewServiceAccount,
 	}
+	vv[client.PvGVR] = MetaViewer{
+		viewerFn: NewPersistentVolume,
+	}
 	vv[client.PvcGVR] = MetaViewer{
 		viewerFn: NewPersistentVolumeClaim,
 	}
@@ -78,6 +82,15 @@ func miscViewers(vv MetaViewers) {
 	vv[client.SdGVR] = MetaViewer{
 		viewerFn: NewScreenDump,
 	}
+	vv[client.MwGVR] = MetaViewer{
+		viewerFn: NewMaintenance,
+	}
+	vv[client.NplGVR] = MetaViewer{
+		viewerFn: NewNodePool,
+	}
+	vv[client.QuoGVR] = MetaViewer{
+		viewerFn: NewQuotaUsage,
+	}
 	vv[client.BeGVR] = MetaViewer{
 		viewerFn: NewBenchmark,
 	}
@@ -92,6 +105,15 @@ func miscViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	vv[client.HpaGVR] = MetaViewer{
+		viewerFn: NewHorizontalPodAutoscaler,
+	}
+	vv[client.HpaV2GVR] = MetaViewer{
+		viewerFn: NewHorizontalPodAutoscaler,
+	}
+}
+
 func appsViewers(vv MetaViewers) {
 	vv[client.DpGVR] = MetaViewer{
 		viewerFn: NewDeploy,
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OrphanReplicaSet presents the replicasets not owned by an existing deployment.
+type OrphanReplicaSet struct {
+	ResourceViewer
+}
+
+// NewOrphanReplicaSet returns a new viewer.
+func NewOrphanReplicaSet(gvr *client.GVR) ResourceViewer {
+	r := OrphanReplicaSet{
+		ResourceViewer: NewReplicaSet(gvr),
+	}
+	r.SetContextFn(orphanCtx)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *OrphanReplicaSet) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().SetColorerFn(orphanColorer)
+
+	return nil
+}
+
+func (r *OrphanReplicaSet) bindKeys(aa *ui.KeyActions) {
+	if r.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(tcell.KeyCtrlX, ui.NewKeyActionWithOpts("Delete Marked", r.deleteMarkedCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		}))
+}
+
+// deleteMarkedCmd deletes the marked orphaned replicasets.
+func (r *OrphanReplicaSet) deleteMarkedCmd(*tcell.EventKey) *tcell.EventKey {
+	ids := r.markedIDs()
+	if len(ids) == 0 {
+		r.App().Flash().Warn("Mark the orphaned replicasets to delete first")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Delete %d orphaned %s?\n\n%s", len(ids), r.GVR(), strings.Join(ids, "\n"))
+	d := r.App().Styles.Dialog()
+	dialog.ShowDelete(&d, r.App().Content.Pages, msg, func(propagation *metav1.DeletionPropagation, force bool) {
+		grace := dao.DefaultGrace
+		if force {
+			grace = dao.ForceGrace
+		}
+		var (
+			rs    dao.ReplicaSet
+			count int
+		)
+		rs.Init(r.App().factory, r.GVR())
+		for _, id := range ids {
+			if err := rs.Delete(context.Background(), id, propagation, grace); err != nil {
+				r.App().Flash().Errf("Delete failed with `%s", err)
+				continue
+			}
+			r.GetTable().DeleteMark(id)
+			count++
+		}
+		if count > 0 {
+			r.App().Flash().Infof("Deleted %d orphaned %s", count, r.GVR())
+		}
+		r.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// markedIDs returns the marked replicasets in listing order.
+func (r *OrphanReplicaSet) markedIDs() []string {
+	var ids []string
+	for _, id := range r.GetTable().RowIDs() {
+		if r.GetTable().IsMarked(id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func orphanCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyOrphaned, true)
+}
+
+// orphanColorer flags all orphaned replicasets, keeping deletions distinct.
+func orphanColorer(ns string, h model1.Header, re *model1.RowEvent) tcell.Color {
+	if re.Kind == model1.EventDelete {
+		return model1.DefaultColorer(ns, h, re)
+	}
+
+	return model1.ErrColor
+}
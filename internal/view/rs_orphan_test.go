@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	dao.MetaAccess.RegisterMeta(client.RsGVR.String(), &metav1.APIResource{
+		Name:         "replicasets",
+		SingularName: "replicaset",
+		Namespaced:   true,
+		Kind:         "ReplicaSets",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
+}
+
+func TestOrphanReplicaSet(t *testing.T) {
+	v := view.NewOrphanReplicaSet(client.RsGVR)
+
+	require.NoError(t, v.Init(makeCtx(t)))
+	assert.Equal(t, "ReplicaSets", v.Name())
+	assert.Len(t, v.Hints(), 11)
+}
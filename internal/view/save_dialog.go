@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const saveFileKey = "saveFile"
+
+// SaveFileFunc represents a save file callback function.
+type SaveFileFunc func(path string)
+
+// ShowSaveFile pops a dialog prompting for a destination file path.
+func ShowSaveFile(view ResourceViewer, title, path string, okFn SaveFileFunc) {
+	styles := view.App().Styles.Dialog()
+
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color()).
+		SetFieldBackgroundColor(styles.BgColor.Color())
+
+	f.AddInputField("Path:", path, 0, nil, func(v string) {
+		path = v
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissSaveFile(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissSaveFile(view, pages)
+		okFn(path)
+	})
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText("Save to file?")
+	modal.SetDoneFunc(func(int, string) {
+		DismissSaveFile(view, pages)
+	})
+
+	pages.AddPage(saveFileKey, modal, false, true)
+	pages.ShowPage(saveFileKey)
+	view.App().SetFocus(pages.GetPrimitive(saveFileKey))
+}
+
+// DismissSaveFile dismiss the save file dialog.
+func DismissSaveFile(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(saveFileKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/slogs"
 	"github.com/derailed/k9s/internal/ui"
@@ -60,24 +61,50 @@ func (s *ScaleExtender) scaleCmd(*tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	s.Stop()
-	defer s.Start()
-	s.showScaleDialog(paths)
+	go func() {
+		b, err := s.hpaBounds(paths)
+		s.App().QueueUpdateDraw(func() {
+			if err != nil {
+				s.App().Flash().Err(err)
+				return
+			}
+			s.Stop()
+			defer s.Start()
+			s.showScaleDialog(paths, b)
+		})
+	}()
 
 	return nil
 }
 
-func (s *ScaleExtender) showScaleDialog(paths []string) {
+func (s *ScaleExtender) showScaleDialog(paths []string, b *dao.HPABounds) {
 	form, err := s.makeScaleForm(paths)
 	if err != nil {
 		s.App().Flash().Err(err)
 		return
 	}
-	confirm := tview.NewModalForm("<Scale>", form)
 	msg := fmt.Sprintf("Scale %s %s?", singularize(s.GVR().R()), paths[0])
 	if len(paths) > 1 {
 		msg = fmt.Sprintf("Scale [%d] %s?", len(paths), s.GVR().R())
 	}
+	if b != nil {
+		msg = fmt.Sprintf("%s %s is managed by hpa %s (min: %d, max: %d) which may override the replicas. Scale anyway?",
+			singularize(s.GVR().R()), paths[0], client.FQN(b.Namespace, b.Name), b.MinReplicas, b.MaxReplicas)
+		form.AddButton("HPA", func() {
+			s.dismissDialog()
+			s.showHPADialog(*b)
+		})
+		s.styleButtons(form)
+	}
+	s.showDialog(form, msg)
+}
+
+func (s *ScaleExtender) showHPADialog(b dao.HPABounds) {
+	s.showDialog(s.makeHPAForm(b), fmt.Sprintf("Update hpa %s bounds?", client.FQN(b.Namespace, b.Name)))
+}
+
+func (s *ScaleExtender) showDialog(form *tview.Form, msg string) {
+	confirm := tview.NewModalForm("<Scale>", form)
 	confirm.SetText(msg)
 	confirm.SetDoneFunc(func(int, string) {
 		s.dismissDialog()
@@ -86,6 +113,15 @@ func (s *ScaleExtender) showScaleDialog(paths []string) {
 	s.App().Content.ShowPage(scaleDialogKey)
 }
 
+func (s *ScaleExtender) styleButtons(f *tview.Form) {
+	styles := s.App().Styles.Dialog()
+	for i := range f.GetButtonCount() {
+		f.GetButton(i).
+			SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color()).
+			SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+}
+
 func (s *ScaleExtender) valueOf(col string) (string, error) {
 	colIdx, ok := s.GetTable().HeaderIndex(col)
 	if !ok {
@@ -134,8 +170,9 @@ func (s *ScaleExtender) makeScaleForm(fqns []string) (*tview.Form, error) {
 	factor := "0"
 	if len(fqns) == 1 {
 		// If the CRD resource supports scaling, then first try to
-		// read the replicas directly from the CRD.
-		if meta, _ := dao.MetaAccess.MetaFor(s.GVR()); dao.IsScalable(meta) {
+		// read the replicas directly from the CRD. Autoscalers report
+		// their scale target replicas.
+		if meta, _ := dao.MetaAccess.MetaFor(s.GVR()); dao.IsScalable(meta) || isHPA(s.GVR()) {
 			replicas, err := s.replicasFromScaleSubresource(fqns[0])
 			if err == nil && replicas != "" {
 				factor = replicas
@@ -211,6 +248,97 @@ func (s *ScaleExtender) makeScaleForm(fqns []string) (*tview.Form, error) {
 	return f, nil
 }
 
+// hpaBounds returns the bounds of the autoscaler managing a single selected deployment if any.
+// It dials the api server and must not run on the UI thread.
+func (s *ScaleExtender) hpaBounds(paths []string) (*dao.HPABounds, error) {
+	if s.GVR() != client.DpGVR || len(paths) != 1 {
+		return nil, nil
+	}
+	dp, err := s.deployment()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(paths[0])
+
+	return dp.HPABounds(ctx, ns, n)
+}
+
+func (s *ScaleExtender) deployment() (*dao.Deployment, error) {
+	res, err := dao.AccessorFor(s.App().factory, client.DpGVR)
+	if err != nil {
+		return nil, err
+	}
+	dp, ok := res.(*dao.Deployment)
+	if !ok {
+		return nil, fmt.Errorf("expecting a deployment accessor but got %T", res)
+	}
+
+	return dp, nil
+}
+
+// makeHPAForm offers to update the autoscaler bounds in lieu of scaling its target.
+func (s *ScaleExtender) makeHPAForm(b dao.HPABounds) *tview.Form {
+	minR, maxR := strconv.Itoa(int(b.MinReplicas)), strconv.Itoa(int(b.MaxReplicas))
+	styles := s.App().Styles.Dialog()
+	f := tview.NewForm().
+		SetItemPadding(0).
+		SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+
+	isNum := func(textToCheck string, _ rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}
+	f.AddInputField("Min Replicas:", minR, 4, isNum, func(changed string) {
+		minR = changed
+	})
+	f.AddInputField("Max Replicas:", maxR, 4, isNum, func(changed string) {
+		maxR = changed
+	})
+
+	f.AddButton("OK", func() {
+		defer s.dismissDialog()
+		lo, err := strconv.Atoi(minR)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		hi, err := strconv.Atoi(maxR)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		dp, err := s.deployment()
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		b.MinReplicas, b.MaxReplicas = int32(lo), int32(hi)
+		if err := dp.UpdateHPABounds(ctx, b); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("hpa %s bounds updated successfully", client.FQN(b.Namespace, b.Name))
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+	s.styleButtons(f)
+
+	return f
+}
+
+func isHPA(gvr *client.GVR) bool {
+	return gvr == client.HpaGVR || gvr == client.HpaV2GVR
+}
+
 func (s *ScaleExtender) dismissDialog() {
 	s.App().Content.RemovePage(scaleDialogKey)
 }
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Search presents global search results across resource kinds.
+type Search struct {
+	ResourceViewer
+
+	term string
+}
+
+// NewSearch returns a new search view.
+func NewSearch(term string) ResourceViewer {
+	s := Search{
+		ResourceViewer: NewBrowser(client.SrcGVR),
+		term:           term,
+	}
+	s.AddBindKeysFn(s.bindKeys)
+	s.SetContextFn(s.searchContext)
+	s.GetTable().SetEnterFn(s.gotoResult)
+
+	return &s
+}
+
+// Init initializes the view.
+func (s *Search) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	s.GetTable().GetModel().SetNamespace(client.NotNamespaced)
+
+	return nil
+}
+
+func (s *Search) searchContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeySearchTerm, s.term)
+	return context.WithValue(ctx, internal.KeySearchKinds, dao.DefaultSearchKinds)
+}
+
+func (s *Search) bindKeys(aa *ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlD, ui.KeyE, ui.KeyY, ui.KeyD)
+	aa.Bulk(ui.KeyMap{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Match", s.GetTable().SortColCmd("MATCH", true), false),
+	})
+}
+
+// gotoResult navigates to the selected result resource view filtered on its name.
+func (s *Search) gotoResult(app *App, _ ui.Tabular, _ *client.GVR, path string) {
+	gvr, fqn, ok := strings.Cut(path, render.SearchSep)
+	if !ok {
+		return
+	}
+	ns, n := client.Namespaced(fqn)
+	cmd := gvr
+	if ns != "" {
+		cmd += " " + ns
+	}
+	app.gotoResource(cmd+" /"+n, "", false, true)
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchNew(t *testing.T) {
+	s := view.NewSearch("fred")
+
+	require.NoError(t, s.Init(makeCtx(t)))
+	assert.Equal(t, "Search", s.Name())
+	assert.Len(t, s.Hints(), 4)
+}
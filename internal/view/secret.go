@@ -28,10 +28,10 @@ func NewSecret(gvr *client.GVR) ResourceViewer {
 }
 
 func (s *Secret) bindKeys(aa *ui.KeyActions) {
-	aa.Bulk(ui.KeyMap{
-		ui.KeyX: ui.NewKeyAction("Decode", s.decodeCmd, true),
-		ui.KeyU: ui.NewKeyAction("UsedBy", s.refCmd, true),
-	})
+	aa.Add(ui.KeyU, ui.NewKeyAction("UsedBy", s.refCmd, true))
+	if s.App().Config.K9s.CanDecodeSecrets() {
+		aa.Add(ui.KeyX, ui.NewKeyAction("Decode", s.decodeCmd, true))
+	}
 }
 
 func (s *Secret) refCmd(evt *tcell.EventKey) *tcell.EventKey {
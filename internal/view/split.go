@@ -0,0 +1,389 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/slogs"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const splitTitle = "Split"
+
+// SplitView presents a resource side by side for two kubeconfig contexts.
+type SplitView struct {
+	*tview.Flex
+
+	app          *App
+	gvr          *client.GVR
+	ns           string
+	context      string
+	left, right  *tview.Table
+	actions      *ui.KeyActions
+	conn         *client.APIClient
+	rightFactory *watch.Factory
+	multi        *dao.MultiClient
+	cancelFn     context.CancelFunc
+}
+
+// NewSplitView returns a new split view comparing the active context with another one.
+func NewSplitView(gvr *client.GVR, ns, context string) *SplitView {
+	return &SplitView{
+		Flex:    tview.NewFlex(),
+		gvr:     gvr,
+		ns:      ns,
+		context: context,
+		left:    newSplitPane(),
+		right:   newSplitPane(),
+		actions: ui.NewKeyActions(),
+	}
+}
+
+func newSplitPane() *tview.Table {
+	t := tview.NewTable()
+	t.SetBorder(true)
+	t.SetBorderPadding(0, 0, 1, 1)
+	t.SetFixed(1, 0)
+	t.SetSelectable(true, false)
+
+	return t
+}
+
+func (*SplitView) SetCommand(*cmd.Interpreter)      {}
+func (*SplitView) SetFilter(string)                 {}
+func (*SplitView) SetLabelFilter(map[string]string) {}
+
+// Init initializes the view.
+func (s *SplitView) Init(ctx context.Context) error {
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	active := s.app.Config.ActiveContextName()
+	if s.context == active {
+		return fmt.Errorf("context %q is already active", s.context)
+	}
+	if err := s.connect(); err != nil {
+		return err
+	}
+
+	s.SetTitle(fmt.Sprintf(" %s(%s) [%s] ", splitTitle, s.gvr.R(), s.ns))
+	s.SetBorder(true)
+	s.SetBorderPadding(0, 0, 1, 1)
+	s.left.SetTitle(" " + active + " ")
+	s.right.SetTitle(" " + s.context + " ")
+	s.AddItem(s.left, 0, 1, true)
+	s.AddItem(s.right, 0, 1, false)
+
+	s.app.Styles.AddListener(s)
+	s.StylesChanged(s.app.Styles)
+	s.bindKeys()
+	s.SetInputCapture(s.keyboard)
+
+	return nil
+}
+
+// connect dials the other context with its own connection, factory and metrics
+// server so caches are not shared with the active context.
+func (s *SplitView) connect() error {
+	cfg, err := s.app.Conn().Config().ForContext(s.context)
+	if err != nil {
+		return err
+	}
+	if cc, err := s.app.Config.K9s.ContextConfig(s.context); err != nil {
+		slog.Warn("Unable to load context config", slogs.Context, s.context, slogs.Error, err)
+	} else if p := cc.Context.Proxy; p != nil {
+		cfg.SetProxy(func(*http.Request) (*url.URL, error) {
+			return url.Parse(p.Address)
+		})
+	}
+	conn, err := client.InitConnection(cfg, slog.Default())
+	if err != nil {
+		return fmt.Errorf("unable to connect to context %q: %w", s.context, err)
+	}
+	s.conn, s.rightFactory = conn, watch.NewFactory(conn)
+	s.multi = dao.NewMultiClient(s.app.factory, s.rightFactory)
+
+	return nil
+}
+
+// StylesChanged notifies the skin changed.
+func (s *SplitView) StylesChanged(st *config.Styles) {
+	s.SetBackgroundColor(st.BgColor())
+	s.SetBorderColor(st.Frame().Border.FgColor.Color())
+	s.SetTitleColor(st.Frame().Title.FgColor.Color())
+	for _, t := range []*tview.Table{s.left, s.right} {
+		t.SetBackgroundColor(st.BgColor())
+		t.SetBorderColor(st.Frame().Border.FgColor.Color())
+		t.SetTitleColor(st.Frame().Title.FgColor.Color())
+		t.SetSelectedStyle(tcell.StyleDefault.
+			Foreground(st.Table().CursorFgColor.Color()).
+			Background(st.Table().CursorBgColor.Color()))
+	}
+}
+
+func (s *SplitView) bindKeys() {
+	s.actions.Bulk(ui.KeyMap{
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.app.PrevCmd, false),
+		tcell.KeyTab:    ui.NewKeyAction("Switch Pane", s.switchPaneCmd, true),
+		tcell.KeyCtrlR:  ui.NewKeyAction("Refresh", s.refreshCmd, true),
+	})
+}
+
+func (s *SplitView) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := s.actions.Get(ui.AsKey(evt)); ok {
+		return a.Action(evt)
+	}
+
+	return evt
+}
+
+func (s *SplitView) switchPaneCmd(*tcell.EventKey) *tcell.EventKey {
+	if s.left.HasFocus() {
+		s.app.SetFocus(s.right)
+	} else {
+		s.app.SetFocus(s.left)
+	}
+
+	return nil
+}
+
+func (s *SplitView) refreshCmd(*tcell.EventKey) *tcell.EventKey {
+	if s.multi == nil {
+		return nil
+	}
+	go s.refresh(context.Background(), s.multi)
+
+	return nil
+}
+
+// Start starts the split view refresh loop, reconnecting to the other context if needed.
+func (s *SplitView) Start() {
+	s.Stop()
+
+	if s.conn == nil {
+		if err := s.connect(); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+	}
+	s.rightFactory.Start(s.ns)
+	var ctx context.Context
+	ctx, s.cancelFn = context.WithCancel(context.Background())
+	go s.updater(ctx, s.multi, time.Duration(s.app.Config.K9s.GetRefreshRate())*time.Second)
+}
+
+// Stop terminates the refresh loop and closes the other context connection.
+func (s *SplitView) Stop() {
+	if s.cancelFn == nil {
+		return
+	}
+	s.cancelFn()
+	s.cancelFn = nil
+	s.rightFactory.Terminate()
+	s.conn.Close()
+	s.conn, s.rightFactory, s.multi = nil, nil, nil
+}
+
+func (s *SplitView) updater(ctx context.Context, multi *dao.MultiClient, every time.Duration) {
+	s.refresh(ctx, multi)
+	ticker := time.NewTicker(every)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refresh(ctx, multi)
+		}
+	}
+}
+
+func (s *SplitView) refresh(ctx context.Context, multi *dao.MultiClient) {
+	ctx, cancel := context.WithTimeout(ctx, s.app.Conn().Config().CallTimeout())
+	defer cancel()
+
+	l, r, err := s.load(ctx, multi)
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	s.app.QueueUpdateDraw(func() {
+		if err != nil {
+			slog.Warn("Split view refresh failed", slogs.GVR, s.gvr, slogs.Error, err)
+			s.app.Flash().Err(err)
+			return
+		}
+		diffColor := s.app.Styles.Frame().Status.ModifyColor.Color()
+		missColor := s.app.Styles.Frame().Status.ErrorColor.Color()
+		s.renderPane(s.left, l, diffRows(l, r), diffColor, missColor)
+		s.renderPane(s.right, r, diffRows(r, l), diffColor, missColor)
+	})
+}
+
+// load lists the resource on both clusters and renders their table models.
+func (s *SplitView) load(ctx context.Context, multi *dao.MultiClient) (left, right *model1.TableData, err error) {
+	meta, ok := model.Registry[s.gvr.String()]
+	if !ok || meta.DAO == nil {
+		meta = model.ResourceMeta{DAO: new(dao.Table)}
+	}
+	lo, ro, err := multi.List(ctx, meta.DAO, s.gvr, s.ns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	left, right = model1.NewTableDataFull(s.gvr, s.ns, nil, model1.NewRowEvents(len(lo))),
+		model1.NewTableDataFull(s.gvr, s.ns, nil, model1.NewRowEvents(len(ro)))
+	if err := left.Render(ctx, splitRenderer(meta), lo); err != nil {
+		return nil, nil, err
+	}
+	if err := right.Render(ctx, splitRenderer(meta), ro); err != nil {
+		return nil, nil, err
+	}
+
+	return left, right, nil
+}
+
+// splitRenderer returns the resource renderer. Generic renderers track the
+// table they render, so each pane gets its own.
+func splitRenderer(meta model.ResourceMeta) model1.Renderer {
+	if meta.Renderer == nil || meta.Renderer.IsGeneric() {
+		return new(render.Table)
+	}
+
+	return meta.Renderer
+}
+
+func (s *SplitView) renderPane(t *tview.Table, data *model1.TableData, diffs map[string]sets.Set[string], diffColor, missColor tcell.Color) {
+	row, _ := t.GetSelection()
+	t.Clear()
+
+	st := s.app.Styles.Table()
+	h := data.Header()
+	cols := splitCols(h)
+	for c, idx := range cols {
+		t.SetCell(0, c, tview.NewTableCell(h[idx].Name).
+			SetTextColor(st.Header.FgColor.Color()).
+			SetBackgroundColor(st.Header.BgColor.Color()).
+			SetExpansion(1).
+			SetSelectable(false))
+	}
+
+	r := 1
+	data.RowsRange(func(_ int, re model1.RowEvent) bool {
+		dd, diff := diffs[re.Row.ID]
+		for c, idx := range cols {
+			fg := st.FgColor.Color()
+			switch {
+			case diff && dd == nil:
+				fg = missColor
+			case dd.Has(h[idx].Name):
+				fg = diffColor
+			}
+			var field string
+			if idx < len(re.Row.Fields) {
+				field = re.Row.Fields[idx]
+			}
+			t.SetCell(r, c, tview.NewTableCell(tview.Escape(field)).
+				SetTextColor(fg).
+				SetExpansion(1))
+		}
+		r++
+		return true
+	})
+	if row < 1 {
+		row = 1
+	}
+	if row >= t.GetRowCount() {
+		row = t.GetRowCount() - 1
+	}
+	t.Select(row, 0)
+}
+
+// Name returns the component name.
+func (*SplitView) Name() string {
+	return splitTitle
+}
+
+// InCmdMode checks if prompt is active.
+func (*SplitView) InCmdMode() bool {
+	return false
+}
+
+// Hints returns menu hints.
+func (s *SplitView) Hints() model.MenuHints {
+	return s.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*SplitView) ExtraHints() map[string]string {
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitCols returns the indices of the header columns displayed in a split pane.
+func splitCols(h model1.Header) []int {
+	cc := make([]int, 0, len(h))
+	for i, c := range h {
+		if c.Wide || c.Hide {
+			continue
+		}
+		cc = append(cc, i)
+	}
+
+	return cc
+}
+
+// diffRows reports the rows of a table that are missing from or differ with
+// another table. Missing rows map to a nil set while differing rows map to the
+// names of the columns that differ. Time and metrics columns are ignored.
+func diffRows(a, b *model1.TableData) map[string]sets.Set[string] {
+	dd := make(map[string]sets.Set[string])
+	h := a.Header()
+	a.RowsRange(func(_ int, re model1.RowEvent) bool {
+		other, ok := b.FindRow(re.Row.ID)
+		if !ok {
+			dd[re.Row.ID] = nil
+			return true
+		}
+		for i, c := range h {
+			if c.Time || c.MX || c.MXC || c.MXM || i >= len(re.Row.Fields) {
+				continue
+			}
+			_, j := b.HeadCol(c.Name, true)
+			if j < 0 || j >= len(other.Row.Fields) {
+				continue
+			}
+			if re.Row.Fields[i] != other.Row.Fields[j] {
+				if dd[re.Row.ID] == nil {
+					dd[re.Row.ID] = sets.New[string]()
+				}
+				dd[re.Row.ID].Insert(c.Name)
+			}
+		}
+		return true
+	})
+
+	return dd
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func Test_splitCols(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "IP", Attrs: model1.Attrs{Wide: true}},
+		model1.HeaderColumn{Name: "LABELS", Attrs: model1.Attrs{Hide: true}},
+		model1.HeaderColumn{Name: "STATUS"},
+	}
+
+	assert.Equal(t, []int{0, 3}, splitCols(h))
+}
+
+func Test_diffRows(t *testing.T) {
+	h := model1.Header{
+		model1.HeaderColumn{Name: "NAME"},
+		model1.HeaderColumn{Name: "STATUS"},
+		model1.HeaderColumn{Name: "CPU", Attrs: model1.Attrs{MX: true}},
+		model1.HeaderColumn{Name: "AGE", Attrs: model1.Attrs{Time: true}},
+	}
+	a := model1.NewTableDataWithRows(client.PodGVR, h, model1.NewRowEventsWithEvts(
+		model1.RowEvent{Row: model1.Row{ID: "ns/p1", Fields: model1.Fields{"p1", "Running", "10", "1m"}}},
+		model1.RowEvent{Row: model1.Row{ID: "ns/p2", Fields: model1.Fields{"p2", "Pending", "10", "1m"}}},
+		model1.RowEvent{Row: model1.Row{ID: "ns/p3", Fields: model1.Fields{"p3", "Running", "10", "1m"}}},
+	))
+	b := model1.NewTableDataWithRows(client.PodGVR, h, model1.NewRowEventsWithEvts(
+		model1.RowEvent{Row: model1.Row{ID: "ns/p1", Fields: model1.Fields{"p1", "Running", "20", "2m"}}},
+		model1.RowEvent{Row: model1.Row{ID: "ns/p2", Fields: model1.Fields{"p2", "Running", "10", "1m"}}},
+	))
+
+	assert.Equal(t, map[string]sets.Set[string]{
+		"ns/p2": sets.New("STATUS"),
+		"ns/p3": nil,
+	}, diffRows(a, b))
+	assert.Equal(t, map[string]sets.Set[string]{
+		"ns/p2": sets.New("STATUS"),
+	}, diffRows(b, a))
+}
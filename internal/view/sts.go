@@ -4,11 +4,15 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
 )
 
@@ -54,6 +58,44 @@ func (s *StatefulSet) logOptions(prev bool) (*dao.LogOptions, error) {
 
 func (s *StatefulSet) bindKeys(aa *ui.KeyActions) {
 	aa.Add(ui.KeyShiftR, ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false))
+	if s.App().Config.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyU, ui.NewKeyActionWithOpts("Rollback", s.rollbackCmd,
+		ui.ActionOpts{
+			Visible:   true,
+			Dangerous: true,
+		},
+	))
+}
+
+func (s *StatefulSet) rollbackCmd(*tcell.EventKey) *tcell.EventKey {
+	paths := s.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return nil
+	}
+
+	msg := fmt.Sprintf("Rollback statefulset %s to its previous revision?", paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Rollback %d statefulsets to their previous revision?", len(paths))
+	}
+	d := s.App().Styles.Dialog()
+	dialog.ShowConfirm(&d, s.App().Content.Pages, "Confirm Rollback", msg, func() {
+		var sts dao.StatefulSet
+		sts.Init(s.App().factory, client.StsGVR)
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		for _, path := range paths {
+			ns, n := client.Namespaced(path)
+			if err := sts.RollbackRestart(ctx, ns, n); err != nil {
+				s.App().Flash().Err(err)
+				continue
+			}
+			s.App().Flash().Infof("Rollback in progress for `%s...", path)
+		}
+	}, func() {})
+
+	return nil
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _ *client.GVR, path string) {
@@ -4,9 +4,12 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +28,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	endpointHealthTitle   = "Endpoints Health"
+	endpointHealthTimeout = 2 * time.Second
+)
+
 // Service represents a service viewer.
 type Service struct {
 	ResourceViewer
@@ -52,6 +60,7 @@ func NewService(gvr *client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa *ui.KeyActions) {
 	aa.Bulk(ui.KeyMap{
 		ui.KeyB:      ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyH:      ui.NewKeyAction(endpointHealthTitle, s.endpointHealthCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
 	})
 }
@@ -77,6 +86,42 @@ func (s *Service) showPods(a *App, _ ui.Tabular, _ *client.GVR, path string) {
 	showPods(a, path, toLabelsStr(svc.Spec.Selector), "")
 }
 
+func (s *Service) endpointHealthCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	details := NewDetails(s.App(), endpointHealthTitle, path, contentTXT, true).Update("Checking endpoints...")
+	details.Actions().Add(ui.KeyR, ui.NewKeyAction("Recheck", func(*tcell.EventKey) *tcell.EventKey {
+		go s.updateEndpointHealth(details, path)
+		return nil
+	}, true))
+	if err := s.App().inject(details, false); err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	go s.updateEndpointHealth(details, path)
+
+	return nil
+}
+
+// updateEndpointHealth probes the service endpoints and refreshes the details view.
+func (s *Service) updateEndpointHealth(d *Details, path string) {
+	var svc dao.Service
+	svc.Init(s.App().factory, client.SvcGVR)
+
+	ns, n := client.Namespaced(path)
+	hh, err := svc.CheckEndpointHealth(context.Background(), ns, n, endpointHealthTimeout)
+	s.App().QueueUpdateDraw(func() {
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		d.Update(endpointHealthTable(hh))
+	})
+}
+
 func (*Service) checkSvc(svc *v1.Service) error {
 	if svc.Spec.Type != "NodePort" && svc.Spec.Type != "LoadBalancer" {
 		return errors.New("you must select a reachable service")
@@ -208,6 +253,38 @@ func clearStatus(app *App) {
 	})
 }
 
+// endpointHealthTable renders the service endpoints reachability.
+func endpointHealthTable(hh []dao.EndpointHealth) string {
+	if len(hh) == 0 {
+		return "No endpoints found"
+	}
+
+	const addrHdr, healthHdr, latencyHdr, errHdr = "ENDPOINT", "HEALTH", "LATENCY", "ERROR"
+	aw := len(addrHdr)
+	for _, h := range hh {
+		aw = max(aw, len(endpointAddress(h)))
+	}
+
+	ll := make([]string, 0, len(hh)+1)
+	ll = append(ll, fmt.Sprintf("%-*s  %-9s  %8s  %s", aw, addrHdr, healthHdr, latencyHdr, errHdr))
+	for _, h := range hh {
+		health, latency := "unhealthy", render.NAValue
+		if h.Healthy {
+			health = "healthy"
+		}
+		if h.Healthy || h.LatencyMs > 0 {
+			latency = fmt.Sprintf("%dms", h.LatencyMs)
+		}
+		ll = append(ll, strings.TrimRight(fmt.Sprintf("%-*s  %-9s  %8s  %s", aw, endpointAddress(h), health, latency, h.Error), " "))
+	}
+
+	return strings.Join(ll, "\n")
+}
+
+func endpointAddress(h dao.EndpointHealth) string {
+	return net.JoinHostPort(h.Address, strconv.Itoa(int(h.Port)))
+}
+
 func fetchService(f dao.Factory, path string) (*v1.Service, error) {
 	o, err := f.Get(client.SvcGVR, path, true, labels.Everything())
 	if err != nil {
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_endpointHealthTable(t *testing.T) {
+	uu := map[string]struct {
+		hh []dao.EndpointHealth
+		e  string
+	}{
+		"empty": {
+			e: "No endpoints found",
+		},
+		"mixed": {
+			hh: []dao.EndpointHealth{
+				{Address: "10.0.0.1", Port: 80, Healthy: true, LatencyMs: 3},
+				{Address: "10.0.0.2", Port: 80, LatencyMs: 2000, Error: "i/o timeout"},
+				{Address: "10.0.0.3", Port: 80, Error: "endpoint not ready"},
+			},
+			e: "ENDPOINT     HEALTH      LATENCY  ERROR\n" +
+				"10.0.0.1:80  healthy         3ms\n" +
+				"10.0.0.2:80  unhealthy    2000ms  i/o timeout\n" +
+				"10.0.0.3:80  unhealthy       n/a  endpoint not ready",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, endpointHealthTable(u.hh))
+		})
+	}
+}
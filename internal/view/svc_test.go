@@ -45,6 +45,14 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta(client.HpaV2GVR.String(), &metav1.APIResource{
+		Name:         "horizontalpodautoscalers",
+		SingularName: "horizontalpodautoscaler",
+		Namespaced:   true,
+		Kind:         "HorizontalPodAutoscalers",
+		Verbs:        []string{"get", "list", "watch", "delete"},
+		Categories:   []string{"k9s"},
+	})
 	dao.MetaAccess.RegisterMeta(client.SecGVR.String(), &metav1.APIResource{
 		Name:         "secrets",
 		SingularName: "secret",
@@ -135,6 +143,35 @@ func init() {
 		Verbs:        []string{"get", "list", "watch", "delete"},
 		Categories:   []string{"k9s"},
 	})
+	dao.MetaAccess.RegisterMeta(client.MwGVR.String(), &metav1.APIResource{
+		Name:         "maintenance",
+		SingularName: "maintenance",
+		Kind:         "Maintenance",
+		Verbs:        []string{"delete"},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.NplGVR.String(), &metav1.APIResource{
+		Name:         "nodepools",
+		SingularName: "nodepool",
+		Kind:         "NodePools",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.QuoGVR.String(), &metav1.APIResource{
+		Name:         "quotausages",
+		Namespaced:   true,
+		SingularName: "quotausage",
+		Kind:         "QuotaUsages",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
+	dao.MetaAccess.RegisterMeta(client.SrcGVR.String(), &metav1.APIResource{
+		Name:         "search",
+		SingularName: "search",
+		Kind:         "Search",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	})
 	dao.MetaAccess.RegisterMeta(client.StsGVR.String(), &metav1.APIResource{
 		Name:         "statefulsets",
 		SingularName: "statefulset",
@@ -174,5 +211,5 @@ func TestServiceNew(t *testing.T) {
 
 	require.NoError(t, s.Init(makeCtx(t)))
 	assert.Equal(t, "Services", s.Name())
-	assert.Len(t, s.Hints(), 12)
+	assert.Len(t, s.Hints(), 13)
 }